@@ -0,0 +1,285 @@
+package goda
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// Clock provides access to the current instant and a time zone.
+// The NowClock functions of each type, such as LocalDateNowClock, read the current date and time from a Clock,
+// so the time can be injected in tests instead of reading the system clock directly.
+//
+// The implementations in this package are safe for concurrent use:
+//   - ClockSystem: the system clock, ClockSystemUTC and ClockSystemDefaultZone are the shortcuts
+//   - ClockFixed: always returns the same instant
+//   - ClockOffset: adds a duration to another clock
+//   - ClockTick and ClockTickUnit: truncate another clock to whole ticks, such as whole seconds
+//   - ManualClock: a clock that only moves when set or advanced, for tests
+//
+// This is similar to Java's Clock.
+type Clock interface {
+	// Instant returns the current instant of the clock.
+	Instant() Instant
+	// Zone returns the time zone used to convert the instant to a date and time.
+	Zone() ZoneId
+	// WithZone returns a copy of the clock with a different time zone, reading the same instant.
+	WithZone(zone ZoneId) Clock
+}
+
+type systemClock struct {
+	zone ZoneId
+}
+
+func (c systemClock) Instant() Instant {
+	return InstantNow()
+}
+
+func (c systemClock) Zone() ZoneId {
+	return c.zone
+}
+
+func (c systemClock) WithZone(zone ZoneId) Clock {
+	return systemClock{zone: zone}
+}
+
+// ClockSystem returns a clock of the system time in the time zone.
+func ClockSystem(zone ZoneId) Clock {
+	return systemClock{zone: zone}
+}
+
+// ClockSystemUTC returns a clock of the system time in UTC.
+func ClockSystemUTC() Clock {
+	return systemClock{zone: ZoneIdUTC()}
+}
+
+// ClockSystemDefaultZone returns a clock of the system time in the system's default time zone, see ZoneIdDefault.
+func ClockSystemDefaultZone() Clock {
+	return systemClock{zone: ZoneIdDefault()}
+}
+
+type fixedClock struct {
+	instant Instant
+	zone    ZoneId
+}
+
+func (c fixedClock) Instant() Instant {
+	return c.instant
+}
+
+func (c fixedClock) Zone() ZoneId {
+	return c.zone
+}
+
+func (c fixedClock) WithZone(zone ZoneId) Clock {
+	return fixedClock{instant: c.instant, zone: zone}
+}
+
+// ClockFixed returns a clock always returning the instant in the time zone.
+func ClockFixed(instant Instant, zone ZoneId) Clock {
+	return fixedClock{instant: instant, zone: zone}
+}
+
+type offsetClock struct {
+	base   Clock
+	offset Duration
+}
+
+func (c offsetClock) Instant() Instant {
+	r, e := c.base.Instant().Chain().PlusSeconds(c.offset.Seconds()).PlusNanos(int64(c.offset.Nano())).GetResult()
+	if e != nil {
+		return Instant{}
+	}
+	return r
+}
+
+func (c offsetClock) Zone() ZoneId {
+	return c.base.Zone()
+}
+
+func (c offsetClock) WithZone(zone ZoneId) Clock {
+	return offsetClock{base: c.base.WithZone(zone), offset: c.offset}
+}
+
+// ClockOffset returns a clock adding the offset to the instant of the base clock, in the time zone of the base clock.
+// A negative offset moves the clock back. The instant is zero value if adding the offset overflows.
+func ClockOffset(base Clock, offset Duration) Clock {
+	if offset.Seconds()|int64(offset.Nano()) == 0 {
+		return base
+	}
+	return offsetClock{base: base, offset: offset}
+}
+
+type tickClock struct {
+	base Clock
+	tick Duration
+}
+
+func (c tickClock) Instant() Instant {
+	var instant = c.base.Instant()
+	if instant.IsZero() {
+		return instant
+	}
+	var seconds = instant.EpochSecond()
+	if c.tick.Nano() == 0 {
+		r, _ := InstantOfEpochSecond(seconds-floorMod(seconds, c.tick.Seconds()), 0)
+		return r
+	}
+	// the tick is less than about 292 years, its nanoseconds fit in int64
+	var tick = uint64(c.tick.Seconds())*1000_000_000 + uint64(c.tick.Nano())
+	hi, lo := bits.Mul64(uint64(floorMod(seconds, int64(tick))), 1000_000_000)
+	var remainder = (bits.Rem64(hi, lo, tick) + uint64(instant.Nano())) % tick
+	r, _ := instant.Chain().MinusNanos(int64(remainder)).GetResult()
+	return r
+}
+
+func (c tickClock) Zone() ZoneId {
+	return c.base.Zone()
+}
+
+func (c tickClock) WithZone(zone ZoneId) Clock {
+	return tickClock{base: c.base.WithZone(zone), tick: c.tick}
+}
+
+// ClockTick returns a clock truncating the instant of the base clock to whole ticks since the Unix epoch,
+// such as whole seconds or whole 15 minutes, in the time zone of the base clock.
+// Returns an error if the tick is not positive, or it has a fraction of second and is longer than about 292 years.
+func ClockTick(base Clock, tick Duration) (Clock, error) {
+	if !tick.IsPositive() {
+		return nil, newError("tick must be positive, got %v", tick)
+	}
+	if tick.Nano() != 0 && tick.Seconds() >= (1<<63-1)/1000_000_000 {
+		return nil, newError("tick with a fraction of second is too long: %v", tick)
+	}
+	if tick.Seconds() == 0 && tick.Nano() == 1 {
+		return base, nil
+	}
+	return tickClock{base: base, tick: tick}, nil
+}
+
+// MustClockTick returns a clock truncating the instant of the base clock to whole ticks.
+// Panics if the tick is invalid, see ClockTick.
+func MustClockTick(base Clock, tick Duration) Clock {
+	return mustValue(ClockTick(base, tick))
+}
+
+// ClockTickUnit returns a clock truncating the instant of the base clock to whole units, such as UnitSeconds.
+// Returns an error if the unit is not time-based (UnitNanos to UnitHalfDays), as the other units vary in length.
+func ClockTickUnit(base Clock, unit Unit) (Clock, error) {
+	if !unit.IsTimeBased() {
+		return nil, unsupportedUnit(unit)
+	}
+	return ClockTick(base, unit.Duration())
+}
+
+// MustClockTickUnit returns a clock truncating the instant of the base clock to whole units.
+// Panics if the unit is not time-based.
+func MustClockTickUnit(base Clock, unit Unit) Clock {
+	return mustValue(ClockTickUnit(base, unit))
+}
+
+// ManualClock is a Clock which only moves when it's set or advanced, intended for tests.
+// It's safe for concurrent use, a ManualClock must not be copied after first use.
+//
+// The clocks returned by WithZone share the instant with the ManualClock, they move together.
+type ManualClock struct {
+	mu      sync.Mutex
+	instant Instant
+	zone    ZoneId
+}
+
+// ManualClockOf returns a ManualClock starting at the instant in the time zone.
+func ManualClockOf(instant Instant, zone ZoneId) *ManualClock {
+	return &ManualClock{instant: instant, zone: zone}
+}
+
+// Instant returns the current instant of the clock.
+func (c *ManualClock) Instant() Instant {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.instant
+}
+
+// Zone returns the time zone of the clock.
+func (c *ManualClock) Zone() ZoneId {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.zone
+}
+
+// WithZone returns a clock with a different time zone, sharing the instant with this clock.
+func (c *ManualClock) WithZone(zone ZoneId) Clock {
+	return manualClockView{clock: c, zone: zone}
+}
+
+// Set moves the clock to the instant.
+func (c *ManualClock) Set(instant Instant) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.instant = instant
+}
+
+// SetZone changes the time zone of the clock.
+func (c *ManualClock) SetZone(zone ZoneId) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.zone = zone
+}
+
+// Advance moves the clock by the duration, a negative duration moves the clock back.
+// Returns an error and leaves the clock unchanged if the result overflows.
+func (c *ManualClock) Advance(d Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, e := c.instant.Chain().PlusSeconds(d.Seconds()).PlusNanos(int64(d.Nano())).GetResult()
+	if e != nil {
+		return e
+	}
+	c.instant = r
+	return nil
+}
+
+// AdvanceUnit moves the clock by the amount of the unit, such as 3 UnitHours.
+// Returns an error and leaves the clock unchanged if the unit is unsupported or the result overflows.
+func (c *ManualClock) AdvanceUnit(amount int64, unit Unit) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, e := c.instant.Chain().PlusUnit(amount, unit).GetResult()
+	if e != nil {
+		return e
+	}
+	c.instant = r
+	return nil
+}
+
+type manualClockView struct {
+	clock *ManualClock
+	zone  ZoneId
+}
+
+func (c manualClockView) Instant() Instant {
+	return c.clock.Instant()
+}
+
+func (c manualClockView) Zone() ZoneId {
+	return c.zone
+}
+
+func (c manualClockView) WithZone(zone ZoneId) Clock {
+	return manualClockView{clock: c.clock, zone: zone}
+}
+
+// zonedDateTimeNowClock returns the current date-time of the clock,
+// the zero value if the instant or the zone of the clock is zero.
+func zonedDateTimeNowClock(clock Clock) ZonedDateTime {
+	r, _ := ZonedDateTimeOfInstant(clock.Instant(), clock.Zone())
+	return r
+}
+
+var (
+	_ Clock = systemClock{}
+	_ Clock = fixedClock{}
+	_ Clock = offsetClock{}
+	_ Clock = tickClock{}
+	_ Clock = (*ManualClock)(nil)
+	_ Clock = manualClockView{}
+)
@@ -0,0 +1,205 @@
+package goda
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockSystem(t *testing.T) {
+	var before = InstantNow()
+	var clock = ClockSystemUTC()
+	var now = clock.Instant()
+	assert.False(t, now.IsBefore(before))
+	assert.False(t, now.IsAfter(InstantNow()))
+	assert.Equal(t, ZoneIdUTC(), clock.Zone())
+	assert.Equal(t, ZoneIdDefault(), ClockSystemDefaultZone().Zone())
+
+	var tokyo = MustZoneIdOf("Asia/Tokyo")
+	assert.Equal(t, tokyo, ClockSystem(tokyo).Zone())
+	assert.Equal(t, tokyo, clock.WithZone(tokyo).Zone())
+}
+
+func TestClockFixed(t *testing.T) {
+	var instant = MustInstantParse("2024-03-15T23:30:00Z")
+	var tokyo = MustZoneIdOf("Asia/Tokyo")
+	var clock = ClockFixed(instant, tokyo)
+	assert.Equal(t, instant, clock.Instant())
+	assert.Equal(t, tokyo, clock.Zone())
+
+	assert.Equal(t, instant, InstantNowClock(clock))
+	assert.Equal(t, MustLocalDateOf(2024, March, 16), LocalDateNowClock(clock))
+	assert.Equal(t, MustLocalTimeOf(8, 30, 0, 0), LocalTimeNowClock(clock))
+	assert.Equal(t, MustLocalDateTimeParse("2024-03-16T08:30"), LocalDateTimeNowClock(clock))
+	assert.Equal(t, MustOffsetDateTimeParse("2024-03-16T08:30+09:00"), OffsetDateTimeNowClock(clock))
+	assert.Equal(t, MustOffsetTimeParse("08:30+09:00"), OffsetTimeNowClock(clock))
+	assert.Equal(t, MustZonedDateTimeParse("2024-03-16T08:30+09:00[Asia/Tokyo]"), ZonedDateTimeNowClock(clock))
+
+	clock = clock.WithZone(ZoneIdUTC())
+	assert.Equal(t, instant, clock.Instant())
+	assert.Equal(t, MustLocalDateOf(2024, March, 15), LocalDateNowClock(clock))
+	assert.Equal(t, MustOffsetDateTimeParse("2024-03-15T23:30Z"), OffsetDateTimeNowClock(clock))
+
+	clock = ClockFixed(instant, ZoneId{})
+	assert.True(t, LocalDateNowClock(clock).IsZero())
+	assert.True(t, LocalTimeNowClock(clock).IsZero())
+	assert.True(t, ZonedDateTimeNowClock(clock).IsZero())
+	assert.True(t, LocalDateNowClock(ClockFixed(Instant{}, tokyo)).IsZero())
+}
+
+func TestClockOffset(t *testing.T) {
+	var instant = MustInstantParse("2024-03-15T23:30:00Z")
+	var base = ClockFixed(instant, ZoneIdUTC())
+	var clock = ClockOffset(base, MustDurationParse("PT1H30M"))
+	assert.Equal(t, MustInstantParse("2024-03-16T01:00:00Z"), clock.Instant())
+	assert.Equal(t, ZoneIdUTC(), clock.Zone())
+	assert.Equal(t, MustInstantParse("2024-03-15T22:59:59.5Z"), ClockOffset(base, MustDurationParse("-PT30M0.5S")).Instant())
+	assert.Equal(t, base, ClockOffset(base, DurationZero()))
+
+	var tokyo = MustZoneIdOf("Asia/Tokyo")
+	clock = clock.WithZone(tokyo)
+	assert.Equal(t, tokyo, clock.Zone())
+	assert.Equal(t, MustLocalDateTimeParse("2024-03-16T10:00"), LocalDateTimeNowClock(clock))
+
+	assert.True(t, ClockOffset(ClockFixed(InstantMax(), ZoneIdUTC()), MustDurationParse("PT1S")).Instant().IsZero())
+}
+
+func TestClockTick(t *testing.T) {
+	var base = ClockFixed(MustInstantParse("2024-03-15T23:31:47.123456789Z"), ZoneIdUTC())
+	for _, it := range []struct {
+		tick     string
+		expected string
+	}{
+		{"PT0.000000001S", "2024-03-15T23:31:47.123456789Z"},
+		{"PT0.001S", "2024-03-15T23:31:47.123Z"},
+		{"PT0.3S", "2024-03-15T23:31:47.1Z"},
+		{"PT1S", "2024-03-15T23:31:47Z"},
+		{"PT1.5S", "2024-03-15T23:31:46.5Z"},
+		{"PT15M", "2024-03-15T23:30:00Z"},
+		{"PT24H", "2024-03-15T00:00:00Z"},
+		{"PT168H", "2024-03-14T00:00:00Z"},
+	} {
+		clock, e := ClockTick(base, MustDurationParse(it.tick))
+		require.NoError(t, e, it.tick)
+		assert.Equal(t, MustInstantParse(it.expected), clock.Instant(), it.tick)
+	}
+
+	var before = ClockFixed(MustInstantParse("1969-12-31T23:59:59.7Z"), ZoneIdUTC())
+	assert.Equal(t, MustInstantParse("1969-12-31T23:59:59Z"), MustClockTick(before, MustDurationParse("PT1S")).Instant())
+	assert.Equal(t, MustInstantParse("1969-12-31T23:59:59.5Z"), MustClockTick(before, MustDurationParse("PT0.5S")).Instant())
+	assert.Equal(t, MustInstantParse("1969-12-31T23:59:58.5Z"), MustClockTick(before, MustDurationParse("PT1.5S")).Instant())
+
+	assert.Equal(t, MustInstantParse("2024-03-15T23:31:00Z"), MustClockTickUnit(base, UnitMinutes).Instant())
+	assert.Equal(t, MustInstantParse("2024-03-15T12:00:00Z"), MustClockTickUnit(base, UnitHalfDays).Instant())
+	assert.Equal(t, MustInstantParse("2024-03-15T23:31:47.123456Z"), MustClockTickUnit(base, UnitMicros).Instant())
+
+	var tokyo = MustZoneIdOf("Asia/Tokyo")
+	var clock = MustClockTickUnit(base, UnitHours).WithZone(tokyo)
+	assert.Equal(t, tokyo, clock.Zone())
+	assert.Equal(t, MustLocalTimeOf(8, 0, 0, 0), LocalTimeNowClock(clock))
+
+	_, e := ClockTick(base, DurationZero())
+	assert.Error(t, e)
+	_, e = ClockTick(base, MustDurationParse("-PT1S"))
+	assert.Error(t, e)
+	_, e = ClockTick(base, MustDurationOfSeconds(1<<62, 1))
+	assert.Error(t, e)
+	_, e = ClockTickUnit(base, UnitDays)
+	assert.ErrorIs(t, e, ErrUnsupported)
+	assert.Panics(t, func() { MustClockTickUnit(base, UnitMonths) })
+	assert.True(t, MustClockTickUnit(ClockFixed(Instant{}, ZoneIdUTC()), UnitSeconds).Instant().IsZero())
+}
+
+func TestManualClock(t *testing.T) {
+	var clock = ManualClockOf(MustInstantParse("2024-03-15T23:30:00Z"), ZoneIdUTC())
+	var tokyo = clock.WithZone(MustZoneIdOf("Asia/Tokyo"))
+	assert.Equal(t, MustLocalDateOf(2024, March, 15), LocalDateNowClock(clock))
+	assert.Equal(t, MustLocalDateOf(2024, March, 16), LocalDateNowClock(tokyo))
+
+	require.NoError(t, clock.Advance(MustDurationParse("PT1H")))
+	assert.Equal(t, MustInstantParse("2024-03-16T00:30:00Z"), clock.Instant())
+	assert.Equal(t, MustLocalDateTimeParse("2024-03-16T09:30"), LocalDateTimeNowClock(tokyo))
+	require.NoError(t, clock.AdvanceUnit(-2, UnitDays))
+	assert.Equal(t, MustInstantParse("2024-03-14T00:30:00Z"), tokyo.Instant())
+
+	clock.Set(MustInstantParse("2000-01-01T00:00:00Z"))
+	assert.Equal(t, MustLocalDateOf(2000, January, 1), LocalDateNowClock(clock))
+	clock.SetZone(MustZoneIdOf("America/New_York"))
+	assert.Equal(t, MustLocalDateOf(1999, December, 31), LocalDateNowClock(clock))
+	assert.Equal(t, MustZoneIdOf("Asia/Tokyo"), tokyo.Zone())
+	assert.Equal(t, ZoneIdUTC(), tokyo.WithZone(ZoneIdUTC()).Zone())
+
+	clock.Set(InstantMax())
+	assert.Error(t, clock.Advance(MustDurationParse("PT1S")))
+	assert.Equal(t, InstantMax(), clock.Instant())
+	assert.ErrorIs(t, clock.AdvanceUnit(1, UnitMonths), ErrUnsupported)
+
+	clock.Set(InstantEpoch())
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				_ = clock.Advance(DurationOfMillis(1))
+				_ = LocalDateTimeNowClock(tokyo)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, InstantOfEpochMilli(8000), clock.Instant())
+}
+
+func TestClock_SystemNow(t *testing.T) {
+	var clock = ClockSystem(ZoneIdOfGoLocation(time.UTC))
+	var before = LocalDateTimeNowUTC()
+	var now = LocalDateTimeNowClock(clock)
+	assert.False(t, now.IsBefore(before))
+	assert.False(t, now.IsAfter(LocalDateTimeNowUTC()))
+}
+
+// newYearClocks returns the clocks at 2024-12-31T20:00Z in UTC and in Tokyo, where it's already 2025.
+func newYearClocks() (utc, tokyo Clock) {
+	var instant = MustInstantParse("2024-12-31T20:00:00Z")
+	return ClockFixed(instant, ZoneIdUTC()), ClockFixed(instant, MustZoneIdOf("Asia/Tokyo"))
+}
+
+func TestYearNowClock(t *testing.T) {
+	var utc, tokyo = newYearClocks()
+	assert.Equal(t, Year(2024), YearNowClock(utc))
+	assert.Equal(t, Year(2025), YearNowClock(tokyo))
+	assert.Equal(t, Year(0), YearNowClock(ClockFixed(Instant{}, ZoneIdUTC())))
+}
+
+func TestYearMonthNowClock(t *testing.T) {
+	var utc, tokyo = newYearClocks()
+	assert.Equal(t, MustYearMonthOf(2024, December), YearMonthNowClock(utc))
+	assert.Equal(t, MustYearMonthOf(2025, January), YearMonthNowClock(tokyo))
+	assert.True(t, YearMonthNowClock(ClockFixed(Instant{}, ZoneIdUTC())).IsZero())
+}
+
+func TestMonthDayNowClock(t *testing.T) {
+	var utc, tokyo = newYearClocks()
+	assert.Equal(t, MustMonthDayOf(December, 31), MonthDayNowClock(utc))
+	assert.Equal(t, MustMonthDayOf(January, 1), MonthDayNowClock(tokyo))
+	assert.True(t, MonthDayNowClock(ClockFixed(Instant{}, ZoneIdUTC())).IsZero())
+}
+
+func TestYearWeekNowClock(t *testing.T) {
+	var utc, tokyo = newYearClocks()
+	assert.Equal(t, MustYearWeekOf(2025, 1), YearWeekNowClock(utc))
+	assert.Equal(t, MustYearWeekOf(2025, 1), YearWeekNowClock(tokyo))
+	assert.Equal(t, MustYearWeekOf(2024, 52), YearWeekNowClock(ClockFixed(MustInstantParse("2024-12-29T20:00:00Z"), ZoneIdUTC())))
+	assert.Equal(t, MustYearWeekOf(2025, 1), YearWeekNowClock(ClockFixed(MustInstantParse("2024-12-29T20:00:00Z"), MustZoneIdOf("Asia/Tokyo"))))
+	assert.True(t, YearWeekNowClock(ClockFixed(Instant{}, ZoneIdUTC())).IsZero())
+}
+
+func TestYearQuarterNowClock(t *testing.T) {
+	var utc, tokyo = newYearClocks()
+	assert.Equal(t, MustYearQuarterOf(2024, Q4), YearQuarterNowClock(utc))
+	assert.Equal(t, MustYearQuarterOf(2025, Q1), YearQuarterNowClock(tokyo))
+	assert.True(t, YearQuarterNowClock(ClockFixed(Instant{}, ZoneIdUTC())).IsZero())
+}
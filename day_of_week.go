@@ -1,6 +1,13 @@
 package goda
 
-import "time"
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 // DayOfWeek represents a day-of-week in the ISO-8601 calendar system,
 // where Monday=1 and Sunday=7. This differs from time.Weekday where Sunday=0.
@@ -48,3 +55,23 @@ func DayOfWeekFromGoWeekday(w time.Weekday) DayOfWeek {
 	}
 	return DayOfWeek(w)
 }
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = DayOfWeek(0)
+	_ fmt.Stringer             = DayOfWeek(0)
+	_ encoding.TextMarshaler   = DayOfWeek(0)
+	_ encoding.TextUnmarshaler = (*DayOfWeek)(nil)
+	_ json.Marshaler           = DayOfWeek(0)
+	_ json.Unmarshaler         = (*DayOfWeek)(nil)
+	_ driver.Valuer            = DayOfWeek(0)
+	_ sql.Scanner              = (*DayOfWeek)(nil)
+	_ encoding.TextAppender    = DayOfWeekName(0)
+	_ fmt.Stringer             = DayOfWeekName(0)
+	_ encoding.TextMarshaler   = DayOfWeekName(0)
+	_ encoding.TextUnmarshaler = (*DayOfWeekName)(nil)
+	_ json.Marshaler           = DayOfWeekName(0)
+	_ json.Unmarshaler         = (*DayOfWeekName)(nil)
+	_ driver.Valuer            = DayOfWeekName(0)
+	_ sql.Scanner              = (*DayOfWeekName)(nil)
+)
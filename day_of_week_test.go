@@ -0,0 +1,82 @@
+package goda
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDayOfWeek_UnmarshalText(t *testing.T) {
+	for _, s := range []string{"1", "MONDAY", "Monday", "monday", "Mon", "MON"} {
+		var d DayOfWeek
+		require.NoError(t, d.UnmarshalText([]byte(s)), s)
+		assert.Equal(t, Monday, d, s)
+	}
+	var d = Monday
+	require.NoError(t, d.UnmarshalText(nil))
+	assert.True(t, d.IsZero())
+	for _, s := range []string{"8", "Mo", "March"} {
+		assert.Error(t, d.UnmarshalText([]byte(s)), s)
+	}
+}
+
+func TestDayOfWeek_Format(t *testing.T) {
+	assert.Equal(t, "7", Sunday.Format(EnumFormatNumber))
+	assert.Equal(t, "SUNDAY", Sunday.Format(EnumFormatName))
+	assert.Equal(t, "Sunday", Sunday.Format(EnumFormatDisplayName))
+	assert.Equal(t, "Sun", Sunday.Format(EnumFormatShortName))
+	assert.Equal(t, "", DayOfWeek(0).Format(EnumFormatName))
+}
+
+func TestDayOfWeek_Codecs(t *testing.T) {
+	data, err := json.Marshal([]DayOfWeek{Sunday, 0})
+	require.NoError(t, err)
+	assert.Equal(t, `[7,0]`, string(data))
+	var days []DayOfWeek
+	require.NoError(t, json.Unmarshal(data, &days))
+	assert.Equal(t, []DayOfWeek{Sunday, 0}, days)
+	value, err := Sunday.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), value)
+	value, err = DayOfWeek(0).Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestDayOfWeekName(t *testing.T) {
+	data, err := json.Marshal([]DayOfWeekName{DayOfWeekName(Sunday), 0})
+	require.NoError(t, err)
+	assert.Equal(t, `["SUNDAY",null]`, string(data))
+	var days []DayOfWeekName
+	require.NoError(t, json.Unmarshal(data, &days))
+	assert.Equal(t, []DayOfWeekName{DayOfWeekName(Sunday), 0}, days)
+	value, err := DayOfWeekName(Sunday).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "SUNDAY", value)
+	var d DayOfWeekName
+	require.NoError(t, d.Scan(int64(7)))
+	assert.Equal(t, Sunday, d.DayOfWeek())
+}
+
+func TestDayOfWeek_JSON(t *testing.T) {
+	var s struct {
+		Days []DayOfWeek `json:"days"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"days":[1,"Tue","WEDNESDAY",null,""]}`), &s))
+	assert.Equal(t, []DayOfWeek{Monday, Tuesday, Wednesday, 0, 0}, s.Days)
+	assert.Error(t, json.Unmarshal([]byte(`{"days":[8]}`), &s))
+}
+
+func TestDayOfWeek_Scan(t *testing.T) {
+	var d DayOfWeek
+	for _, src := range []any{int64(5), "5", []byte("FRIDAY"), "fri"} {
+		d = 0
+		require.NoError(t, d.Scan(src), "%v", src)
+		assert.Equal(t, Friday, d)
+	}
+	require.NoError(t, d.Scan(nil))
+	assert.True(t, d.IsZero())
+	assert.Error(t, d.Scan(int64(8)))
+}
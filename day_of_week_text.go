@@ -0,0 +1,151 @@
+package goda
+
+import "database/sql/driver"
+
+// Format returns the day-of-week in the format, such as "MONDAY" in EnumFormatName or "Mon" in EnumFormatShortName.
+// Returns empty string for zero value.
+func (d DayOfWeek) Format(format EnumFormat) string {
+	if d.IsZero() {
+		return ""
+	}
+	return string(appendEnumText(nil, FieldDayOfWeek, int64(d), format))
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// The day-of-week is written as its number (e.g., "1" for Monday), use DayOfWeekName for the name.
+// Appends nothing for zero value.
+func (d DayOfWeek) AppendText(b []byte) ([]byte, error) {
+	if d.IsZero() {
+		return b, nil
+	}
+	return appendEnumText(b, FieldDayOfWeek, int64(d), EnumFormatNumber), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d DayOfWeek) MarshalText() ([]byte, error) {
+	return marshalTextImpl(d)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the number, the name or the three-letter abbreviation ignoring case,
+// such as "1", "MONDAY", "Monday" or "Mon".
+// Empty input and "0" are treated as zero value.
+func (d *DayOfWeek) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*d = 0
+		return nil
+	}
+	v, e := parseEnumText(text, FieldDayOfWeek)
+	if e != nil {
+		return
+	}
+	*d = DayOfWeek(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The day-of-week is a JSON number, 0 for zero value, same as the underlying integer.
+func (d DayOfWeek) MarshalJSON() ([]byte, error) {
+	return appendEnumText(nil, FieldDayOfWeek, int64(d), EnumFormatNumber), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON numbers, JSON strings in any form UnmarshalText accepts, or JSON null.
+func (d *DayOfWeek) UnmarshalJSON(data []byte) error {
+	if len(data) == 4 && string(data) == "null" {
+		*d = 0
+		return nil
+	}
+	if len(data) > 0 && data[0] != '"' {
+		return d.UnmarshalText(data)
+	}
+	return unmarshalJsonImpl(d, data)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil for zero value, otherwise the number as an int64.
+func (d DayOfWeek) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return int64(d), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports scanning from nil, int64, string and []byte.
+// Nil values and 0 are converted to the zero value of DayOfWeek.
+func (d *DayOfWeek) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*d = 0
+		return nil
+	case int64:
+		if e := scanEnumInt64(FieldDayOfWeek, v); e != nil {
+			return e
+		}
+		*d = DayOfWeek(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case []byte:
+		return d.UnmarshalText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+// DayOfWeekName is a DayOfWeek written as its upper case name by its codecs, such as "MONDAY",
+// which is how Java serializes DayOfWeek. Parsing accepts the same forms as DayOfWeek.
+// The zero value is JSON null and SQL NULL.
+type DayOfWeekName DayOfWeek
+
+// DayOfWeek returns the day-of-week.
+func (d DayOfWeekName) DayOfWeek() DayOfWeek {
+	return DayOfWeek(d)
+}
+
+// String returns the upper case name of the day-of-week (e.g., "MONDAY").
+// Returns empty string for zero value.
+func (d DayOfWeekName) String() string {
+	return DayOfWeek(d).Format(EnumFormatName)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (d DayOfWeekName) AppendText(b []byte) ([]byte, error) {
+	return append(b, d.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d DayOfWeekName) MarshalText() ([]byte, error) {
+	return marshalTextImpl(d)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, same as DayOfWeek.UnmarshalText.
+func (d *DayOfWeekName) UnmarshalText(text []byte) error {
+	return (*DayOfWeek)(d).UnmarshalText(text)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d DayOfWeekName) MarshalJSON() ([]byte, error) {
+	return marshalEnumNameJson(FieldDayOfWeek, int64(d)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, same as DayOfWeek.UnmarshalJSON.
+func (d *DayOfWeekName) UnmarshalJSON(data []byte) error {
+	return (*DayOfWeek)(d).UnmarshalJSON(data)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil for zero value, otherwise the upper case name.
+func (d DayOfWeekName) Value() (driver.Value, error) {
+	if DayOfWeek(d).IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
+
+// Scan implements the sql.Scanner interface, same as DayOfWeek.Scan.
+func (d *DayOfWeekName) Scan(src any) error {
+	return (*DayOfWeek)(d).Scan(src)
+}
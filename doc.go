@@ -5,17 +5,43 @@
 //   - LocalDate: A date without time (e.g., 2024-03-15)
 //   - LocalTime: A time without date (e.g., 14:30:45.123456789)
 //   - LocalDateTime: A date-time without timezone (e.g., 2024-03-15T14:30:45.123456789)
+//   - MonthDay: A month-day without a year, such as a birthday (e.g., --12-03)
+//   - YearWeek: An ISO-8601 week of a week-based-year (e.g., 2024-W52)
+//   - YearQuarter: A quarter of a year, such as for financial reports (e.g., 2024-Q1)
+//   - Interval: A half-open interval of instants (e.g., 2024-03-15T10:00:00Z/2024-03-15T12:00:00Z)
+//   - LocalDateRange: A half-open range of dates (e.g., [2024-01-01,2024-02-01))
 //   - ZoneOffset: A time-zone offset from UTC (e.g., +08:00, -05:00, Z)
 //   - OffsetDateTime: A date-time with offset from UTC (e.g., 2024-03-15T14:30:45+08:00)
-//   - Year, Month, DayOfWeek: Supporting types for date/time operations
+//   - OffsetTime: A time with offset from UTC, such as a PostgreSQL timetz (e.g., 09:00+08:00)
+//   - ZonedDateTime: A date-time with a time-zone, resolving DST gaps and overlaps (e.g., 2024-03-15T14:30:45+01:00[Europe/Paris])
+//   - ZoneRules: The offset transitions and daylight saving time of a ZoneId, obtained by ZoneId.Rules
+//   - ZoneRulesProvider: A source of TZif data consulted by ZoneIdOf before the host's zone database,
+//     see AvailableZoneIds and ZoneRulesVersion
+//   - Instant: An instantaneous point on the UTC time-line (e.g., 2024-03-15T06:30:45Z)
+//   - Duration: A time-based amount of time (e.g., PT8H6M12.345S)
+//   - Period: A date-based amount of time (e.g., P1Y2M3D)
+//   - Year, Month, Quarter, DayOfWeek: Supporting types for date/time operations
 //
 // All types implement standard interfaces for serialization:
 //   - encoding.TextMarshaler and encoding.TextUnmarshaler (ISO 8601 basic format)
 //   - encoding.json.Marshaler and encoding.json.Unmarshaler
 //   - database/sql.Scanner and database/sql/driver.Valuer
 //
+// Year, Month and DayOfWeek are written as numbers by default. Month and DayOfWeek accept names such as "MARCH"
+// or "Mar" as well. MonthName and DayOfWeekName are written as names, and Format writes a value in any EnumFormat.
+//
+// Scan accepts the PostgreSQL infinity and BC forms of dates and date-times,
+// PostgresValue wraps a value so Value produces them as well.
+//
+// The pgxgoda package registers native pgx codecs for the types, with the binary protocol and arrays.
+//
 // Note: This package uses ISO 8601 basic formats only (yyyy-MM-dd, HH:mm:ss[.nnnnnnnnn]),
 // not the full complex ISO 8601 specification (no week dates, ordinal dates, or timezone offsets).
+// ISO week-based-years are available through YearWeek and FieldWeekOfWeekBasedYear,
+// other week definitions such as weeks starting on Sunday through WeekFields.
+//
+// The current date and time can be read from a Clock with the NowClock functions, such as LocalDateNowClock,
+// so tests can inject a fixed or a ManualClock instead of reading the system clock.
 //
 // # Quick Start
 //
@@ -37,6 +63,29 @@
 //   - Use LocalTime when you only need a time (e.g., office hours, schedules)
 //   - Convert to/from time.Time when timezone information is needed
 //
+// # Adjusters
+//
+// TemporalAdjuster adjusts the date of a LocalDate, LocalDateTime or OffsetDateTime chain through With,
+// such as LastDayOfMonth, Next(Monday) or DayOfWeekInMonth(2, Tuesday).
+// TemporalAdjusterFunc turns a function into an adjuster, which is handy for composing the standard ones.
+//
+// # Units
+//
+// Unit measures and adds amounts of time, such as UnitDays or UnitMonths, similar to Java's ChronoUnit.
+// UntilUnit on each temporal type, or Unit.Between, counts the complete units between two values,
+// PlusUnit and MinusUnit on the chains add them, and TruncatedTo drops the smaller fields of a time.
+//
+// # Custom Patterns
+//
+// DateTimeFormatter formats any TemporalAccessor and parses text using Java-style patterns,
+// such as "dd/MM/yyyy HH:mm" or "d-MMM-yyyy". See DateTimeFormatterOfPattern for the supported letters.
+// DateTimeFormatterBuilder builds formatters in code.
+//
+// Parsed fields are resolved according to the ResolverStyle of the formatter, which follows Java's rules:
+// ResolverStyleStrict rejects "2024-02-30", ResolverStyleSmart (the default) clamps it to 2024-02-29,
+// and ResolverStyleLenient rolls "2024-13-01" over to 2025-01-01.
+// ResolveFields resolves a map of field values into the best-fitting type with the same rules.
+//
 // # Format Specification
 //
 // This package uses ISO 8601 basic calendar date and time formats (not the full specification):
@@ -53,10 +102,40 @@
 //   - LocalDateTime: yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn] (e.g., "2024-03-15T14:30:45.123456789")
 //     Combined with 'T' separator (lowercase 't' accepted when parsing).
 //
+//   - MonthDay: --MM-dd (e.g., "--12-03")
+//     February 29 is valid, AtYear turns it into February 28 in a non-leap year.
+//
+//   - YearWeek: yyyy-Www (e.g., "2024-W52")
+//     The week-based-year and the ISO-8601 week, see FieldWeekOfWeekBasedYear.
+//
+//   - YearQuarter: yyyy-Qq (e.g., "2024-Q1")
+//
+//   - Interval: ISO 8601 start/end (e.g., "2024-03-15T10:00:00Z/2024-03-15T12:00:00Z")
+//     start/duration and duration/end are accepted when parsing, ".." is an unbounded end.
+//     Scan and Value use the PostgreSQL tstzrange format.
+//
+//   - LocalDateRange: [yyyy-MM-dd,yyyy-MM-dd) (e.g., "[2024-01-01,2024-02-01)")
+//     The start is inclusive and the end is exclusive, same as PostgreSQL daterange.
+//
 //   - ZoneOffset: ±HH:mm[:ss] or Z for UTC (e.g., "+08:00", "-05:30", "Z")
 //     Hours must be in range [-18, 18], minutes and seconds in [0, 59].
 //     Compact formats (±HH, ±HHMM, ±HHMMSS) are also supported.
 //
 //   - OffsetDateTime: yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]±HH:mm[:ss] (e.g., "2024-03-15T14:30:45+08:00")
 //     Combines LocalDateTime and ZoneOffset. 'Z' is accepted as UTC offset.
+//
+//   - OffsetTime: HH:mm:ss[.nnnnnnnnn]±HH:mm[:ss] (e.g., "09:00:00+08:00")
+//     Combines LocalTime and ZoneOffset. Compact offsets such as "09:00:00+08" are accepted when parsing.
+//
+//   - Instant: yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]Z (e.g., "2024-03-15T06:30:45Z")
+//     Always formatted in UTC. Any offset is accepted when parsing.
+//
+//   - ZonedDateTime: yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]±HH:mm[:ss][ZoneId] (e.g., "2024-03-15T14:30:45+01:00[Europe/Paris]")
+//     Combines OffsetDateTime and ZoneId. The bracketed zone is omitted for fixed-offset zones.
+//
+//   - Duration: PTnHnMn.nS (e.g., "PT8H6M12.345S")
+//     Same as Java's Duration#toString. Days (PnDTnHnMnS) and a leading sign are accepted when parsing.
+//
+//   - Period: PnYnMnD (e.g., "P1Y2M3D")
+//     Same as Java's Period#toString. Weeks (PnW) and a leading sign are accepted when parsing.
 package goda
@@ -0,0 +1,249 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Duration represents a time-based amount of time, such as 8 hours 6 minutes 12.345 seconds.
+// It stores a number of seconds and a nanosecond adjustment (0-999,999,999), so its range
+// (about ±292 billion years) is far wider than time.Duration's ±292 years.
+//
+// A day is always treated as exactly 24 hours.
+//
+// Duration is comparable and can be used as a map key.
+// The zero value represents an unset duration and IsZero returns true for it.
+// Note: a duration of zero length (PT0S, see DurationZero) is a valid duration and is different from the zero value.
+//
+// Duration implements sql.Scanner and driver.Valuer for database operations,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: ISO 8601 PTnHnMn.nS (e.g., "PT8H6M12.345S"), matching Java's Duration#toString.
+// When parsing, a day part (e.g., "P2DT3H") and a leading sign are also accepted.
+// When scanning from a database, PostgreSQL interval output (e.g., "1 day 02:03:04.5") is also accepted.
+type Duration struct {
+	seconds int64
+	nanos   int32
+	valid   bool
+}
+
+// Seconds returns the number of seconds in this duration.
+// The nanosecond part is always positive, so -0.5s is represented as -1 second and 500,000,000 nanoseconds.
+func (d Duration) Seconds() int64 {
+	return d.seconds
+}
+
+// Nano returns the nanosecond adjustment to the seconds (0-999,999,999).
+func (d Duration) Nano() int {
+	return int(d.nanos)
+}
+
+// IsZero returns true if this is the zero value of Duration.
+// Use Compare with DurationZero to test for a zero-length duration.
+func (d Duration) IsZero() bool {
+	return !d.valid
+}
+
+// IsNegative returns true if this duration is strictly less than zero length.
+func (d Duration) IsNegative() bool {
+	return d.seconds < 0
+}
+
+// IsPositive returns true if this duration is strictly greater than zero length.
+func (d Duration) IsPositive() bool {
+	return d.seconds > 0 || (d.seconds == 0 && d.nanos > 0)
+}
+
+// ToDays returns the number of whole days (24 hours) in this duration, truncated towards zero.
+func (d Duration) ToDays() int64 {
+	return d.seconds / 86400
+}
+
+// ToHours returns the number of whole hours in this duration, truncated towards zero.
+func (d Duration) ToHours() int64 {
+	return d.seconds / 3600
+}
+
+// ToMinutes returns the number of whole minutes in this duration, truncated towards zero.
+func (d Duration) ToMinutes() int64 {
+	return d.seconds / 60
+}
+
+// ToMillis returns the total length of this duration in milliseconds, truncated towards zero.
+// If the result cannot be represented as int64, it is clamped to math.MinInt64 or math.MaxInt64.
+func (d Duration) ToMillis() int64 {
+	return d.toUnits(1000_000)
+}
+
+// ToNanos returns the total length of this duration in nanoseconds.
+// If the result cannot be represented as int64, it is clamped to math.MinInt64 or math.MaxInt64.
+func (d Duration) ToNanos() int64 {
+	return d.toUnits(1)
+}
+
+func (d Duration) toUnits(nanosPerUnit int64) int64 {
+	var seconds, nanos = d.seconds, int64(d.nanos)
+	if seconds < 0 && nanos > 0 {
+		// truncate towards zero
+		seconds++
+		nanos -= 1000_000_000
+	}
+	r, overflow := mulExact(seconds, 1000_000_000/nanosPerUnit)
+	if !overflow {
+		r, overflow = addExactly(r, nanos/nanosPerUnit)
+	}
+	if overflow {
+		if seconds < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return r
+}
+
+// ToHoursPart returns the hours part of this duration (0-23 for positive durations), the days are excluded.
+func (d Duration) ToHoursPart() int {
+	return int(d.ToHours() % 24)
+}
+
+// ToMinutesPart returns the minutes part of this duration (0-59 for positive durations).
+func (d Duration) ToMinutesPart() int {
+	return int(d.ToMinutes() % 60)
+}
+
+// ToSecondsPart returns the seconds part of this duration (0-59 for positive durations).
+func (d Duration) ToSecondsPart() int {
+	return int(d.seconds % 60)
+}
+
+// ToMillisPart returns the milliseconds part of this duration (0-999).
+func (d Duration) ToMillisPart() int {
+	return int(d.nanos / 1000_000)
+}
+
+// ToNanosPart returns the nanoseconds part of this duration (0-999,999,999).
+func (d Duration) ToNanosPart() int {
+	return int(d.nanos)
+}
+
+// GoDuration converts this duration to a time.Duration.
+// If the duration is outside the range of time.Duration, it is clamped to the minimum or maximum time.Duration.
+// Returns 0 for zero value.
+func (d Duration) GoDuration() time.Duration {
+	return time.Duration(d.ToNanos())
+}
+
+// Compare compares this duration with another duration.
+// Returns -1 if this duration is shorter than other, 0 if equal, and 1 if longer.
+// Zero values are considered less than non-zero values.
+func (d Duration) Compare(other Duration) int {
+	return doCompare(d, other, compareZero, comparing(Duration.Seconds), comparing(Duration.Nano))
+}
+
+func (d Duration) Chain() (chain DurationChain) {
+	chain.value = d
+	return
+}
+
+func (d Duration) chainWithError(e error) (chain DurationChain) {
+	chain = d.Chain()
+	chain.eError = e
+	return
+}
+
+// DurationOfSeconds creates a Duration from a number of seconds and a nanosecond adjustment.
+// The nanosecond adjustment may be outside the range 0-999,999,999 (including negative),
+// it is normalized into the seconds.
+//
+// Returns an error if the result overflows.
+func DurationOfSeconds(seconds int64, nanoAdjustment int64) (r Duration, e error) {
+	s, overflow := addExactly(seconds, floorDiv(nanoAdjustment, 1000_000_000))
+	if overflow {
+		e = overflowError()
+		return
+	}
+	return Duration{
+		seconds: s,
+		nanos:   int32(floorMod(nanoAdjustment, 1000_000_000)),
+		valid:   true,
+	}, nil
+}
+
+// MustDurationOfSeconds creates a Duration from a number of seconds and a nanosecond adjustment.
+// Panics if the result overflows. Use DurationOfSeconds for error handling.
+func MustDurationOfSeconds(seconds int64, nanoAdjustment int64) Duration {
+	return mustValue(DurationOfSeconds(seconds, nanoAdjustment))
+}
+
+// DurationOfMillis creates a Duration from a number of milliseconds.
+func DurationOfMillis(millis int64) Duration {
+	return Duration{
+		seconds: floorDiv(millis, 1000),
+		nanos:   int32(floorMod(millis, 1000) * 1000_000),
+		valid:   true,
+	}
+}
+
+// DurationOfNanos creates a Duration from a number of nanoseconds.
+func DurationOfNanos(nanos int64) Duration {
+	return Duration{
+		seconds: floorDiv(nanos, 1000_000_000),
+		nanos:   int32(floorMod(nanos, 1000_000_000)),
+		valid:   true,
+	}
+}
+
+// DurationOfGoDuration creates a Duration from a time.Duration.
+// Unlike other OfGoXxx constructors, a time.Duration of 0 is converted to DurationZero, not the zero value.
+func DurationOfGoDuration(d time.Duration) Duration {
+	return DurationOfNanos(int64(d))
+}
+
+// DurationZero returns a duration of zero length (PT0S).
+func DurationZero() Duration {
+	return Duration{valid: true}
+}
+
+// DurationParse parses a duration string in ISO 8601 PnDTnHnMn.nS format, such as "PT8H6M12.345S".
+// The format follows Java's Duration#parse:
+//   - An optional leading sign negates the whole duration, e.g. "-PT6H3M".
+//   - Each part may have its own sign, e.g. "PT+3H-2M".
+//   - Only the seconds part may have a fraction of up to 9 digits, using '.' or ','.
+//   - Letters are case-insensitive.
+//
+// Returns an error if the string is invalid or the result overflows.
+func DurationParse(s string) (Duration, error) {
+	var d Duration
+	err := d.UnmarshalText([]byte(s))
+	return d, err
+}
+
+// MustDurationParse parses a duration string in ISO 8601 PnDTnHnMn.nS format.
+// Panics if the string is invalid. Use DurationParse for error handling.
+func MustDurationParse(s string) Duration {
+	return mustValue(DurationParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*Duration)(nil)
+	_ fmt.Stringer             = (*Duration)(nil)
+	_ encoding.TextMarshaler   = (*Duration)(nil)
+	_ encoding.TextUnmarshaler = (*Duration)(nil)
+	_ json.Marshaler           = (*Duration)(nil)
+	_ json.Unmarshaler         = (*Duration)(nil)
+	_ driver.Valuer            = (*Duration)(nil)
+	_ sql.Scanner              = (*Duration)(nil)
+	_ TemporalAmount           = (*Duration)(nil)
+)
+
+// Compile-time check that Duration is comparable
+func _assertDurationIsComparable[T comparable](t T) {}
+
+var _ = _assertDurationIsComparable[Duration]
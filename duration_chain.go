@@ -0,0 +1,270 @@
+package goda
+
+import (
+	"math"
+	"math/big"
+)
+
+type DurationChain struct {
+	Chain[Duration]
+}
+
+func (d DurationChain) PlusDays(days int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnPlusDays)
+	return d.plusUnits(days, 86400)
+}
+
+func (d DurationChain) MinusDays(days int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMinusDays)
+	if days == math.MinInt64 {
+		return d.PlusDays(math.MaxInt64).PlusDays(1)
+	}
+	return d.PlusDays(-days)
+}
+
+func (d DurationChain) PlusHours(hours int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnPlusHours)
+	return d.plusUnits(hours, 3600)
+}
+
+func (d DurationChain) MinusHours(hours int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMinusHours)
+	if hours == math.MinInt64 {
+		return d.PlusHours(math.MaxInt64).PlusHours(1)
+	}
+	return d.PlusHours(-hours)
+}
+
+func (d DurationChain) PlusMinutes(minutes int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnPlusMinutes)
+	return d.plusUnits(minutes, 60)
+}
+
+func (d DurationChain) MinusMinutes(minutes int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMinusMinutes)
+	if minutes == math.MinInt64 {
+		return d.PlusMinutes(math.MaxInt64).PlusMinutes(1)
+	}
+	return d.PlusMinutes(-minutes)
+}
+
+func (d DurationChain) PlusSeconds(seconds int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnPlusSeconds)
+	return d.plus(seconds, 0)
+}
+
+func (d DurationChain) MinusSeconds(seconds int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMinusSeconds)
+	if seconds == math.MinInt64 {
+		return d.PlusSeconds(math.MaxInt64).PlusSeconds(1)
+	}
+	return d.PlusSeconds(-seconds)
+}
+
+func (d DurationChain) PlusMillis(millis int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnPlusMillis)
+	return d.plus(millis/1000, millis%1000*1000_000)
+}
+
+func (d DurationChain) MinusMillis(millis int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMinusMillis)
+	if millis == math.MinInt64 {
+		return d.PlusMillis(math.MaxInt64).PlusMillis(1)
+	}
+	return d.PlusMillis(-millis)
+}
+
+func (d DurationChain) PlusNanos(nanos int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnPlusNanos)
+	return d.plus(0, nanos)
+}
+
+func (d DurationChain) MinusNanos(nanos int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMinusNanos)
+	if nanos == math.MinInt64 {
+		return d.PlusNanos(math.MaxInt64).PlusNanos(1)
+	}
+	return d.PlusNanos(-nanos)
+}
+
+// Plus returns a copy of this duration with the specified duration added.
+// A zero value duration is treated as zero length.
+func (d DurationChain) Plus(duration Duration) DurationChain {
+	defer d.leaveFunction(tyDuration, fnPlus)
+	return d.plus(duration.seconds, int64(duration.nanos))
+}
+
+// Minus returns a copy of this duration with the specified duration subtracted.
+// A zero value duration is treated as zero length.
+func (d DurationChain) Minus(duration Duration) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMinus)
+	if duration.seconds == math.MinInt64 {
+		return d.plus(math.MaxInt64, 0).plus(1, -int64(duration.nanos))
+	}
+	return d.plus(-duration.seconds, -int64(duration.nanos))
+}
+
+// PlusUnit returns a copy of this duration with the amount of the unit added, such as 90 UnitMinutes.
+// The units with an exact duration are supported, from UnitNanos to UnitDays, a day is 86400 seconds.
+// Otherwise an error wrapping ErrUnsupported is returned.
+func (d DurationChain) PlusUnit(amount int64, unit Unit) DurationChain {
+	defer d.leaveFunction(tyDuration, fnPlusUnit)
+	if !d.ok() {
+		return d
+	}
+	if !unit.IsTimeBased() && unit != UnitDays {
+		d.eError = unsupportedUnit(unit)
+		return d
+	}
+	seconds, nanos, overflow := unit.secondsNanos(amount)
+	if overflow {
+		d.eError = overflowError()
+		return d
+	}
+	return d.plus(seconds, nanos)
+}
+
+// MinusUnit returns a copy of this duration with the amount of the unit subtracted, see PlusUnit.
+func (d DurationChain) MinusUnit(amount int64, unit Unit) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMinusUnit)
+	if amount == math.MinInt64 {
+		return d.PlusUnit(math.MaxInt64, unit).PlusUnit(1, unit)
+	}
+	return d.PlusUnit(-amount, unit)
+}
+
+func (d DurationChain) plusUnits(amount int64, secondsPerUnit int64) DurationChain {
+	if !d.ok() {
+		return d
+	}
+	seconds, overflow := mulExact(amount, secondsPerUnit)
+	if overflow {
+		d.eError = overflowError()
+		return d
+	}
+	return d.plus(seconds, 0)
+}
+
+func (d DurationChain) plus(seconds, nanos int64) DurationChain {
+	if !d.ok() {
+		return d
+	}
+	if seconds|nanos == 0 {
+		return d
+	}
+	s, overflow := addExactly(d.value.seconds, seconds)
+	if !overflow {
+		s, overflow = addExactly(s, nanos/1000_000_000)
+	}
+	if overflow {
+		d.eError = overflowError()
+		return d
+	}
+	d.value, d.eError = DurationOfSeconds(s, int64(d.value.nanos)+nanos%1000_000_000)
+	return d
+}
+
+// MultipliedBy returns a copy of this duration multiplied by the scalar.
+// Returns an error if the result overflows.
+func (d DurationChain) MultipliedBy(multiplicand int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnMultipliedBy)
+	if !d.ok() {
+		return d
+	}
+	if multiplicand == 1 {
+		return d
+	}
+	var n = d.value.totalNanos()
+	n.Mul(n, big.NewInt(multiplicand))
+	d.value, d.eError = durationOfTotalNanos(n)
+	return d
+}
+
+// DividedBy returns a copy of this duration divided by the specified value.
+// The result is truncated towards zero, to nanosecond precision.
+// Returns an error if the divisor is zero.
+func (d DurationChain) DividedBy(divisor int64) DurationChain {
+	defer d.leaveFunction(tyDuration, fnDividedBy)
+	if !d.ok() {
+		return d
+	}
+	if divisor == 0 {
+		d.eError = newError("division by zero")
+		return d
+	}
+	if divisor == 1 {
+		return d
+	}
+	var n = d.value.totalNanos()
+	n.Quo(n, big.NewInt(divisor))
+	d.value, d.eError = durationOfTotalNanos(n)
+	return d
+}
+
+// Negated returns a copy of this duration with the length negated.
+// Returns an error if the result overflows, which only happens for the minimum duration.
+func (d DurationChain) Negated() DurationChain {
+	defer d.leaveFunction(tyDuration, fnNegated)
+	if !d.ok() {
+		return d
+	}
+	if d.value.nanos == 0 {
+		if d.value.seconds == math.MinInt64 {
+			d.eError = overflowError()
+			return d
+		}
+		d.value.seconds = -d.value.seconds
+		return d
+	}
+	// -(s + n/1e9) == (-s - 1) + (1e9 - n)/1e9
+	d.value.seconds = ^d.value.seconds
+	d.value.nanos = 1000_000_000 - d.value.nanos
+	return d
+}
+
+// Abs returns a copy of this duration with a positive length.
+// Returns an error if the result overflows, which only happens for the minimum duration.
+func (d DurationChain) Abs() DurationChain {
+	defer d.leaveFunction(tyDuration, fnAbs)
+	if d.value.IsNegative() {
+		return d.Negated()
+	}
+	return d
+}
+
+// TruncatedTo returns a copy of this duration truncated to the unit, such as UnitMinutes.
+// Truncation is towards zero, so -90 seconds truncated to UnitMinutes is -60 seconds.
+//
+// As in Java's Duration#truncatedTo, the units from UnitNanos to UnitDays are supported,
+// otherwise an error wrapping ErrUnsupported is returned.
+func (d DurationChain) TruncatedTo(unit Unit) DurationChain {
+	defer d.leaveFunction(tyDuration, fnTruncatedTo)
+	if !d.ok() {
+		return d
+	}
+	if !unit.IsTimeBased() && unit != UnitDays {
+		d.eError = unsupportedUnit(unit)
+		return d
+	}
+	var dur = unit.nanos()
+	if dur == 1 || (dur == 1000_000_000 && d.value.nanos == 0) {
+		return d
+	}
+	var nod = d.value.seconds%86400*1000_000_000 + int64(d.value.nanos)
+	return d.plus(0, nod/dur*dur-nod)
+}
+
+func (d Duration) totalNanos() *big.Int {
+	var n = big.NewInt(d.seconds)
+	n.Mul(n, big.NewInt(1000_000_000))
+	return n.Add(n, big.NewInt(int64(d.nanos)))
+}
+
+func durationOfTotalNanos(n *big.Int) (r Duration, e error) {
+	var seconds, nanos = new(big.Int).DivMod(n, big.NewInt(1000_000_000), new(big.Int))
+	if !seconds.IsInt64() {
+		e = overflowError()
+		return
+	}
+	return Duration{seconds: seconds.Int64(), nanos: int32(nanos.Int64()), valid: true}, nil
+}
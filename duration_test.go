@@ -0,0 +1,281 @@
+package goda
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationOf(t *testing.T) {
+	d, err := DurationOfSeconds(10, -1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), d.Seconds())
+	assert.Equal(t, 999_999_999, d.Nano())
+
+	_, err = DurationOfSeconds(math.MaxInt64, 1_000_000_000)
+	assert.ErrorIs(t, err, ErrArithmeticOverflow)
+
+	assert.Equal(t, MustDurationOfSeconds(-1, 500_000_000), DurationOfMillis(-500))
+	assert.Equal(t, MustDurationOfSeconds(-1, 999_999_999), DurationOfNanos(-1))
+	assert.Equal(t, DurationOfMillis(1500), DurationOfGoDuration(1500*time.Millisecond))
+	assert.False(t, DurationZero().IsZero())
+	assert.True(t, Duration{}.IsZero())
+	assert.Equal(t, DurationZero(), DurationOfGoDuration(0))
+}
+
+func TestDuration_Accessors(t *testing.T) {
+	d := MustDurationParse("P1DT2H3M4.005S")
+	assert.Equal(t, int64(1), d.ToDays())
+	assert.Equal(t, int64(26), d.ToHours())
+	assert.Equal(t, int64(26*60+3), d.ToMinutes())
+	assert.Equal(t, int64(93784005), d.ToMillis())
+	assert.Equal(t, int64(93784005000000), d.ToNanos())
+	assert.Equal(t, 2, d.ToHoursPart())
+	assert.Equal(t, 3, d.ToMinutesPart())
+	assert.Equal(t, 4, d.ToSecondsPart())
+	assert.Equal(t, 5, d.ToMillisPart())
+	assert.Equal(t, 5_000_000, d.ToNanosPart())
+	assert.True(t, d.IsPositive())
+	assert.False(t, d.IsNegative())
+	assert.False(t, DurationZero().IsPositive())
+	assert.False(t, DurationZero().IsNegative())
+
+	neg := DurationOfMillis(-1500)
+	assert.True(t, neg.IsNegative())
+	assert.Equal(t, int64(-1500), neg.ToMillis())
+	assert.Equal(t, -1500*time.Millisecond, neg.GoDuration())
+
+	big := MustDurationOfSeconds(math.MaxInt64, 0)
+	assert.Equal(t, int64(math.MaxInt64), big.ToNanos())
+	assert.Equal(t, time.Duration(math.MaxInt64), big.GoDuration())
+	assert.Equal(t, int64(math.MinInt64), MustDurationOfSeconds(math.MinInt64, 0).ToMillis())
+}
+
+func TestDuration_Compare(t *testing.T) {
+	a := DurationOfMillis(-1)
+	b := DurationZero()
+	c := DurationOfNanos(1)
+	assert.Equal(t, -1, a.Compare(b))
+	assert.Equal(t, -1, b.Compare(c))
+	assert.Equal(t, 1, c.Compare(a))
+	assert.Equal(t, 0, c.Compare(DurationOfNanos(1)))
+	assert.Equal(t, -1, Duration{}.Compare(a))
+}
+
+func TestDurationChain(t *testing.T) {
+	d := MustDurationParse("PT1H")
+
+	t.Run("plus and minus", func(t *testing.T) {
+		assert.Equal(t, "PT25H", d.Chain().PlusDays(1).MustGet().String())
+		assert.Equal(t, "PT2H", d.Chain().PlusHours(1).MustGet().String())
+		assert.Equal(t, "PT59M", d.Chain().MinusMinutes(1).MustGet().String())
+		assert.Equal(t, "PT59M59.999S", d.Chain().MinusMillis(1).MustGet().String())
+		assert.Equal(t, "PT1H0.000000001S", d.Chain().PlusNanos(1).MustGet().String())
+		assert.Equal(t, "PT-1H", d.Chain().MinusSeconds(7200).MustGet().String())
+		assert.Equal(t, "PT1H30M", d.Chain().Plus(MustDurationParse("PT30M")).MustGet().String())
+		assert.Equal(t, "PT30M", d.Chain().Minus(MustDurationParse("PT30M")).MustGet().String())
+		assert.Equal(t, d, d.Chain().Plus(Duration{}).MustGet())
+		assert.Equal(t, d, d.Chain().MinusDays(1).PlusHours(24).MustGet())
+
+		_, err := d.Chain().PlusDays(math.MaxInt64).GetResult()
+		assert.ErrorIs(t, err, ErrArithmeticOverflow)
+		_, err = MustDurationOfSeconds(math.MaxInt64, 999_999_999).Chain().PlusNanos(1).GetResult()
+		assert.ErrorIs(t, err, ErrArithmeticOverflow)
+		v, err := DurationZero().Chain().Minus(MustDurationOfSeconds(math.MinInt64+1, 0)).GetResult()
+		require.NoError(t, err)
+		assert.Equal(t, int64(math.MaxInt64), v.Seconds())
+
+		assert.True(t, Duration{}.Chain().PlusHours(1).MustGet().IsZero())
+	})
+
+	t.Run("multiply and divide", func(t *testing.T) {
+		assert.Equal(t, "PT3H", d.Chain().MultipliedBy(3).MustGet().String())
+		assert.Equal(t, "PT-1.5S", DurationOfMillis(500).Chain().MultipliedBy(-3).MustGet().String())
+		assert.Equal(t, "PT20M", d.Chain().DividedBy(3).MustGet().String())
+		assert.Equal(t, "PT-0.333333333S", MustDurationOfSeconds(1, 0).Chain().DividedBy(-3).MustGet().String())
+		_, err := d.Chain().MultipliedBy(math.MaxInt64).GetResult()
+		assert.ErrorIs(t, err, ErrArithmeticOverflow)
+		_, err = d.Chain().DividedBy(0).GetResult()
+		assert.Error(t, err)
+	})
+
+	t.Run("negate and abs", func(t *testing.T) {
+		assert.Equal(t, DurationOfMillis(-1500), DurationOfMillis(1500).Chain().Negated().MustGet())
+		assert.Equal(t, DurationOfMillis(1500), DurationOfMillis(-1500).Chain().Negated().MustGet())
+		assert.Equal(t, DurationOfMillis(1500), DurationOfMillis(-1500).Chain().Abs().MustGet())
+		assert.Equal(t, DurationOfMillis(1500), DurationOfMillis(1500).Chain().Abs().MustGet())
+		assert.Equal(t, DurationZero(), DurationZero().Chain().Negated().MustGet())
+		_, err := MustDurationOfSeconds(math.MinInt64, 0).Chain().Negated().GetResult()
+		assert.ErrorIs(t, err, ErrArithmeticOverflow)
+		v, err := MustDurationOfSeconds(math.MinInt64, 1).Chain().Negated().GetResult()
+		require.NoError(t, err)
+		assert.Equal(t, MustDurationOfSeconds(math.MaxInt64, 999_999_999), v)
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		v := MustDurationParse("PT26H3M4.5678912S")
+		assert.Equal(t, "PT26H3M", v.Chain().TruncatedTo(UnitMinutes).MustGet().String())
+		assert.Equal(t, "PT26H3M4S", v.Chain().TruncatedTo(UnitSeconds).MustGet().String())
+		assert.Equal(t, "PT26H3M4.567S", v.Chain().TruncatedTo(UnitMillis).MustGet().String())
+		assert.Equal(t, "PT26H3M4.567891S", v.Chain().TruncatedTo(UnitMicros).MustGet().String())
+		assert.Equal(t, v, v.Chain().TruncatedTo(UnitNanos).MustGet())
+		assert.Equal(t, "PT26H", v.Chain().TruncatedTo(UnitHours).MustGet().String())
+		assert.Equal(t, "PT24H", v.Chain().TruncatedTo(UnitHalfDays).MustGet().String())
+		assert.Equal(t, "PT24H", v.Chain().TruncatedTo(UnitDays).MustGet().String())
+		assert.Equal(t, "PT-1M", DurationOfMillis(-90_500).Chain().TruncatedTo(UnitMinutes).MustGet().String())
+		assert.Equal(t, "PT-1S", DurationOfMillis(-1500).Chain().TruncatedTo(UnitSeconds).MustGet().String())
+
+		for _, unit := range []Unit{UnitWeeks, UnitMonths, UnitForever, 0} {
+			_, err := v.Chain().TruncatedTo(unit).GetResult()
+			assert.ErrorIs(t, err, ErrUnsupported, unit.String())
+		}
+	})
+}
+
+func TestDuration_Text(t *testing.T) {
+	t.Run("format", func(t *testing.T) {
+		for _, it := range [][2]string{
+			{"PT0S", "PT0S"},
+			{"PT8H6M12.345S", "PT8H6M12.345S"},
+			{"PT-8H-6M-12.345S", "PT-8H-6M-12.345S"},
+			{"P2D", "PT48H"},
+			{"PT0.5S", "PT0.5S"},
+			{"PT-0.5S", "PT-0.5S"},
+			{"-PT0.5S", "PT-0.5S"},
+			{"PT-1.000000001S", "PT-1.000000001S"},
+			{"PT90M", "PT1H30M"},
+			{"pt1h", "PT1H"},
+			{"PT1,5S", "PT1.5S"},
+			{"-PT-6H+3M", "PT5H57M"},
+			{"P1DT-1H", "PT23H"},
+			{"+PT1S", "PT1S"},
+		} {
+			d, err := DurationParse(it[0])
+			require.NoError(t, err, it[0])
+			assert.Equal(t, it[1], d.String(), it[0])
+		}
+		assert.Equal(t, "", Duration{}.String())
+	})
+
+	t.Run("parse errors", func(t *testing.T) {
+		for _, s := range []string{
+			"P", "PT", "T1H", "P1H", "PT1D", "PT1.5H", "PT1.1234567890S", "PT1", "PTS", "P1DT", "PT1HT1M", "PT1X",
+			"PT9223372036854775807H", "PT99999999999999999999S",
+		} {
+			_, err := DurationParse(s)
+			assert.Error(t, err, s)
+		}
+		d, err := DurationParse("")
+		require.NoError(t, err)
+		assert.True(t, d.IsZero())
+		assert.Panics(t, func() { MustDurationParse("invalid") })
+	})
+
+	t.Run("json", func(t *testing.T) {
+		type S struct {
+			D Duration `json:"d"`
+		}
+		data, err := json.Marshal(S{D: MustDurationParse("PT8H6M12.345S")})
+		require.NoError(t, err)
+		assert.Equal(t, `{"d":"PT8H6M12.345S"}`, string(data))
+
+		var s S
+		require.NoError(t, json.Unmarshal(data, &s))
+		assert.Equal(t, MustDurationParse("PT8H6M12.345S"), s.D)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"d":null}`), &s))
+		assert.True(t, s.D.IsZero())
+	})
+}
+
+func TestDuration_Scan(t *testing.T) {
+	for _, it := range [][2]string{
+		{"PT1H", "PT1H"},
+		{"00:00:00", "PT0S"},
+		{"02:03:04.5", "PT2H3M4.5S"},
+		{"-02:03:04", "PT-2H-3M-4S"},
+		{"-00:00:00.5", "PT-0.5S"},
+		{"123:00:00", "PT123H"},
+		{"838:59:59", "PT838H59M59S"},
+		{"-838:59:59.000000", "PT-838H-59M-59S"},
+		{"1 day", "PT24H"},
+		{"3 days 01:00:00", "PT73H"},
+		{"-1 days +02:00:00", "PT-22H"},
+		{"0 years 0 mons 1 day", "PT24H"},
+	} {
+		var d Duration
+		require.NoError(t, d.Scan(it[0]), it[0])
+		assert.Equal(t, it[1], d.String(), it[0])
+		require.NoError(t, d.Scan([]byte(it[0])), it[0])
+		assert.Equal(t, it[1], d.String(), it[0])
+	}
+
+	var d Duration
+	for _, s := range []string{"1 mon", "1 year 2 days", "1 week", "1", "01:60:00", "1:2:3:4", "xx:00:00", ""} {
+		assert.Error(t, d.Scan(s), s)
+	}
+	require.NoError(t, d.Scan(nil))
+	assert.True(t, d.IsZero())
+	assert.Error(t, d.Scan(1))
+
+	v, err := MustDurationParse("PT1H").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "PT1H", v)
+	v, err = Duration{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestDuration_PlusOnChains(t *testing.T) {
+	d := MustDurationParse("PT25H30M0.5S")
+
+	lt := MustLocalTimeParse("23:00")
+	assert.Equal(t, "00:30:00.500", lt.Chain().Plus(d).MustGet().String())
+	assert.Equal(t, "21:29:59.500", lt.Chain().Minus(d).MustGet().String())
+
+	ldt := MustLocalDateTimeParse("2024-03-15T23:00")
+	assert.Equal(t, "2024-03-17T00:30:00.500", ldt.Chain().Plus(d).MustGet().String())
+	assert.Equal(t, "2024-03-14T21:29:59.500", ldt.Chain().Minus(d).MustGet().String())
+	assert.Equal(t, ldt, ldt.Chain().Plus(d).Minus(d).MustGet())
+	_, err := LocalDateMax().AtTime(MustLocalTimeParse("23:00")).Chain().Plus(d).GetResult()
+	assert.Error(t, err)
+
+	odt := MustOffsetDateTimeParse("2024-03-15T23:00+08:00")
+	assert.Equal(t, "2024-03-17T00:30:00.500+08:00", odt.Chain().Plus(d).MustGet().String())
+	assert.Equal(t, "2024-03-14T21:29:59.500+08:00", odt.Chain().Minus(d).MustGet().String())
+
+	i := MustInstantParse("2024-03-15T23:00:00Z")
+	assert.Equal(t, "2024-03-17T00:30:00.500Z", i.Chain().Plus(d).MustGet().String())
+	assert.Equal(t, "2024-03-14T21:29:59.500Z", i.Chain().Minus(d).MustGet().String())
+
+	zdt := MustZonedDateTimeParse("2024-03-30T23:00:00+01:00[Europe/Paris]")
+	assert.Equal(t, "2024-04-01T01:30:00.500+02:00[Europe/Paris]", zdt.Chain().Plus(d).MustGet().String())
+	assert.Equal(t, zdt, zdt.Chain().Plus(d).Minus(d).MustGet())
+}
+
+func TestDuration_ValuePostgres(t *testing.T) {
+	var pg = GetPG(t)
+	expected := MustDurationParse("P1DT2H3M4.5S")
+	var actual Duration
+	err := pg.QueryRow("SELECT $1::interval", expected).Scan(&actual)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestDuration_ValueMySQL(t *testing.T) {
+	var mysql = GetMySQL(t)
+	for _, it := range [][2]string{
+		{"838:59:59", "PT838H59M59S"},
+		{"-100:30:00.5", "PT-100H-30M-0.5S"},
+		{"12:00:00", "PT12H"},
+	} {
+		var actual Duration
+		var e = mysql.QueryRow("SELECT CAST(? AS TIME(1))", it[0]).Scan(&actual)
+		assert.NoError(t, e)
+		assert.Equal(t, it[1], actual.String(), it[0])
+	}
+}
@@ -0,0 +1,244 @@
+package goda
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"strconv"
+)
+
+// String returns the ISO 8601 string representation (PTnHnMn.nS), such as "PT8H6M12.345S".
+func (d Duration) String() string {
+	return stringImpl(d)
+}
+
+// AppendText implements encoding.TextAppender.
+// The output matches Java's Duration#toString: days are expressed as hours,
+// zero parts are omitted, and a zero-length duration is formatted as "PT0S".
+// The fraction of the seconds is printed without trailing zeros.
+func (d Duration) AppendText(b []byte) ([]byte, error) {
+	if d.IsZero() {
+		return b, nil
+	}
+	if d.seconds == 0 && d.nanos == 0 {
+		return append(b, "PT0S"...), nil
+	}
+	var effectiveTotalSecs = d.seconds
+	if d.seconds < 0 && d.nanos > 0 {
+		effectiveTotalSecs++
+	}
+	hours := effectiveTotalSecs / 3600
+	minutes := (effectiveTotalSecs % 3600) / 60
+	secs := effectiveTotalSecs % 60
+	b = append(b, 'P', 'T')
+	if hours != 0 {
+		b = strconv.AppendInt(b, hours, 10)
+		b = append(b, 'H')
+	}
+	if minutes != 0 {
+		b = strconv.AppendInt(b, minutes, 10)
+		b = append(b, 'M')
+	}
+	if secs == 0 && d.nanos == 0 {
+		return b, nil
+	}
+	if d.seconds < 0 && d.nanos > 0 && secs == 0 {
+		b = append(b, '-', '0')
+	} else {
+		b = strconv.AppendInt(b, secs, 10)
+	}
+	if d.nanos > 0 {
+		var fraction = int64(d.nanos)
+		if d.seconds < 0 {
+			fraction = 1000_000_000 - fraction
+		}
+		var pos = len(b)
+		// the leading '1' keeps the leading zeros, it's replaced by '.'
+		b = strconv.AppendInt(b, fraction+1000_000_000, 10)
+		b = bytes.TrimRight(b, "0")
+		b[pos] = '.'
+	}
+	return append(b, 'S'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return marshalTextImpl(d)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Accepts ISO 8601 format: [-+]PnDTnHnMn.nS, see DurationParse for details.
+// Empty input is treated as zero value.
+func (d *Duration) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*d = Duration{}
+		return nil
+	}
+	var negate = false
+	var s = text
+	if s[0] == '+' || s[0] == '-' {
+		negate = s[0] == '-'
+		s = s[1:]
+	}
+	if len(s) == 0 || (s[0] != 'P' && s[0] != 'p') {
+		return errors.New("expect 'P'")
+	}
+	s = s[1:]
+	var r = DurationZero().Chain()
+	var hasPart, inTime = false, false
+	for len(s) > 0 {
+		if s[0] == 'T' || s[0] == 't' {
+			if inTime {
+				return errors.New("unexpected 'T'")
+			}
+			inTime = true
+			s = s[1:]
+			if len(s) == 0 {
+				return errors.New("expect time part after 'T'")
+			}
+			continue
+		}
+		// [-+]digits[.fraction]designator
+		var i = 0
+		if s[i] == '+' || s[i] == '-' {
+			i++
+		}
+		var digitsStart = i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == digitsStart {
+			return errors.New("expect digits")
+		}
+		amount, err := strconv.ParseInt(string(s[:i]), 10, 64)
+		if err != nil {
+			return err
+		}
+		var nanos int64
+		if i < len(s) && (s[i] == '.' || s[i] == ',') {
+			i++
+			var fractionStart = i
+			for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+				i++
+			}
+			if i-fractionStart > 9 {
+				return errors.New("fraction too long")
+			}
+			nanos = parseFraction(s[fractionStart:i])
+			if s[0] == '-' {
+				nanos = -nanos
+			}
+			if i == len(s) || (s[i] != 'S' && s[i] != 's') {
+				return errors.New("fraction is only allowed for seconds")
+			}
+		}
+		if i == len(s) {
+			return errors.New("expect designator")
+		}
+		switch designator := s[i] | 0x20; {
+		case designator == 'd' && !inTime:
+			r = r.PlusDays(amount)
+		case designator == 'h' && inTime:
+			r = r.PlusHours(amount)
+		case designator == 'm' && inTime:
+			r = r.PlusMinutes(amount)
+		case designator == 's' && inTime:
+			r = r.PlusSeconds(amount).PlusNanos(nanos)
+		default:
+			return errors.New("unexpected designator " + strconv.Quote(string(s[i])))
+		}
+		s = s[i+1:]
+		hasPart = true
+	}
+	if !hasPart {
+		return errors.New("expect at least one part")
+	}
+	if negate {
+		r = r.Negated()
+	}
+	*d, e = r.GetResult()
+	return
+}
+
+// parseFraction converts up to 9 fraction digits to nanoseconds.
+func parseFraction(digits []byte) (nanos int64) {
+	for i := 0; i < 9; i++ {
+		nanos *= 10
+		if i < len(digits) {
+			nanos += int64(digits[i] - '0')
+		}
+	}
+	return
+}
+
+// unmarshalPostgresInterval parses PostgreSQL interval output, see parsePostgresInterval.
+// Intervals with a non-zero year or month part cannot be represented as a Duration and are rejected.
+func (d *Duration) unmarshalPostgresInterval(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	var iv postgresInterval
+	if iv, e = parsePostgresInterval(text); e != nil {
+		return
+	}
+	if iv.years != 0 || iv.months != 0 {
+		return errors.New("interval with years or months cannot be converted to a Duration")
+	}
+	*d, e = DurationZero().Chain().PlusDays(iv.days).PlusSeconds(iv.seconds).PlusNanos(iv.nanos).GetResult()
+	return
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(d)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if len(data) == 4 && string(data) == "null" {
+		*d = Duration{}
+		return nil
+	}
+	return unmarshalJsonImpl(d, data)
+}
+
+// Scan implements sql.Scanner.
+// It supports scanning from nil, string, and []byte.
+// Text in ISO 8601 format and PostgreSQL interval output in the default "postgres" style are accepted,
+// intervals with a non-zero year or month part are rejected.
+// MySQL TIME values such as "-838:59:59.000000" are accepted as well, as they can exceed 24 hours
+// which LocalTime can't hold.
+// Nil values are converted to the zero value of Duration.
+func (d *Duration) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Duration{}
+		return nil
+	case string:
+		return d.scanText([]byte(v))
+	case []byte:
+		return d.scanText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+func (d *Duration) scanText(text []byte) error {
+	var s = text
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	if len(s) > 0 && (s[0] == 'P' || s[0] == 'p') {
+		return d.UnmarshalText(text)
+	}
+	return d.unmarshalPostgresInterval(text)
+}
+
+// Value implements driver.Valuer.
+// It returns nil for zero values, otherwise returns the duration in ISO 8601 format,
+// which PostgreSQL accepts as interval input.
+func (d Duration) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
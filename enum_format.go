@@ -0,0 +1,81 @@
+package goda
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// EnumFormat is a form Month and DayOfWeek can be written in, see Month.Format and DayOfWeek.Format.
+// Parsing accepts all the forms, ignoring case.
+type EnumFormat int
+
+const (
+	// EnumFormatNumber is the ISO number, such as "3" for March or "1" for Monday.
+	// Month and DayOfWeek are written in this form by their own codecs.
+	EnumFormatNumber EnumFormat = iota
+	// EnumFormatName is the upper case name, same as the Java enum constants, such as "MARCH" or "MONDAY".
+	// MonthName and DayOfWeekName are written in this form by their codecs.
+	EnumFormatName
+	// EnumFormatDisplayName is the English name, same as String, such as "March" or "Monday".
+	EnumFormatDisplayName
+	// EnumFormatShortName is the three-letter English abbreviation, such as "Mar" or "Mon".
+	EnumFormatShortName
+)
+
+// appendEnumText appends the text of a Month or DayOfWeek value in the format.
+// Only EnumFormatNumber accepts the zero value, which is written as "0".
+func appendEnumText(b []byte, field Field, value int64, format EnumFormat) []byte {
+	if format == EnumFormatNumber {
+		return strconv.AppendInt(b, value, 10)
+	}
+	var style = textStyleFull
+	if format == EnumFormatShortName {
+		style = textStyleShort
+	}
+	text, _ := fieldText(field, style, value)
+	if format == EnumFormatName {
+		return append(b, strings.ToUpper(text)...)
+	}
+	return append(b, text...)
+}
+
+// marshalEnumNameJson returns the JSON string of a Month or DayOfWeek value in EnumFormatName,
+// or null for the zero value.
+func marshalEnumNameJson(field Field, value int64) []byte {
+	if value == 0 {
+		return []byte("null")
+	}
+	var b = appendEnumText([]byte{'"'}, field, value, EnumFormatName)
+	return append(b, '"')
+}
+
+// parseEnumText parses the number, the name or the three-letter abbreviation of a Month or DayOfWeek value,
+// ignoring case, such as "3", "MARCH", "March" or "mar". The number 0 is the zero value.
+func parseEnumText(text []byte, field Field) (v int64, e error) {
+	if len(text) > 0 && text[0] >= '0' && text[0] <= '9' {
+		if v, e = parseInt64(text); e != nil || v == 0 {
+			return
+		}
+		field.checkSetE(v, &e)
+		return
+	}
+	var r = field.fieldRange()
+	for v = r.Min; v <= r.Max; v++ {
+		for _, style := range []textStyle{textStyleFull, textStyleShort} {
+			if s, _ := fieldText(field, style, v); bytes.EqualFold(text, []byte(s)) {
+				return v, nil
+			}
+		}
+	}
+	return 0, errors.New("unknown " + field.String() + " text " + strconv.Quote(string(text)))
+}
+
+// scanEnumInt64 checks an integer scanned into a Month or DayOfWeek value, 0 is the zero value.
+func scanEnumInt64(field Field, v int64) error {
+	if v == 0 {
+		return nil
+	}
+	return field.check(v)
+}
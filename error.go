@@ -32,6 +32,8 @@ const (
 	errReasonArithmeticOverflow
 	errReasonParseFailed
 	errReasonInvalidZoneId
+	errReasonUnsupportedAmount
+	errReasonUnsupportedUnit
 )
 
 // Error is the error type used by this package.
@@ -68,6 +70,10 @@ func (e Error) Error() string {
 		}
 	case errReasonInvalidZoneId:
 		text = "goda: invalid zone id"
+	case errReasonUnsupportedAmount:
+		text = "goda: unsupported amount " + e.message
+	case errReasonUnsupportedUnit:
+		text = "goda: unsupported unit " + e.message
 	default:
 		text = "goda: " + e.message
 	}
@@ -80,6 +86,12 @@ func (e Error) Error() string {
 	return text
 }
 
+// Field returns the field the error is about, such as the field out of range or the conflicting field.
+// Returns zero if the error isn't about a field.
+func (e Error) Field() Field {
+	return e.field
+}
+
 func (e Error) Unwrap() error {
 	if e.cause == nil {
 		switch e.reason {
@@ -87,7 +99,7 @@ func (e Error) Unwrap() error {
 			return ErrOutOfRange
 		case errReasonArithmeticOverflow:
 			return ErrArithmeticOverflow
-		case errReasonUnsupportedField:
+		case errReasonUnsupportedField, errReasonUnsupportedAmount, errReasonUnsupportedUnit:
 			return ErrUnsupported
 		}
 	}
@@ -106,6 +118,19 @@ func unsupportedField(field Field) error {
 	return &Error{reason: errReasonUnsupportedField, field: field}
 }
 
+func unsupportedAmount(amount TemporalAmount) error {
+	return &Error{reason: errReasonUnsupportedAmount, message: amount.String()}
+}
+
+func unsupportedUnit(unit Unit) error {
+	return &Error{reason: errReasonUnsupportedUnit, message: unit.String()}
+}
+
+// newFieldError creates a new Error about the field with the given format and arguments.
+func newFieldError(field Field, format string, a ...any) error {
+	return &Error{field: field, message: fmt.Sprintf(format, a...)}
+}
+
 func invalidFieldError(field Field) error {
 	return &Error{reason: errReasonInvalidField, field: field}
 }
@@ -2,6 +2,7 @@ package goda_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -848,6 +849,134 @@ func ExampleLocalDateTime_GetField_delegation() {
 	// FieldOffsetSeconds is not supported for LocalDateTime
 }
 
+// ExampleMonthDay demonstrates a recurring annual date, such as a birthday.
+func ExampleMonthDay() {
+	birthday := goda.MustMonthDayOf(goda.February, 29)
+	fmt.Println(birthday)
+
+	// February 29 is celebrated on February 28 in non-leap years
+	fmt.Println(birthday.IsValidYear(2023), birthday.AtYear(2023))
+	fmt.Println(birthday.IsValidYear(2024), birthday.AtYear(2024))
+
+	// Check whether a date is the birthday
+	today := goda.MustLocalDateOf(2024, goda.February, 29)
+	fmt.Println(today.MonthDay() == birthday)
+
+	// Output:
+	// --02-29
+	// false 2023-02-28
+	// true 2024-02-29
+	// true
+}
+
+// ExampleYearWeek demonstrates ISO-8601 weeks, which may start in the previous year.
+func ExampleYearWeek() {
+	date := goda.MustLocalDateOf(2024, goda.December, 30)
+	fmt.Println(date.YearWeek())
+	fmt.Println(date.GetField(goda.FieldWeekBasedYear).Int(), date.GetField(goda.FieldWeekOfWeekBasedYear).Int())
+
+	yw := goda.MustYearWeekParse("2020-W53")
+	fmt.Println(yw.AtDay(goda.Monday), yw.AtDay(goda.Sunday))
+	fmt.Println(yw.Chain().PlusWeeks(1).MustGet(), yw.Chain().PlusYears(1).MustGet())
+
+	// Output:
+	// 2025-W01
+	// 2025 1
+	// 2020-12-28 2021-01-03
+	// 2021-W01 2021-W52
+}
+
+// ExampleYearQuarter demonstrates keying reports by quarter.
+func ExampleYearQuarter() {
+	yq := goda.MustLocalDateOf(2024, goda.May, 15).YearQuarter()
+	fmt.Println(yq, yq.FirstDay(), yq.LastDay())
+	fmt.Println(yq.YearMonths())
+	fmt.Println(yq.Chain().PlusQuarters(3).MustGet())
+
+	// Output:
+	// 2024-Q2 2024-04-01 2024-06-30
+	// [2024-04 2024-05 2024-06]
+	// 2025-Q1
+}
+
+// ExampleInterval demonstrates checking bookings for conflicts.
+func ExampleInterval() {
+	booking := goda.MustIntervalParse("2024-03-15T22:00:00Z/PT4H")
+	other := goda.MustIntervalParse("2024-03-16T02:00:00Z/2024-03-16T03:00:00Z")
+	fmt.Println(booking, booking.Duration())
+	fmt.Println(booking.Overlaps(other), booking.Abuts(other))
+
+	for date, part := range booking.SplitByDay(goda.ZoneIdUTC()) {
+		fmt.Println(date, part.Duration())
+	}
+	v, _ := booking.Value()
+	fmt.Println(v)
+
+	// Output:
+	// 2024-03-15T22:00:00Z/2024-03-16T02:00:00Z PT4H
+	// false true
+	// 2024-03-15 PT2H
+	// 2024-03-16 PT2H
+	// ["2024-03-15T22:00:00Z","2024-03-16T02:00:00Z")
+}
+
+// ExampleLocalDateRange demonstrates iterating and splitting a range of dates for a backfill.
+func ExampleLocalDateRange() {
+	r := goda.MustLocalDateRangeOfClosed(goda.MustLocalDateOf(2024, goda.January, 30), goda.MustLocalDateOf(2024, goda.March, 2))
+	fmt.Println(r, r.LengthInDays())
+	for d := range r.Months() {
+		fmt.Println(d)
+	}
+	for ym, part := range r.SplitByYearMonth() {
+		fmt.Println(ym, part.LengthInDays())
+	}
+	fmt.Println(r.Contains(goda.MustLocalDateOf(2024, goda.March, 2)))
+
+	// Output:
+	// [2024-01-30,2024-03-03) 33
+	// 2024-01-30
+	// 2024-02-29
+	// 2024-01 2
+	// 2024-02 29
+	// 2024-03 2
+	// true
+}
+
+// ExampleManualClock demonstrates injecting the current time in tests.
+func ExampleManualClock() {
+	clock := goda.ManualClockOf(goda.MustInstantParse("2024-03-31T23:30:00Z"), goda.MustZoneIdOf("Asia/Tokyo"))
+	fmt.Println(goda.LocalDateNowClock(clock))
+	fmt.Println(goda.LocalDateNowClock(clock.WithZone(goda.ZoneIdUTC())))
+
+	_ = clock.Advance(goda.MustDurationParse("PT1H"))
+	fmt.Println(goda.ZonedDateTimeNowClock(clock))
+	fmt.Println(goda.InstantNowClock(goda.MustClockTickUnit(clock, goda.UnitHours)))
+
+	// Output:
+	// 2024-04-01
+	// 2024-03-31
+	// 2024-04-01T09:30:00+09:00[Asia/Tokyo]
+	// 2024-04-01T00:00:00Z
+}
+
+// ExampleWeekFields demonstrates weeks starting on Sunday, as in the US.
+func ExampleWeekFields() {
+	us := goda.WeekFieldsSundayStart()
+	date := goda.MustLocalDateOf(2023, goda.December, 31)
+	fmt.Println(us, date.DayOfWeek())
+	fmt.Println(date.GetField(us.DayOfWeek()).Int(), date.GetField(us.WeekOfYear()).Int())
+	fmt.Println(date.GetField(us.WeekBasedYear()).Int(), date.GetField(us.WeekOfWeekBasedYear()).Int())
+	fmt.Println(date.Chain().WithField(us.DayOfWeek(), goda.TemporalValueOf(7)).MustGet())
+	fmt.Println(date.GetField(goda.WeekFieldsISO().WeekOfYear()).Int())
+
+	// Output:
+	// WeekFields[Sunday,1] Sunday
+	// 1 53
+	// 2024 1
+	// 2024-01-06
+	// 52
+}
+
 // ExampleZoneOffset demonstrates basic ZoneOffset usage.
 func ExampleZoneOffset() {
 	// Create zone offsets
@@ -1089,6 +1218,27 @@ func ExampleOffsetDateTime_UnmarshalJSON() {
 	// Offset: 8 hours
 }
 
+// ExampleOffsetTime demonstrates a time of day with an offset, such as opening hours.
+func ExampleOffsetTime() {
+	opens := goda.MustOffsetTimeParse("09:00+08:00")
+	fmt.Println(opens)
+
+	// Convert to another offset keeping the instant
+	fmt.Println(opens.Chain().WithOffsetSameInstant(goda.ZoneOffsetUTC()).MustGet())
+
+	// Compared on the instant as if on the same date
+	fmt.Println(opens.IsBefore(goda.MustOffsetTimeParse("02:00Z")))
+
+	// Combine with a date
+	fmt.Println(opens.AtDate(goda.MustLocalDateOf(2024, goda.March, 15)))
+
+	// Output:
+	// 09:00:00+08:00
+	// 01:00:00Z
+	// true
+	// 2024-03-15T09:00:00+08:00
+}
+
 // ExampleZoneId demonstrates basic ZoneId usage.
 func ExampleZoneId() {
 	// Create zone IDs for different time zones
@@ -1482,3 +1632,245 @@ func ExampleLocalTimeChain_WithField() {
 	// 07:30:45.987654321
 	// 22:01:00
 }
+
+// ExampleInstant demonstrates converting between Instant and OffsetDateTime.
+func ExampleInstant() {
+	odt := goda.MustOffsetDateTimeParse("2024-03-15T14:30:45.5+08:00")
+	instant := odt.ToInstant()
+
+	fmt.Println(instant)
+	fmt.Println(instant.EpochSecond(), instant.EpochMilli())
+	fmt.Println(instant.Chain().PlusMillis(500).MustGet())
+	fmt.Println(instant.AtOffset(goda.MustZoneOffsetOfHours(-5)))
+
+	// Output:
+	// 2024-03-15T06:30:45.500Z
+	// 1710484245 1710484245500
+	// 2024-03-15T06:30:46Z
+	// 2024-03-15T01:30:45.500-05:00
+}
+
+// ExampleZonedDateTime demonstrates daylight saving aware arithmetic.
+func ExampleZonedDateTime() {
+	paris := goda.MustZoneIdOf("Europe/Paris")
+	zdt := goda.MustZonedDateTimeOf(goda.MustLocalDateTimeParse("2024-03-30T09:00:00"), paris)
+
+	fmt.Println(zdt)
+	// Date-based arithmetic keeps the local time
+	fmt.Println(zdt.Chain().PlusDays(1).MustGet())
+	// Time-based arithmetic follows the instant
+	fmt.Println(zdt.Chain().PlusHours(24).MustGet())
+	// Local date-times in a gap are shifted later
+	fmt.Println(goda.MustZonedDateTimeOf(goda.MustLocalDateTimeParse("2024-03-31T02:30:00"), paris))
+
+	// Output:
+	// 2024-03-30T09:00:00+01:00[Europe/Paris]
+	// 2024-03-31T09:00:00+02:00[Europe/Paris]
+	// 2024-03-31T10:00:00+02:00[Europe/Paris]
+	// 2024-03-31T03:30:00+02:00[Europe/Paris]
+}
+
+// ExampleDuration demonstrates Duration arithmetic and its ISO 8601 text form.
+func ExampleDuration() {
+	d := goda.MustDurationParse("PT8H6M12.345S")
+	fmt.Println(d)
+	fmt.Println(d.ToMinutes(), d.GoDuration())
+	fmt.Println(d.Chain().MultipliedBy(3).TruncatedTo(goda.UnitMinutes).MustGet())
+
+	ldt := goda.MustLocalDateTimeParse("2024-03-15T20:00:00")
+	fmt.Println(ldt.Chain().Plus(d).MustGet())
+
+	// Output:
+	// PT8H6M12.345S
+	// 486 8h6m12.345s
+	// PT24H18M
+	// 2024-03-16T04:06:12.345
+}
+
+func ExamplePeriod() {
+	p := goda.MustPeriodParse("P1Y15M")
+	fmt.Println(p, p.Chain().Normalized().MustGet())
+
+	start := goda.MustLocalDateParse("2024-01-31")
+	fmt.Println(start.Chain().Plus(goda.PeriodOfMonths(1)).MustGet())
+	fmt.Println(start.Until(goda.MustLocalDateParse("2025-03-15")))
+
+	// Output:
+	// P1Y15M P2Y3M
+	// 2024-02-29
+	// P1Y1M15D
+}
+
+func ExampleDateTimeFormatter() {
+	f := goda.MustDateTimeFormatterOfPattern("dd-MMM-yyyy HH:mm")
+	ldt := goda.MustLocalDateTimeParse("2024-03-15T14:30:00")
+	fmt.Println(f.MustFormat(ldt))
+
+	parsed, err := f.ParseLocalDateTime("01-Apr-2024 09:05")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(parsed)
+
+	odt, err := goda.MustDateTimeFormatterOfPattern("yyyyMMddHHmmssXX").ParseOffsetDateTime("20240315143000+0800")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(odt)
+
+	// Output:
+	// 15-Mar-2024 14:30
+	// 2024-04-01T09:05:00
+	// 2024-03-15T14:30:00+08:00
+}
+
+func ExampleDateTimeFormatterBuilder() {
+	f, err := goda.NewDateTimeFormatterBuilder().
+		ParseCaseInsensitive().
+		AppendPattern("yyyy-MM").
+		OptionalStart().
+		AppendLiteral("-").
+		AppendValue(goda.FieldDayOfMonth, 2, 2, goda.SignStyleNotNegative).
+		OptionalEnd().
+		OptionalStart().
+		AppendLiteral("T").
+		AppendPattern("HH:mm:ss").
+		AppendFraction(goda.FieldNanoOfSecond, 0, 9, true).
+		AppendOffset("+HH:MM", "Z").
+		OptionalEnd().
+		ParseDefaulting(goda.FieldDayOfMonth, 1).
+		ToFormatter()
+	if err != nil {
+		panic(err)
+	}
+
+	d, _ := f.ParseLocalDate("2024-03")
+	fmt.Println(d)
+	odt, _ := f.ParseOffsetDateTime("2024-03-15t14:30:05.5+08:00")
+	fmt.Println(odt)
+	fmt.Println(f.MustFormat(odt))
+	fmt.Println(f.MustFormat(d))
+
+	// Output:
+	// 2024-03-01
+	// 2024-03-15T14:30:05.500+08:00
+	// 2024-03-15T14:30:05.5+08:00
+	// 2024-03-01
+}
+
+// ExampleResolverStyle demonstrates how the resolver styles resolve the parsed fields.
+func ExampleResolverStyle() {
+	var f = goda.MustDateTimeFormatterOfPattern("uuuu-MM-dd HH:mm")
+	for _, style := range []goda.ResolverStyle{goda.ResolverStyleStrict, goda.ResolverStyleSmart, goda.ResolverStyleLenient} {
+		for _, text := range []string{"2024-02-30 10:00", "2024-13-01 10:00", "2024-03-15 24:00"} {
+			ldt, err := f.WithResolverStyle(style).ParseLocalDateTime(text)
+			if err != nil {
+				fmt.Println(style, text, "error")
+				continue
+			}
+			fmt.Println(style, text, ldt)
+		}
+	}
+
+	// Conflicting fields are reported with the field at fault
+	_, err := goda.ResolveFields(map[goda.Field]goda.TemporalValue{
+		goda.FieldYear:        goda.TemporalValueOf(2024),
+		goda.FieldMonthOfYear: goda.TemporalValueOf(3),
+		goda.FieldDayOfMonth:  goda.TemporalValueOf(15),
+		goda.FieldDayOfWeek:   goda.TemporalValueOf(1),
+	}, goda.ResolverStyleSmart)
+	var e *goda.Error
+	if errors.As(err, &e) {
+		fmt.Println("conflict:", e.Field())
+	}
+
+	// Output:
+	// Strict 2024-02-30 10:00 error
+	// Strict 2024-13-01 10:00 error
+	// Strict 2024-03-15 24:00 error
+	// Smart 2024-02-30 10:00 2024-02-29T10:00:00
+	// Smart 2024-13-01 10:00 error
+	// Smart 2024-03-15 24:00 2024-03-16T00:00:00
+	// Lenient 2024-02-30 10:00 2024-03-01T10:00:00
+	// Lenient 2024-13-01 10:00 2025-01-01T10:00:00
+	// Lenient 2024-03-15 24:00 2024-03-16T00:00:00
+	// conflict: DayOfWeek
+}
+
+// ExampleTemporalAdjuster demonstrates adjusting dates with the standard adjusters.
+func ExampleTemporalAdjuster() {
+	d := goda.MustLocalDateParse("2024-03-15")
+	fmt.Println(d.Chain().With(goda.LastDayOfMonth()).MustGet())
+	fmt.Println(d.Chain().With(goda.Next(goda.Monday)).MustGet())
+	fmt.Println(d.Chain().With(goda.DayOfWeekInMonth(2, goda.Tuesday)).MustGet())
+
+	// Compose adjusters, such as the last business day of the month
+	lastBusinessDay := goda.TemporalAdjusterFunc(func(date goda.LocalDate) (goda.LocalDate, error) {
+		return date.Chain().With(goda.LastDayOfMonth()).With(goda.PreviousOrSame(goda.Friday)).GetResult()
+	})
+	ldt := goda.MustLocalDateTimeParse("2024-08-15T09:00:00")
+	fmt.Println(ldt.Chain().With(lastBusinessDay).MustGet())
+
+	// Output:
+	// 2024-03-31
+	// 2024-03-18
+	// 2024-03-12
+	// 2024-08-30T09:00:00
+}
+
+// ExampleUnit demonstrates measuring and adding amounts of time in units.
+func ExampleUnit() {
+	start := goda.MustLocalDateTimeParse("2024-01-31T12:00:00")
+	end := goda.MustLocalDateTimeParse("2024-03-01T13:00:00")
+	months, _ := start.UntilUnit(end, goda.UnitMonths)
+	days, _ := goda.UnitDays.Between(start, end)
+	fmt.Println(months, days)
+
+	fmt.Println(start.Chain().PlusUnit(1, goda.UnitMonths).MustGet())
+	fmt.Println(start.Chain().PlusUnit(3, goda.UnitHalfDays).MustGet())
+	fmt.Println(goda.MustLocalTimeParse("14:30:45").Chain().TruncatedTo(goda.UnitHours).MustGet())
+	fmt.Println(goda.UnitYears.Duration(), goda.UnitYears.IsDurationEstimated())
+
+	// Output:
+	// 1 30
+	// 2024-02-29T12:00:00
+	// 2024-02-02T00:00:00
+	// 14:00:00
+	// PT8765H49M12S true
+}
+
+// ExampleZoneRules demonstrates querying the daylight saving time transitions of a zone.
+func ExampleZoneRules() {
+	rules := goda.MustZoneIdOf("Europe/Paris").Rules()
+	for _, tr := range rules.Transitions(goda.MustInstantParse("2024-01-01T00:00:00Z"), goda.MustInstantParse("2025-01-01T00:00:00Z")) {
+		fmt.Println(tr)
+	}
+
+	summer := goda.MustInstantParse("2024-07-01T00:00:00Z")
+	fmt.Println(rules.IsDaylightSavings(summer), rules.StandardOffset(summer), rules.OffsetOfInstant(summer))
+	fmt.Println(rules.ValidOffsets(goda.MustLocalDateTimeParse("2024-10-27T02:30:00")))
+
+	// Output:
+	// Transition[Gap at 2024-03-31T02:00:00+01:00 to +02:00]
+	// Transition[Overlap at 2024-10-27T03:00:00+02:00 to +01:00]
+	// true +01:00 +02:00
+	// [+02:00 +01:00]
+}
+
+func ExampleRegisterZoneRulesProvider() {
+	// Load the zones from a zoneinfo directory, an embed.FS or memory instead of the host's zone database.
+	// Zones the providers don't have are still loaded by Go.
+	goda.RegisterZoneRulesProvider(goda.ZoneRulesProviderOfDir("/opt/app/zoneinfo"))
+
+	zone := goda.MustZoneIdOf("Asia/Tokyo")
+	fmt.Println(zone, zone.GetOffset(goda.MustLocalDateTimeParse("2024-07-01T00:00:00")))
+}
+
+func ExampleZoneIdOf_link() {
+	// Renamed zones are canonicalized
+	zone := goda.MustZoneIdOf("Asia/Calcutta")
+	fmt.Println(zone, zone == goda.MustZoneIdOf("Asia/Kolkata"))
+
+	// Output:
+	// Asia/Kolkata true
+}
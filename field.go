@@ -100,6 +100,20 @@ const (
 
 	// FieldOffsetSeconds represents the offset from UTC/Greenwich in seconds.
 	FieldOffsetSeconds
+
+	// FieldQuarterOfYear represents the quarter-of-year field (1-4), January to March is the first quarter.
+	FieldQuarterOfYear
+
+	// FieldDayOfQuarter represents the day-of-quarter field (1-92).
+	FieldDayOfQuarter
+
+	// FieldWeekOfWeekBasedYear represents the ISO-8601 week-of-week-based-year field (1-53).
+	// Weeks start on Monday, and the first week of a week-based-year contains its first Thursday,
+	// so 2024-12-30 is in the week 1 of 2025.
+	FieldWeekOfWeekBasedYear
+
+	// FieldWeekBasedYear represents the ISO-8601 week-based-year, which differs from the year around January 1.
+	FieldWeekBasedYear
 )
 
 type fieldRange struct {
@@ -112,6 +126,8 @@ type fieldDescriptor struct {
 	name     string
 	javaName string
 	based    int8
+	// iso is true for the fields of Java's IsoFields instead of ChronoField
+	iso bool
 	fieldRange
 }
 
@@ -155,13 +171,29 @@ var fieldDescriptors = []fieldDescriptor{
 	FieldEra:                     {name: "Era", javaName: "ERA", based: dateBased, fieldRange: makeRange(0, 1)},
 	FieldInstantSeconds:          {name: "InstantSeconds", javaName: "INSTANT_SECONDS", fieldRange: makeRange(math.MinInt64, math.MaxInt64)},
 	FieldOffsetSeconds:           {name: "OffsetSeconds", javaName: "OFFSET_SECONDS", fieldRange: makeRange(-18*3600, 18*3600)},
+	FieldQuarterOfYear:           {name: "QuarterOfYear", javaName: "QUARTER_OF_YEAR", based: dateBased, iso: true, fieldRange: makeRange(1, 4)},
+	FieldDayOfQuarter:            {name: "DayOfQuarter", javaName: "DAY_OF_QUARTER", based: dateBased, iso: true, fieldRange: makeRange(1, 92)},
+	FieldWeekOfWeekBasedYear:     {name: "WeekOfWeekBasedYear", javaName: "WEEK_OF_WEEK_BASED_YEAR", based: dateBased, iso: true, fieldRange: makeRange(1, 53)},
+	FieldWeekBasedYear:           {name: "WeekBasedYear", javaName: "WEEK_BASED_YEAR", based: dateBased, iso: true, fieldRange: makeRange(YearMin, YearMax)},
+}
+
+// descriptor returns the descriptor of the field, which is computed for the fields of WeekFields.
+// Returns the zero descriptor if the field is invalid.
+func (f Field) descriptor() fieldDescriptor {
+	if f > 0 && int(f) < len(fieldDescriptors) {
+		return fieldDescriptors[f]
+	}
+	if wf, kind, ok := f.weekFields(); ok {
+		return wf.descriptor(kind)
+	}
+	return fieldDescriptor{}
 }
 
 func (f Field) check(value int64) error {
 	if !f.Valid() {
 		return invalidFieldError(f)
 	}
-	var r = fieldDescriptors[f].fieldRange
+	var r = f.descriptor().fieldRange
 	if r.Valid && (value < r.Min || value > r.Max) {
 		return fieldOutOfRangeError(f, value)
 	}
@@ -169,7 +201,7 @@ func (f Field) check(value int64) error {
 }
 
 func (f Field) fieldRange() fieldRange {
-	return fieldDescriptors[f].fieldRange
+	return f.descriptor().fieldRange
 }
 
 func (f Field) checkSetE(value int64, e *error) {
@@ -180,13 +212,14 @@ func (f Field) checkSetE(value int64, e *error) {
 }
 
 func (f Field) Valid() bool {
-	return f > 0 && f <= FieldOffsetSeconds
+	return f.descriptor().name != ""
 }
 
 // String returns the name of the field.
+// The fields of WeekFields are named after their WeekFields, such as "WeekOfYear[WeekFields[Sunday,1]]".
 func (f Field) String() string {
 	if f.Valid() {
-		return fieldDescriptors[f].name
+		return f.descriptor().name
 	}
 	return "UnknownField(" + strconv.Itoa(int(f)) + ")"
 }
@@ -199,7 +232,7 @@ func (f Field) String() string {
 //
 // Returns true if this field is a component of a date.
 func (f Field) IsDateBased() bool {
-	return f.Valid() && fieldDescriptors[f].based == dateBased
+	return f.descriptor().based == dateBased
 }
 
 // IsTimeBased checks if this field represents a component of a time.
@@ -210,13 +243,19 @@ func (f Field) IsDateBased() bool {
 //
 // Returns true if this field is a component of a time.
 func (f Field) IsTimeBased() bool {
-	return f.Valid() && fieldDescriptors[f].based == timeBased
+	return f.descriptor().based == timeBased
 }
 
 func (f Field) JavaName() string {
 	if !f.Valid() {
 		return ""
 	}
+	if wf, kind, ok := f.weekFields(); ok {
+		return wf.javaName(kind)
+	}
 	var j = fieldDescriptors[f].javaName
+	if fieldDescriptors[f].iso {
+		return "IsoFields." + j
+	}
 	return "ChronoField." + j
 }
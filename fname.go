@@ -1,73 +1,139 @@
 package goda
 
 const (
-	fnMinusDays = iota + 1
+	fnAbs = iota + 1
+	fnDividedBy
+	fnMinus
+	fnMinusDays
 	fnMinusHours
+	fnMinusMillis
 	fnMinusMinutes
 	fnMinusMonths
 	fnMinusNanos
+	fnMinusQuarters
 	fnMinusSeconds
+	fnMinusUnit
 	fnMinusWeeks
 	fnMinusYears
+	fnMultipliedBy
+	fnNegated
+	fnNormalized
+	fnPlus
 	fnPlusDays
 	fnPlusHours
+	fnPlusMillis
 	fnPlusMinutes
 	fnPlusMonths
 	fnPlusNanos
+	fnPlusQuarters
 	fnPlusSeconds
+	fnPlusUnit
 	fnPlusWeeks
 	fnPlusYears
+	fnTruncatedTo
+	fnWith
 	fnWithDayOfMonth
 	fnWithDayOfYear
+	fnWithDays
+	fnWithEarlierOffsetAtOverlap
 	fnWithField
 	fnWithHour
+	fnWithLaterOffsetAtOverlap
 	fnWithMinute
 	fnWithMonth
+	fnWithMonths
 	fnWithNano
+	fnWithOffsetSameInstant
+	fnWithOffsetSameLocal
+	fnWithQuarter
 	fnWithSecond
+	fnWithWeek
 	fnWithYear
+	fnWithYears
+	fnWithZoneSameInstant
+	fnWithZoneSameLocal
 )
 
 var fnNames = []string{
-	fnMinusDays:      "MinusDays",
-	fnMinusHours:     "MinusHours",
-	fnMinusMinutes:   "MinusMinutes",
-	fnMinusMonths:    "MinusMonths",
-	fnMinusNanos:     "MinusNanos",
-	fnMinusSeconds:   "MinusSeconds",
-	fnMinusWeeks:     "MinusWeeks",
-	fnMinusYears:     "MinusYears",
-	fnPlusDays:       "PlusDays",
-	fnPlusHours:      "PlusHours",
-	fnPlusMinutes:    "PlusMinutes",
-	fnPlusMonths:     "PlusMonths",
-	fnPlusNanos:      "PlusNanos",
-	fnPlusSeconds:    "PlusSeconds",
-	fnPlusWeeks:      "PlusWeeks",
-	fnPlusYears:      "PlusYears",
-	fnWithDayOfMonth: "WithDayOfMonth",
-	fnWithDayOfYear:  "WithDayOfYear",
-	fnWithField:      "WithField",
-	fnWithHour:       "WithHour",
-	fnWithMinute:     "WithMinute",
-	fnWithMonth:      "WithMonth",
-	fnWithNano:       "WithNano",
-	fnWithSecond:     "WithSecond",
-	fnWithYear:       "WithYear",
+	fnAbs:                        "Abs",
+	fnDividedBy:                  "DividedBy",
+	fnMinus:                      "Minus",
+	fnMinusDays:                  "MinusDays",
+	fnMinusHours:                 "MinusHours",
+	fnMinusMillis:                "MinusMillis",
+	fnMinusMinutes:               "MinusMinutes",
+	fnMinusMonths:                "MinusMonths",
+	fnMinusNanos:                 "MinusNanos",
+	fnMinusQuarters:              "MinusQuarters",
+	fnMinusSeconds:               "MinusSeconds",
+	fnMinusUnit:                  "MinusUnit",
+	fnMinusWeeks:                 "MinusWeeks",
+	fnMinusYears:                 "MinusYears",
+	fnMultipliedBy:               "MultipliedBy",
+	fnNegated:                    "Negated",
+	fnNormalized:                 "Normalized",
+	fnPlus:                       "Plus",
+	fnPlusDays:                   "PlusDays",
+	fnPlusHours:                  "PlusHours",
+	fnPlusMillis:                 "PlusMillis",
+	fnPlusMinutes:                "PlusMinutes",
+	fnPlusMonths:                 "PlusMonths",
+	fnPlusNanos:                  "PlusNanos",
+	fnPlusQuarters:               "PlusQuarters",
+	fnPlusSeconds:                "PlusSeconds",
+	fnPlusUnit:                   "PlusUnit",
+	fnPlusWeeks:                  "PlusWeeks",
+	fnPlusYears:                  "PlusYears",
+	fnTruncatedTo:                "TruncatedTo",
+	fnWith:                       "With",
+	fnWithDayOfMonth:             "WithDayOfMonth",
+	fnWithDayOfYear:              "WithDayOfYear",
+	fnWithDays:                   "WithDays",
+	fnWithEarlierOffsetAtOverlap: "WithEarlierOffsetAtOverlap",
+	fnWithField:                  "WithField",
+	fnWithHour:                   "WithHour",
+	fnWithLaterOffsetAtOverlap:   "WithLaterOffsetAtOverlap",
+	fnWithMinute:                 "WithMinute",
+	fnWithMonth:                  "WithMonth",
+	fnWithMonths:                 "WithMonths",
+	fnWithNano:                   "WithNano",
+	fnWithOffsetSameInstant:      "WithOffsetSameInstant",
+	fnWithOffsetSameLocal:        "WithOffsetSameLocal",
+	fnWithQuarter:                "WithQuarter",
+	fnWithSecond:                 "WithSecond",
+	fnWithWeek:                   "WithWeek",
+	fnWithYear:                   "WithYear",
+	fnWithYears:                  "WithYears",
+	fnWithZoneSameInstant:        "WithZoneSameInstant",
+	fnWithZoneSameLocal:          "WithZoneSameLocal",
 }
 
 const (
-	tyLocalDate = iota + 1
+	tyDuration = iota + 1
+	tyInstant
+	tyLocalDate
 	tyLocalDateTime
 	tyLocalTime
 	tyOffsetDateTime
+	tyOffsetTime
+	tyPeriod
 	tyYearMonth
+	tyYearQuarter
+	tyYearWeek
+	tyZonedDateTime
 )
 
 var tyNames = []string{
+	tyDuration:       "Duration",
+	tyInstant:        "Instant",
 	tyLocalDate:      "LocalDate",
 	tyLocalDateTime:  "LocalDateTime",
 	tyLocalTime:      "LocalTime",
 	tyOffsetDateTime: "OffsetDateTime",
+	tyOffsetTime:     "OffsetTime",
+	tyPeriod:         "Period",
 	tyYearMonth:      "YearMonth",
+	tyYearQuarter:    "YearQuarter",
+	tyYearWeek:       "YearWeek",
+	tyZonedDateTime:  "ZonedDateTime",
 }
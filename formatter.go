@@ -0,0 +1,283 @@
+package goda
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DateTimeFormatter formats and parses date-time text using a pattern, such as "dd/MM/yyyy HH:mm".
+// It is immutable and safe for concurrent use.
+//
+// The zero value is not a valid formatter, use DateTimeFormatterOfPattern or DateTimeFormatterBuilder to create one.
+//
+// This is similar to Java's DateTimeFormatter.
+type DateTimeFormatter struct {
+	printerParser compositePrinterParser
+	resolverStyle ResolverStyle
+	valid         bool
+}
+
+// IsZero returns true if this is the zero value of DateTimeFormatter.
+func (f DateTimeFormatter) IsZero() bool {
+	return !f.valid
+}
+
+// ResolverStyle returns the style used to resolve the parsed fields, ResolverStyleSmart by default.
+func (f DateTimeFormatter) ResolverStyle() ResolverStyle {
+	if f.resolverStyle == 0 {
+		return ResolverStyleSmart
+	}
+	return f.resolverStyle
+}
+
+// WithResolverStyle returns a copy of this formatter using the style to resolve the parsed fields.
+// The style has no effect on formatting.
+func (f DateTimeFormatter) WithResolverStyle(style ResolverStyle) DateTimeFormatter {
+	f.resolverStyle = style
+	return f
+}
+
+// DateTimeFormatterOfPattern creates a formatter from a Java-style pattern.
+//
+// The supported pattern letters are:
+//
+//	Letter  Meaning                     Examples
+//	G       era                         AD; Anno Domini; A
+//	u       year                        2024; 24
+//	y       year-of-era                 2024; 24
+//	D       day-of-year                 189
+//	M       month-of-year               7; 07; Jul; July; J
+//	d       day-of-month                10
+//	E       day-of-week                 Tue; Tuesday; T
+//	a       am-pm-of-day                PM
+//	h       clock-hour-of-am-pm (1-12)  12
+//	K       hour-of-am-pm (0-11)        0
+//	k       clock-hour-of-day (1-24)    24
+//	H       hour-of-day (0-23)          0
+//	m       minute-of-hour              30
+//	s       second-of-minute            55
+//	S       fraction-of-second          978
+//	n       nano-of-second              987654321
+//	VV      zone id                     America/Los_Angeles; Z; -08:30
+//	X       zone-offset 'Z' for zero    Z; -08; -0830; -08:30
+//	x       zone-offset                 +0000; -08; -0830; -08:30
+//	Z       zone-offset                 +0000; -0800; GMT-08:00; -08:00
+//	'       escape for text
+//	''      single quote
+//	[       optional section start
+//	]       optional section end
+//
+// The count of pattern letters follows Java's rules.
+// For numbers, one letter prints the minimum digits, two letters print two digits with zero padding.
+// For years, two letters print the last two digits and parse them into the range 2000-2099,
+// four or more letters print the year with zero padding, and a sign if the year exceeds the padding.
+// For text, three letters print the short form, four letters print the full form, five letters print the narrow form.
+// Texts are in English.
+//
+// Other ASCII letters are reserved and return an error, the characters '{', '}' and '#' are reserved as well.
+// Any other character is printed and parsed as is.
+func DateTimeFormatterOfPattern(pattern string) (DateTimeFormatter, error) {
+	return NewDateTimeFormatterBuilder().AppendPattern(pattern).ToFormatter()
+}
+
+// MustDateTimeFormatterOfPattern creates a formatter from a Java-style pattern.
+// Panics if the pattern is invalid. Use DateTimeFormatterOfPattern for error handling.
+func MustDateTimeFormatterOfPattern(pattern string) DateTimeFormatter {
+	return mustValue(DateTimeFormatterOfPattern(pattern))
+}
+
+// Format formats the temporal using this formatter.
+// Returns an empty string if the temporal is nil or zero.
+// Returns an error if the temporal doesn't support a field required by the pattern,
+// fields in an optional section are omitted instead.
+func (f DateTimeFormatter) Format(temporal TemporalAccessor) (string, error) {
+	b, e := f.AppendFormat(nil, temporal)
+	if e != nil {
+		return "", e
+	}
+	return string(b), nil
+}
+
+// MustFormat formats the temporal using this formatter.
+// Panics if the temporal cannot be formatted. Use Format for error handling.
+func (f DateTimeFormatter) MustFormat(temporal TemporalAccessor) string {
+	return mustValue(f.Format(temporal))
+}
+
+// AppendFormat appends the formatted temporal to b and returns the extended buffer.
+// See Format for details.
+func (f DateTimeFormatter) AppendFormat(b []byte, temporal TemporalAccessor) ([]byte, error) {
+	if temporal == nil || temporal.IsZero() {
+		return b, nil
+	}
+	var ctx = dateTimePrintContext{temporal: temporal}
+	var length = len(b)
+	b, e := f.printerParser.format(&ctx, b)
+	if e != nil {
+		return b[:length], e
+	}
+	return b, nil
+}
+
+// parseResolved parses the text and resolves the parsed fields.
+func (f DateTimeFormatter) parseResolved(text string) (r dateTimeParsed, e error) {
+	if !f.valid {
+		return r, errors.New("formatter is zero")
+	}
+	var ctx = dateTimeParseContext{fields: make(map[Field]int64), caseSensitive: true}
+	var pos = f.printerParser.parse(&ctx, text, 0)
+	if pos < 0 {
+		return r, fmt.Errorf("text %q could not be parsed at index %d", text, ^pos)
+	}
+	if pos < len(text) {
+		return r, fmt.Errorf("text %q could not be parsed, unparsed text found at index %d", text, pos)
+	}
+	return resolveFields(ctx.fields, ctx.zone, f.ResolverStyle())
+}
+
+// ParseLocalDate parses the text into a LocalDate.
+// The text must contain the year, month and day-of-month, or the year and day-of-year.
+// Empty text is treated as the zero value.
+func (f DateTimeFormatter) ParseLocalDate(text string) (r LocalDate, e error) {
+	defer deferOpInParse([]byte(text), &e)
+	if len(text) == 0 {
+		return
+	}
+	parsed, e := f.parseResolved(text)
+	if e != nil {
+		return
+	}
+	if parsed.date.IsZero() {
+		return r, errors.New("unable to obtain LocalDate from the parsed text")
+	}
+	return parsed.date, nil
+}
+
+// ParseLocalTime parses the text into a LocalTime.
+// The text must contain the hour, the minute, second and nano-of-second default to zero.
+// Empty text is treated as the zero value.
+func (f DateTimeFormatter) ParseLocalTime(text string) (r LocalTime, e error) {
+	defer deferOpInParse([]byte(text), &e)
+	if len(text) == 0 {
+		return
+	}
+	parsed, e := f.parseResolved(text)
+	if e != nil {
+		return
+	}
+	if parsed.time.IsZero() {
+		return r, errors.New("unable to obtain LocalTime from the parsed text")
+	}
+	return parsed.time, nil
+}
+
+// ParseLocalDateTime parses the text into a LocalDateTime.
+// The text must contain both the date and the time, see ParseLocalDate and ParseLocalTime.
+// Empty text is treated as the zero value.
+func (f DateTimeFormatter) ParseLocalDateTime(text string) (r LocalDateTime, e error) {
+	defer deferOpInParse([]byte(text), &e)
+	if len(text) == 0 {
+		return
+	}
+	parsed, e := f.parseResolved(text)
+	if e != nil {
+		return
+	}
+	if parsed.date.IsZero() || parsed.time.IsZero() {
+		return r, errors.New("unable to obtain LocalDateTime from the parsed text")
+	}
+	return parsed.date.AtTime(parsed.time), nil
+}
+
+// ParseOffsetDateTime parses the text into an OffsetDateTime.
+// The text must contain the date, the time and the offset, a zone id with a fixed offset is accepted as well.
+// Empty text is treated as the zero value.
+func (f DateTimeFormatter) ParseOffsetDateTime(text string) (r OffsetDateTime, e error) {
+	defer deferOpInParse([]byte(text), &e)
+	if len(text) == 0 {
+		return
+	}
+	parsed, e := f.parseResolved(text)
+	if e != nil {
+		return
+	}
+	var offset = parsed.offset
+	if !parsed.hasOffset && !parsed.zone.IsZero() && parsed.zone.loc == nil {
+		offset = parsed.zone.zo
+	} else if !parsed.hasOffset {
+		return r, errors.New("unable to obtain OffsetDateTime from the parsed text")
+	}
+	if parsed.date.IsZero() || parsed.time.IsZero() {
+		return r, errors.New("unable to obtain OffsetDateTime from the parsed text")
+	}
+	return parsed.date.AtTime(parsed.time).AtOffset(offset), nil
+}
+
+// ParseOffsetTime parses the text into an OffsetTime.
+// The text must contain the time and the offset, a zone id with a fixed offset is accepted as well.
+// Empty text is treated as the zero value.
+func (f DateTimeFormatter) ParseOffsetTime(text string) (r OffsetTime, e error) {
+	defer deferOpInParse([]byte(text), &e)
+	if len(text) == 0 {
+		return
+	}
+	parsed, e := f.parseResolved(text)
+	if e != nil {
+		return
+	}
+	var offset = parsed.offset
+	if !parsed.hasOffset && !parsed.zone.IsZero() && parsed.zone.loc == nil {
+		offset = parsed.zone.zo
+	} else if !parsed.hasOffset {
+		return r, errors.New("unable to obtain OffsetTime from the parsed text")
+	}
+	if parsed.time.IsZero() {
+		return r, errors.New("unable to obtain OffsetTime from the parsed text")
+	}
+	return parsed.time.AtOffset(offset), nil
+}
+
+// ParseZonedDateTime parses the text into a ZonedDateTime.
+// The text must contain the date, the time, and the zone id or the offset.
+// If both are present, the offset selects the instant, which is then converted to the zone.
+// Empty text is treated as the zero value.
+func (f DateTimeFormatter) ParseZonedDateTime(text string) (r ZonedDateTime, e error) {
+	defer deferOpInParse([]byte(text), &e)
+	if len(text) == 0 {
+		return
+	}
+	parsed, e := f.parseResolved(text)
+	if e != nil {
+		return
+	}
+	if parsed.date.IsZero() || parsed.time.IsZero() || (!parsed.hasOffset && parsed.zone.IsZero()) {
+		return r, errors.New("unable to obtain ZonedDateTime from the parsed text")
+	}
+	var ldt = parsed.date.AtTime(parsed.time)
+	var zone = parsed.zone
+	if zone.IsZero() {
+		zone = ZoneIdOfOffset(parsed.offset)
+	}
+	if parsed.hasOffset {
+		return zonedDateTimeOfEpochSecond(ldt.AtOffset(parsed.offset).EpochSecond(), int64(ldt.Nanosecond()), zone)
+	}
+	return zonedDateTimeOfLocal(ldt, zone, nil)
+}
+
+// ParseYearMonth parses the text into a YearMonth.
+// The text must contain the year and the month.
+// Empty text is treated as the zero value.
+func (f DateTimeFormatter) ParseYearMonth(text string) (r YearMonth, e error) {
+	defer deferOpInParse([]byte(text), &e)
+	if len(text) == 0 {
+		return
+	}
+	parsed, e := f.parseResolved(text)
+	if e != nil {
+		return
+	}
+	if parsed.yearMonth.IsZero() {
+		return r, errors.New("unable to obtain YearMonth from the parsed text")
+	}
+	return parsed.yearMonth, nil
+}
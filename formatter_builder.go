@@ -0,0 +1,292 @@
+package goda
+
+import (
+	"slices"
+	"strconv"
+)
+
+// DateTimeFormatterBuilder builds a DateTimeFormatter from its parts.
+// The methods append to the builder and return it, so calls can be chained.
+// The first invalid argument is recorded and returned by ToFormatter, later calls are ignored then.
+//
+// The zero value is an empty builder ready to use.
+//
+// This is similar to Java's DateTimeFormatterBuilder.
+type DateTimeFormatterBuilder struct {
+	// stack holds a frame per open optional section, the root frame is at the bottom.
+	stack []formatterBuilderFrame
+	e     error
+}
+
+type formatterBuilderFrame struct {
+	printerParsers []dateTimePrinterParser
+	// valueParserIndex is the index of the last variable width numberPrinterParser,
+	// subsequent fixed width values reserve their width on it for adjacent value parsing.
+	valueParserIndex int
+}
+
+// NewDateTimeFormatterBuilder creates an empty builder.
+func NewDateTimeFormatterBuilder() *DateTimeFormatterBuilder {
+	return &DateTimeFormatterBuilder{}
+}
+
+func (b *DateTimeFormatterBuilder) ok() bool {
+	return b.e == nil
+}
+
+func (b *DateTimeFormatterBuilder) active() *formatterBuilderFrame {
+	if len(b.stack) == 0 {
+		b.stack = append(b.stack, formatterBuilderFrame{valueParserIndex: -1})
+	}
+	return &b.stack[len(b.stack)-1]
+}
+
+// AppendValue appends the decimal value of the field.
+//
+// The value is printed with at least minWidth digits, padded with zeros, and at most maxWidth digits.
+// Printing fails if the value needs more than maxWidth digits.
+// When parsing, between minWidth and maxWidth digits are accepted.
+// A variable width value directly followed by fixed width values, such as "yyyyMMdd",
+// leaves the digits of the fixed width values when parsing.
+//
+// The widths must be from 1 to 19, and maxWidth must be able to hold the maximum value of the field,
+// such as 2 for FieldMonthOfYear.
+func (b *DateTimeFormatterBuilder) AppendValue(field Field, minWidth, maxWidth int, signStyle SignStyle) *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	switch {
+	case !field.Valid():
+		b.e = invalidFieldError(field)
+	case !signStyle.Valid():
+		b.e = newError("invalid sign style: %d", signStyle)
+	case minWidth < 1 || minWidth > 19:
+		b.e = newError("minimum width must be from 1 to 19 inclusive but was %d", minWidth)
+	case maxWidth < 1 || maxWidth > 19:
+		b.e = newError("maximum width must be from 1 to 19 inclusive but was %d", maxWidth)
+	case maxWidth < minWidth:
+		b.e = newError("maximum width must exceed or equal the minimum width but %d < %d", maxWidth, minWidth)
+	case len(strconv.FormatInt(abs(field.fieldRange().Max), 10)) > maxWidth:
+		b.e = newError("maximum width %d cannot hold the maximum value %d of field %s", maxWidth, field.fieldRange().Max, field)
+	default:
+		b.appendValue(numberPrinterParser{field: field, minWidth: minWidth, maxWidth: maxWidth, signStyle: signStyle})
+	}
+	return b
+}
+
+// AppendFraction appends the value of the field as a decimal fraction of its range,
+// such as 123_000_000 of FieldNanoOfSecond as "123".
+//
+// The fraction is printed with at least minWidth digits and at most maxWidth digits,
+// trailing zeros beyond minWidth are omitted, and the fraction is truncated to maxWidth.
+// If decimalPoint is true, the fraction is preceded by '.', which is omitted along with
+// the fraction if no digits are printed.
+//
+// The minWidth must be from 0 to 9, the maxWidth must be from 1 to 9.
+func (b *DateTimeFormatterBuilder) AppendFraction(field Field, minWidth, maxWidth int, decimalPoint bool) *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	switch {
+	case !field.Valid():
+		b.e = invalidFieldError(field)
+	case !field.fieldRange().Valid:
+		b.e = newError("field %s must have a fixed set of values", field)
+	case minWidth < 0 || minWidth > 9:
+		b.e = newError("minimum width must be from 0 to 9 inclusive but was %d", minWidth)
+	case maxWidth < 1 || maxWidth > 9:
+		b.e = newError("maximum width must be from 1 to 9 inclusive but was %d", maxWidth)
+	case maxWidth < minWidth:
+		b.e = newError("maximum width must exceed or equal the minimum width but %d < %d", maxWidth, minWidth)
+	default:
+		b.appendFraction(fractionPrinterParser{field: field, minWidth: minWidth, maxWidth: maxWidth, decimalPoint: decimalPoint})
+	}
+	return b
+}
+
+// AppendLiteral appends a literal, which is printed as is and must match exactly when parsing.
+func (b *DateTimeFormatterBuilder) AppendLiteral(literal string) *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	b.appendLiteral(literal)
+	return b
+}
+
+// AppendOffset appends the zone offset, such as "+08:00".
+//
+// The pattern is one of "+HH", "+HHmm", "+HH:mm", "+HHMM", "+HH:MM", "+HHMMss", "+HH:MM:ss",
+// "+HHMMSS", "+HH:MM:SS", "+HHmmss" and "+HH:mm:ss".
+// Upper case letters are always printed, lower case letters are only printed if non-zero.
+// A zero offset is printed as noOffsetText, such as "Z" or "+00:00".
+func (b *DateTimeFormatterBuilder) AppendOffset(pattern, noOffsetText string) *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	pp, e := newOffsetPrinterParser(pattern, noOffsetText)
+	if e != nil {
+		b.e = e
+		return b
+	}
+	b.appendInternal(pp)
+	return b
+}
+
+// AppendPattern appends the elements described by a Java-style pattern,
+// see DateTimeFormatterOfPattern for the supported letters.
+func (b *DateTimeFormatterBuilder) AppendPattern(pattern string) *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	b.e = b.parsePattern(pattern)
+	return b
+}
+
+// OptionalStart starts an optional section, which ends at the matching OptionalEnd.
+//
+// When formatting, the section is omitted if the temporal doesn't support a field in the section.
+// When parsing, the section is skipped if it doesn't match the text.
+// Sections can be nested, sections still open are ended by ToFormatter.
+func (b *DateTimeFormatterBuilder) OptionalStart() *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	b.optionalStart()
+	return b
+}
+
+// OptionalEnd ends the optional section started by the last OptionalStart.
+// It's an error to call OptionalEnd without an open optional section.
+func (b *DateTimeFormatterBuilder) OptionalEnd() *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	if len(b.stack) <= 1 {
+		b.e = newError("cannot call OptionalEnd() as there was no previous call to OptionalStart()")
+		return b
+	}
+	b.optionalEnd()
+	return b
+}
+
+// ParseCaseInsensitive makes the rest of the formatter match literals and texts ignoring case when parsing.
+// It has no effect on formatting.
+func (b *DateTimeFormatterBuilder) ParseCaseInsensitive() *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	b.appendInternal(caseSensitivePrinterParser{caseSensitive: false})
+	return b
+}
+
+// ParseCaseSensitive makes the rest of the formatter match literals and texts exactly when parsing,
+// which is the default.
+// It has no effect on formatting.
+func (b *DateTimeFormatterBuilder) ParseCaseSensitive() *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	b.appendInternal(caseSensitivePrinterParser{caseSensitive: true})
+	return b
+}
+
+// ParseDefaulting supplies the value of the field when parsing if the field hasn't been parsed
+// before this point, such as the day-of-month for a "yyyy-MM" text parsed into a LocalDate.
+// It has no effect on formatting.
+//
+// The value must be in the range of the field.
+func (b *DateTimeFormatterBuilder) ParseDefaulting(field Field, value int64) *DateTimeFormatterBuilder {
+	if !b.ok() {
+		return b
+	}
+	if b.e = field.check(value); b.e != nil {
+		return b
+	}
+	b.appendInternal(defaultValueParser{field: field, value: value})
+	return b
+}
+
+// ToFormatter creates the DateTimeFormatter, open optional sections are ended.
+// Returns the first error recorded by the builder.
+// The builder can still be used afterward, the formatter is not affected by later changes.
+func (b *DateTimeFormatterBuilder) ToFormatter() (DateTimeFormatter, error) {
+	if !b.ok() {
+		return DateTimeFormatter{}, b.e
+	}
+	b.active()
+	var c = DateTimeFormatterBuilder{stack: slices.Clone(b.stack)}
+	for i := range c.stack {
+		c.stack[i].printerParsers = slices.Clone(c.stack[i].printerParsers)
+	}
+	for len(c.stack) > 1 {
+		c.optionalEnd()
+	}
+	return DateTimeFormatter{printerParser: compositePrinterParser{printerParsers: c.stack[0].printerParsers}, valid: true}, nil
+}
+
+// MustToFormatter creates the DateTimeFormatter.
+// Panics if the builder recorded an error. Use ToFormatter for error handling.
+func (b *DateTimeFormatterBuilder) MustToFormatter() DateTimeFormatter {
+	return mustValue(b.ToFormatter())
+}
+
+func (b *DateTimeFormatterBuilder) appendInternal(pp dateTimePrinterParser) int {
+	var active = b.active()
+	active.printerParsers = append(active.printerParsers, pp)
+	active.valueParserIndex = -1
+	return len(active.printerParsers) - 1
+}
+
+// appendValue appends a numberPrinterParser, supporting adjacent value parsing,
+// such as "yyyyMMdd" where the year leaves 4 digits for the month and day.
+func (b *DateTimeFormatterBuilder) appendValue(pp numberPrinterParser) {
+	var active = b.active()
+	if active.valueParserIndex < 0 {
+		active.valueParserIndex = b.appendInternal(pp)
+		return
+	}
+	var baseIndex = active.valueParserIndex
+	if pp.isFixedWidth() {
+		var basePP = active.printerParsers[baseIndex].(numberPrinterParser)
+		basePP.subsequentWidth += pp.maxWidth
+		active.printerParsers[baseIndex] = basePP
+		b.appendInternal(pp)
+		active.valueParserIndex = baseIndex
+	} else {
+		active.valueParserIndex = b.appendInternal(pp)
+	}
+}
+
+func (b *DateTimeFormatterBuilder) appendFraction(pp fractionPrinterParser) {
+	var active = b.active()
+	var baseIndex = active.valueParserIndex
+	if baseIndex >= 0 && pp.minWidth == pp.maxWidth && !pp.decimalPoint {
+		// a fixed width fraction, such as "SSS", can follow an adjacent value
+		var basePP = active.printerParsers[baseIndex].(numberPrinterParser)
+		basePP.subsequentWidth += pp.maxWidth
+		active.printerParsers[baseIndex] = basePP
+		b.appendInternal(pp)
+		active.valueParserIndex = baseIndex
+		return
+	}
+	b.appendInternal(pp)
+}
+
+func (b *DateTimeFormatterBuilder) appendLiteral(literal string) {
+	if len(literal) > 0 {
+		b.appendInternal(literalPrinterParser{literal: literal})
+	}
+}
+
+func (b *DateTimeFormatterBuilder) optionalStart() {
+	b.active().valueParserIndex = -1
+	b.stack = append(b.stack, formatterBuilderFrame{valueParserIndex: -1})
+}
+
+func (b *DateTimeFormatterBuilder) optionalEnd() {
+	var frame = b.stack[len(b.stack)-1]
+	b.stack = b.stack[:len(b.stack)-1]
+	if len(frame.printerParsers) > 0 {
+		b.appendInternal(compositePrinterParser{printerParsers: frame.printerParsers, optional: true})
+	}
+}
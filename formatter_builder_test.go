@@ -0,0 +1,190 @@
+package goda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateTimeFormatterBuilder(t *testing.T) {
+	f, err := NewDateTimeFormatterBuilder().
+		AppendValue(FieldYear, 4, 15, SignStyleExceedsPad).
+		AppendLiteral("-").
+		AppendValue(FieldMonthOfYear, 2, 2, SignStyleNotNegative).
+		AppendLiteral("-").
+		AppendValue(FieldDayOfMonth, 2, 2, SignStyleNotNegative).
+		OptionalStart().
+		AppendLiteral("T").
+		AppendValue(FieldHourOfDay, 2, 2, SignStyleNotNegative).
+		AppendLiteral(":").
+		AppendValue(FieldMinuteOfHour, 2, 2, SignStyleNotNegative).
+		OptionalStart().
+		AppendLiteral(":").
+		AppendValue(FieldSecondOfMinute, 2, 2, SignStyleNotNegative).
+		AppendFraction(FieldNanoOfSecond, 0, 9, true).
+		OptionalEnd().
+		OptionalEnd().
+		ToFormatter()
+	require.NoError(t, err)
+
+	assert.Equal(t, "2024-03-15", f.MustFormat(MustLocalDateParse("2024-03-15")))
+	assert.Equal(t, "2024-03-15T14:30:00", f.MustFormat(MustLocalDateTimeParse("2024-03-15T14:30")))
+	assert.Equal(t, "2024-03-15T14:30:05.5", f.MustFormat(MustLocalDateTimeParse("2024-03-15T14:30:05.5")))
+	assert.Equal(t, "+12024-03-15", f.MustFormat(MustLocalDateParse("12024-03-15")))
+
+	ldt, err := f.ParseLocalDateTime("2024-03-15T14:30")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15T14:30:00", ldt.String())
+	ldt, err = f.ParseLocalDateTime("2024-03-15T14:30:05.123456")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15T14:30:05.123456", ldt.String())
+	d, err := f.ParseLocalDate("2024-03-15")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15", d.String())
+
+	// the zero value is ready to use
+	var b DateTimeFormatterBuilder
+	f, err = b.AppendPattern("HH:mm").ToFormatter()
+	require.NoError(t, err)
+	assert.Equal(t, "14:30", f.MustFormat(MustLocalTimeParse("14:30")))
+}
+
+func TestDateTimeFormatterBuilder_SignStyle(t *testing.T) {
+	var build = func(style SignStyle) DateTimeFormatter {
+		return NewDateTimeFormatterBuilder().AppendValue(FieldYear, 2, 15, style).MustToFormatter()
+	}
+	for _, it := range []struct {
+		style    SignStyle
+		year     Year
+		expected string
+	}{
+		{SignStyleNormal, 5, "05"},
+		{SignStyleNormal, -5, "-05"},
+		{SignStyleAlways, 5, "+05"},
+		{SignStyleAlways, -5, "-05"},
+		{SignStyleNever, -5, "05"},
+		{SignStyleNotNegative, 5, "05"},
+		{SignStyleExceedsPad, 5, "05"},
+		{SignStyleExceedsPad, 2024, "+2024"},
+		{SignStyleExceedsPad, -5, "-05"},
+	} {
+		var f = build(it.style)
+		actual, err := f.Format(MustYearMonthOf(it.year, March))
+		require.NoError(t, err, "%d %d", it.style, it.year)
+		assert.Equal(t, it.expected, actual, "%d %d", it.style, it.year)
+	}
+	_, err := build(SignStyleNotNegative).Format(MustYearMonthOf(-5, March))
+	assert.Error(t, err)
+
+	// parsing
+	var parse = func(style SignStyle, text string) (int64, bool) {
+		var ctx = dateTimeParseContext{fields: make(map[Field]int64), caseSensitive: true}
+		var pos = build(style).printerParser.parse(&ctx, text, 0)
+		return ctx.fields[FieldYear], pos == len(text)
+	}
+	for _, it := range []struct {
+		style    SignStyle
+		text     string
+		expected int64
+		ok       bool
+	}{
+		{SignStyleNormal, "05", 5, true},
+		{SignStyleNormal, "-05", -5, true},
+		{SignStyleNormal, "+05", 0, false},
+		{SignStyleAlways, "+05", 5, true},
+		{SignStyleAlways, "05", 0, false},
+		{SignStyleNever, "-05", 0, false},
+		{SignStyleNotNegative, "-05", 0, false},
+		{SignStyleExceedsPad, "05", 5, true},
+		{SignStyleExceedsPad, "+2024", 2024, true},
+		{SignStyleExceedsPad, "2024", 0, false},
+		{SignStyleExceedsPad, "+05", 0, false},
+		{SignStyleNormal, "-00", 0, false},
+	} {
+		actual, ok := parse(it.style, it.text)
+		assert.Equal(t, it.ok, ok, "%d %s", it.style, it.text)
+		if it.ok {
+			assert.Equal(t, it.expected, actual, "%d %s", it.style, it.text)
+		}
+	}
+}
+
+func TestDateTimeFormatterBuilder_Invalid(t *testing.T) {
+	for name, b := range map[string]*DateTimeFormatterBuilder{
+		"invalid field":         NewDateTimeFormatterBuilder().AppendValue(Field(0), 1, 2, SignStyleNormal),
+		"invalid sign style":    NewDateTimeFormatterBuilder().AppendValue(FieldMonthOfYear, 1, 2, SignStyle(10)),
+		"min width too small":   NewDateTimeFormatterBuilder().AppendValue(FieldMonthOfYear, 0, 2, SignStyleNormal),
+		"max width too large":   NewDateTimeFormatterBuilder().AppendValue(FieldEpochDay, 1, 20, SignStyleNormal),
+		"max less than min":     NewDateTimeFormatterBuilder().AppendValue(FieldDayOfYear, 4, 3, SignStyleNormal),
+		"width of month":        NewDateTimeFormatterBuilder().AppendValue(FieldMonthOfYear, 1, 1, SignStyleNormal),
+		"width of nano":         NewDateTimeFormatterBuilder().AppendValue(FieldNanoOfSecond, 2, 8, SignStyleNormal),
+		"width of year":         NewDateTimeFormatterBuilder().AppendValue(FieldYear, 4, 4, SignStyleExceedsPad),
+		"fraction min width":    NewDateTimeFormatterBuilder().AppendFraction(FieldNanoOfSecond, -1, 9, true),
+		"fraction max width":    NewDateTimeFormatterBuilder().AppendFraction(FieldNanoOfSecond, 0, 10, true),
+		"fraction max < min":    NewDateTimeFormatterBuilder().AppendFraction(FieldNanoOfSecond, 3, 2, true),
+		"fraction field":        NewDateTimeFormatterBuilder().AppendFraction(Field(100), 0, 9, true),
+		"offset pattern":        NewDateTimeFormatterBuilder().AppendOffset("+hh:mm", "Z"),
+		"optional end":          NewDateTimeFormatterBuilder().OptionalEnd(),
+		"defaulting range":      NewDateTimeFormatterBuilder().ParseDefaulting(FieldDayOfMonth, 32),
+		"defaulting field":      NewDateTimeFormatterBuilder().ParseDefaulting(Field(0), 1),
+		"pattern":               NewDateTimeFormatterBuilder().AppendPattern("yyyy-q"),
+		"first error is sticky": NewDateTimeFormatterBuilder().AppendPattern("q").AppendLiteral("x").OptionalStart(),
+	} {
+		_, err := b.ToFormatter()
+		assert.Error(t, err, name)
+		assert.Panics(t, func() { b.MustToFormatter() }, name)
+	}
+
+	_, err := NewDateTimeFormatterBuilder().AppendValue(FieldMonthOfYear, 2, 2, SignStyleNormal).AppendPattern("q").AppendValue(Field(0), 1, 1, SignStyleNormal).ToFormatter()
+	assert.ErrorContains(t, err, "unknown pattern letter")
+}
+
+func TestDateTimeFormatterBuilder_ParseCaseInsensitive(t *testing.T) {
+	var f = NewDateTimeFormatterBuilder().
+		AppendPattern("dd-").
+		ParseCaseInsensitive().
+		AppendPattern("MMM-yyyy'T'").
+		ParseCaseSensitive().
+		AppendLiteral("x").
+		MustToFormatter()
+	for _, text := range []string{"15-Mar-2024Tx", "15-mar-2024tx", "15-MAR-2024Tx"} {
+		d, err := f.ParseLocalDate(text)
+		require.NoError(t, err, text)
+		assert.Equal(t, "2024-03-15", d.String(), text)
+	}
+	_, err := f.ParseLocalDate("15-Mar-2024TX")
+	assert.Error(t, err)
+	assert.Equal(t, "15-Mar-2024Tx", f.MustFormat(MustLocalDateParse("2024-03-15")))
+}
+
+func TestDateTimeFormatterBuilder_ParseDefaulting(t *testing.T) {
+	var f = NewDateTimeFormatterBuilder().
+		AppendPattern("yyyy-MM[-dd]").
+		ParseDefaulting(FieldDayOfMonth, 1).
+		ParseDefaulting(FieldHourOfDay, 9).
+		MustToFormatter()
+	d, err := f.ParseLocalDate("2024-03")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01", d.String())
+
+	d, err = f.ParseLocalDate("2024-03-15")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15", d.String())
+
+	ldt, err := f.ParseLocalDateTime("2024-03-15")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15T09:00:00", ldt.String())
+
+	// defaulting has no effect on formatting
+	assert.Equal(t, "2024-03-15", f.MustFormat(MustLocalDateParse("2024-03-15")))
+}
+
+func TestDateTimeFormatterBuilder_Reuse(t *testing.T) {
+	var b = NewDateTimeFormatterBuilder().AppendPattern("yyyy[-MM")
+	f1 := b.MustToFormatter()
+	f2 := b.AppendPattern("-dd]").MustToFormatter()
+	var d = MustLocalDateParse("2024-03-15")
+	assert.Equal(t, "2024-03", f1.MustFormat(d))
+	assert.Equal(t, "2024-03-15", f2.MustFormat(d))
+}
@@ -0,0 +1,198 @@
+package goda
+
+import (
+	"strings"
+)
+
+// parsePattern appends the printer-parsers described by a Java-style pattern.
+func (b *DateTimeFormatterBuilder) parsePattern(pattern string) error {
+	for pos := 0; pos < len(pattern); pos++ {
+		var cur = pattern[pos]
+		switch {
+		case cur >= 'A' && cur <= 'Z' || cur >= 'a' && cur <= 'z':
+			var start = pos
+			for pos+1 < len(pattern) && pattern[pos+1] == cur {
+				pos++
+			}
+			if e := b.parseField(cur, pos-start+1); e != nil {
+				return e
+			}
+		case cur == '\'':
+			// the quoted literal, two single quotes represent a single quote
+			var start = pos
+			for pos++; pos < len(pattern); pos++ {
+				if pattern[pos] == '\'' {
+					if pos+1 < len(pattern) && pattern[pos+1] == '\'' {
+						pos++
+					} else {
+						break
+					}
+				}
+			}
+			if pos >= len(pattern) {
+				return newError("pattern ends with an incomplete string literal: %s", pattern)
+			}
+			var str = pattern[start+1 : pos]
+			if len(str) == 0 {
+				b.appendLiteral("'")
+			} else {
+				b.appendLiteral(strings.ReplaceAll(str, "''", "'"))
+			}
+		case cur == '[':
+			b.optionalStart()
+		case cur == ']':
+			if len(b.stack) <= 1 {
+				return newError("pattern invalid as it contains ] without previous [")
+			}
+			b.optionalEnd()
+		case cur == '{' || cur == '}' || cur == '#':
+			return newError("pattern includes reserved character: '%c'", cur)
+		default:
+			b.appendLiteral(string(cur))
+		}
+	}
+	return nil
+}
+
+func (b *DateTimeFormatterBuilder) parseField(cur byte, count int) error {
+	var countError = func() error {
+		return newError("too many pattern letters: %c", cur)
+	}
+	switch cur {
+	case 'G':
+		// era
+		if count > 5 {
+			return countError()
+		}
+		b.appendInternal(textPrinterParser{field: FieldEra, style: textStyleOfCount(count)})
+	case 'y', 'u':
+		// year-of-era and proleptic year
+		var field = FieldYearOfEra
+		if cur == 'u' {
+			field = FieldYear
+		}
+		switch {
+		case count == 2:
+			b.appendValue(numberPrinterParser{field: field, minWidth: 2, maxWidth: 2, signStyle: SignStyleNotNegative, reduced: true, baseValue: 2000})
+		case count < 4:
+			b.appendValue(numberPrinterParser{field: field, minWidth: count, maxWidth: 19, signStyle: SignStyleNormal})
+		case count <= 19:
+			b.appendValue(numberPrinterParser{field: field, minWidth: count, maxWidth: 19, signStyle: SignStyleExceedsPad})
+		default:
+			return countError()
+		}
+	case 'M':
+		// month-of-year
+		switch count {
+		case 1:
+			b.appendValue(numberPrinterParser{field: FieldMonthOfYear, minWidth: 1, maxWidth: 19, signStyle: SignStyleNormal})
+		case 2:
+			b.appendValue(numberPrinterParser{field: FieldMonthOfYear, minWidth: 2, maxWidth: 2, signStyle: SignStyleNotNegative})
+		case 3, 4, 5:
+			b.appendInternal(textPrinterParser{field: FieldMonthOfYear, style: textStyleOfCount(count)})
+		default:
+			return countError()
+		}
+	case 'E':
+		// day-of-week
+		if count > 5 {
+			return countError()
+		}
+		b.appendInternal(textPrinterParser{field: FieldDayOfWeek, style: textStyleOfCount(count)})
+	case 'a':
+		// am-pm-of-day
+		if count > 1 {
+			return countError()
+		}
+		b.appendInternal(textPrinterParser{field: FieldAmPmOfDay, style: textStyleShort})
+	case 'd', 'H', 'h', 'K', 'k', 'm', 's':
+		var field = map[byte]Field{
+			'd': FieldDayOfMonth,
+			'H': FieldHourOfDay,
+			'h': FieldClockHourOfAmPm,
+			'K': FieldHourOfAmPm,
+			'k': FieldClockHourOfDay,
+			'm': FieldMinuteOfHour,
+			's': FieldSecondOfMinute,
+		}[cur]
+		switch count {
+		case 1:
+			b.appendValue(numberPrinterParser{field: field, minWidth: 1, maxWidth: 19, signStyle: SignStyleNormal})
+		case 2:
+			b.appendValue(numberPrinterParser{field: field, minWidth: 2, maxWidth: 2, signStyle: SignStyleNotNegative})
+		default:
+			return countError()
+		}
+	case 'D':
+		// day-of-year
+		switch count {
+		case 1:
+			b.appendValue(numberPrinterParser{field: FieldDayOfYear, minWidth: 1, maxWidth: 19, signStyle: SignStyleNormal})
+		case 2:
+			b.appendValue(numberPrinterParser{field: FieldDayOfYear, minWidth: 2, maxWidth: 3, signStyle: SignStyleNotNegative})
+		case 3:
+			b.appendValue(numberPrinterParser{field: FieldDayOfYear, minWidth: 3, maxWidth: 3, signStyle: SignStyleNotNegative})
+		default:
+			return countError()
+		}
+	case 'S':
+		// fraction-of-second
+		if count > 9 {
+			return countError()
+		}
+		b.appendFraction(fractionPrinterParser{field: FieldNanoOfSecond, minWidth: count, maxWidth: count})
+	case 'n':
+		// nano-of-second
+		switch {
+		case count == 1:
+			b.appendValue(numberPrinterParser{field: FieldNanoOfSecond, minWidth: 1, maxWidth: 19, signStyle: SignStyleNormal})
+		case count <= 19:
+			b.appendValue(numberPrinterParser{field: FieldNanoOfSecond, minWidth: count, maxWidth: count, signStyle: SignStyleNotNegative})
+		default:
+			return countError()
+		}
+	case 'V':
+		// zone id
+		if count != 2 {
+			return newError("pattern letter count must be 2: %c", cur)
+		}
+		b.appendInternal(zoneIdPrinterParser{})
+	case 'X', 'x':
+		// zone offset, 'X' prints "Z" for zero
+		if count > 5 {
+			return countError()
+		}
+		var pattern = [...]string{"+HHmm", "+HHMM", "+HH:MM", "+HHMMss", "+HH:MM:ss"}[count-1]
+		var noOffsetText = "Z"
+		if cur == 'x' {
+			noOffsetText = [...]string{"+00", "+0000", "+00:00", "+0000", "+00:00"}[count-1]
+		}
+		b.appendInternal(mustValue(newOffsetPrinterParser(pattern, noOffsetText)))
+	case 'Z':
+		// zone offset, such as "+0800", "GMT+08:00" or "+08:00"
+		switch count {
+		case 1, 2, 3:
+			b.appendInternal(mustValue(newOffsetPrinterParser("+HHMM", "+0000")))
+		case 4:
+			b.appendInternal(localizedOffsetPrinterParser{})
+		case 5:
+			b.appendInternal(mustValue(newOffsetPrinterParser("+HH:MM:ss", "Z")))
+		default:
+			return countError()
+		}
+	default:
+		return newError("unknown pattern letter: %c", cur)
+	}
+	return nil
+}
+
+func textStyleOfCount(count int) textStyle {
+	switch count {
+	case 4:
+		return textStyleFull
+	case 5:
+		return textStyleNarrow
+	default:
+		return textStyleShort
+	}
+}
@@ -0,0 +1,767 @@
+package goda
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// dateTimePrinterParser is a single element of a DateTimeFormatter.
+//
+// format appends the formatted value to b.
+// parse parses the text starting at position, it returns the new position on success,
+// or the bitwise complement (^) of the error position on failure.
+type dateTimePrinterParser interface {
+	format(ctx *dateTimePrintContext, b []byte) ([]byte, error)
+	parse(ctx *dateTimeParseContext, text string, position int) int
+}
+
+// errValueNotAvailable is returned by the print context inside an optional section
+// when the temporal cannot supply a value, the optional section is skipped then.
+var errValueNotAvailable = errors.New("value not available")
+
+type dateTimePrintContext struct {
+	temporal TemporalAccessor
+	optional int
+}
+
+func (c *dateTimePrintContext) getValue(field Field) (int64, error) {
+	var v = c.temporal.GetField(field)
+	if v.Unsupported() {
+		if c.optional > 0 {
+			return 0, errValueNotAvailable
+		}
+		return 0, unsupportedField(field)
+	}
+	if v.Overflow() {
+		return 0, overflowError()
+	}
+	return v.Int64(), nil
+}
+
+func (c *dateTimePrintContext) getZone() (ZoneId, error) {
+	if z, ok := c.temporal.(interface{ Zone() ZoneId }); ok && !z.Zone().IsZero() {
+		return z.Zone(), nil
+	}
+	if c.optional > 0 {
+		return ZoneId{}, errValueNotAvailable
+	}
+	return ZoneId{}, newError("unable to obtain zone id from %T", c.temporal)
+}
+
+type dateTimeParseContext struct {
+	fields        map[Field]int64
+	zone          ZoneId
+	caseSensitive bool
+}
+
+// setParsedField stores the parsed value of the field.
+// If the field has already been parsed with a different value, the parse fails at errorPos.
+func (c *dateTimeParseContext) setParsedField(field Field, value int64, errorPos, successPos int) int {
+	if old, ok := c.fields[field]; ok && old != value {
+		return ^errorPos
+	}
+	c.fields[field] = value
+	return successPos
+}
+
+func (c *dateTimeParseContext) copyParsed() (fields map[Field]int64, zone ZoneId) {
+	fields = make(map[Field]int64, len(c.fields))
+	for k, v := range c.fields {
+		fields[k] = v
+	}
+	return fields, c.zone
+}
+
+func (c *dateTimeParseContext) subSequenceEquals(text string, position int, match string) bool {
+	if position+len(match) > len(text) {
+		return false
+	}
+	if c.caseSensitive {
+		return text[position:position+len(match)] == match
+	}
+	return strings.EqualFold(text[position:position+len(match)], match)
+}
+
+// compositePrinterParser is a sequence of printer-parsers, optionally forming an optional section.
+type compositePrinterParser struct {
+	printerParsers []dateTimePrinterParser
+	optional       bool
+}
+
+func (p compositePrinterParser) format(ctx *dateTimePrintContext, b []byte) (_ []byte, e error) {
+	var length = len(b)
+	if p.optional {
+		ctx.optional++
+		defer func() { ctx.optional-- }()
+	}
+	for _, pp := range p.printerParsers {
+		if b, e = pp.format(ctx, b); e != nil {
+			if p.optional && errors.Is(e, errValueNotAvailable) {
+				// the whole optional section is omitted
+				return b[:length], nil
+			}
+			return b, e
+		}
+	}
+	return b, nil
+}
+
+func (p compositePrinterParser) parse(ctx *dateTimeParseContext, text string, position int) int {
+	if p.optional {
+		var fields, zone = ctx.copyParsed()
+		var pos = position
+		for _, pp := range p.printerParsers {
+			pos = pp.parse(ctx, text, pos)
+			if pos < 0 {
+				// restore the state before the optional section
+				ctx.fields, ctx.zone = fields, zone
+				return position
+			}
+		}
+		return pos
+	}
+	for _, pp := range p.printerParsers {
+		position = pp.parse(ctx, text, position)
+		if position < 0 {
+			break
+		}
+	}
+	return position
+}
+
+// literalPrinterParser prints and parses a literal string.
+type literalPrinterParser struct {
+	literal string
+}
+
+func (p literalPrinterParser) format(_ *dateTimePrintContext, b []byte) ([]byte, error) {
+	return append(b, p.literal...), nil
+}
+
+func (p literalPrinterParser) parse(ctx *dateTimeParseContext, text string, position int) int {
+	if position > len(text) || !ctx.subSequenceEquals(text, position, p.literal) {
+		return ^position
+	}
+	return position + len(p.literal)
+}
+
+// SignStyle controls how the sign of a number is printed and parsed by a DateTimeFormatter.
+// This is similar to Java's SignStyle.
+type SignStyle int
+
+const (
+	// SignStyleNormal prints the sign only for negative values, and accepts only '-' when parsing.
+	SignStyleNormal SignStyle = iota
+	// SignStyleAlways always prints the sign, and requires a sign when parsing.
+	SignStyleAlways
+	// SignStyleNever never prints the sign, the absolute value is printed.
+	// A sign is rejected when parsing.
+	SignStyleNever
+	// SignStyleNotNegative rejects negative values when printing, and a sign is rejected when parsing.
+	SignStyleNotNegative
+	// SignStyleExceedsPad prints the sign only if the value exceeds the minimum width or is negative.
+	// When parsing, '+' is required if and only if the value exceeds the minimum width.
+	SignStyleExceedsPad
+)
+
+// Valid returns true if this is a known SignStyle.
+func (s SignStyle) Valid() bool {
+	return s >= SignStyleNormal && s <= SignStyleExceedsPad
+}
+
+// acceptSign reports whether a sign character is accepted while parsing.
+func (s SignStyle) acceptSign(positive bool) bool {
+	switch s {
+	case SignStyleNormal:
+		return !positive
+	case SignStyleAlways, SignStyleExceedsPad:
+		return true
+	default:
+		return false
+	}
+}
+
+var exceedPoints = [...]int64{
+	0,
+	10,
+	100,
+	1000,
+	10000,
+	100000,
+	1000000,
+	10000000,
+	100000000,
+	1000000000,
+	10000000000,
+	100000000000,
+	1000000000000,
+	10000000000000,
+	100000000000000,
+	1000000000000000,
+	10000000000000000,
+	100000000000000000,
+	1000000000000000000,
+}
+
+// numberPrinterParser prints and parses the decimal value of a field.
+// If reduced is true, only the last maxWidth digits are printed,
+// and parsed values are expanded to the range starting at baseValue.
+type numberPrinterParser struct {
+	field           Field
+	minWidth        int
+	maxWidth        int
+	signStyle       SignStyle
+	subsequentWidth int
+	reduced         bool
+	baseValue       int64
+}
+
+func (p numberPrinterParser) format(ctx *dateTimePrintContext, b []byte) ([]byte, error) {
+	value, e := ctx.getValue(p.field)
+	if e != nil {
+		return b, e
+	}
+	if p.reduced {
+		value = p.reduce(value)
+	}
+	var str string
+	if value == math.MinInt64 {
+		str = "9223372036854775808"
+	} else if value < 0 {
+		str = strconv.FormatInt(-value, 10)
+	} else {
+		str = strconv.FormatInt(value, 10)
+	}
+	if len(str) > p.maxWidth {
+		return b, newError("field %s cannot be printed as the value %d exceeds the maximum print width of %d", p.field, value, p.maxWidth)
+	}
+	if value >= 0 {
+		switch p.signStyle {
+		case SignStyleExceedsPad:
+			if p.minWidth < 19 && value >= exceedPoints[p.minWidth] {
+				b = append(b, '+')
+			}
+		case SignStyleAlways:
+			b = append(b, '+')
+		}
+	} else {
+		switch p.signStyle {
+		case SignStyleNormal, SignStyleExceedsPad, SignStyleAlways:
+			b = append(b, '-')
+		case SignStyleNotNegative:
+			return b, newError("field %s cannot be printed as the value %d cannot be negative", p.field, value)
+		}
+	}
+	for i := len(str); i < p.minWidth; i++ {
+		b = append(b, '0')
+	}
+	return append(b, str...), nil
+}
+
+func (p numberPrinterParser) reduce(value int64) int64 {
+	if value < 0 {
+		value = -value
+	}
+	var rangeSize = exceedPoints[p.minWidth]
+	if value >= p.baseValue && value < p.baseValue+rangeSize {
+		return value % rangeSize
+	}
+	return value % exceedPoints[p.maxWidth]
+}
+
+func (p numberPrinterParser) parse(ctx *dateTimeParseContext, text string, position int) int {
+	var length = len(text)
+	if position == length {
+		return ^position
+	}
+	var negative, positive = false, false
+	switch text[position] {
+	case '+':
+		if !p.signStyle.acceptSign(true) {
+			return ^position
+		}
+		positive = true
+		position++
+	case '-':
+		if !p.signStyle.acceptSign(false) {
+			return ^position
+		}
+		negative = true
+		position++
+	default:
+		if p.signStyle == SignStyleAlways {
+			return ^position
+		}
+	}
+	var effMaxWidth = p.maxWidth + max(p.subsequentWidth, 0)
+	var minEndPos = position + p.minWidth
+	if minEndPos > length {
+		return ^position
+	}
+	var total int64
+	var pos = position
+	for pass := 0; pass < 2; pass++ {
+		var maxEndPos = min(pos+effMaxWidth, length)
+		for pos < maxEndPos {
+			var ch = text[pos]
+			if ch < '0' || ch > '9' {
+				if pos < minEndPos {
+					return ^position
+				}
+				break
+			}
+			pos++
+			var overflow bool
+			if total, overflow = mulExact(total, 10); overflow {
+				return ^position
+			}
+			if total, overflow = addExactly(total, int64(ch-'0')); overflow {
+				return ^position
+			}
+		}
+		if p.subsequentWidth > 0 && pass == 0 {
+			// leave enough digits for the adjacent fixed width values
+			effMaxWidth = max(p.minWidth, pos-position-p.subsequentWidth)
+			pos = position
+			total = 0
+		} else {
+			break
+		}
+	}
+	if negative {
+		if total == 0 {
+			// "-0" is not allowed
+			return ^(position - 1)
+		}
+		total = -total
+	} else if p.signStyle == SignStyleExceedsPad {
+		var parseLen = pos - position
+		if positive {
+			if parseLen <= p.minWidth {
+				return ^(position - 1)
+			}
+		} else if parseLen > p.minWidth {
+			return ^position
+		}
+	}
+	if p.reduced {
+		var rangeSize = exceedPoints[pos-position]
+		var lastPart = p.baseValue % rangeSize
+		var basePart = p.baseValue - lastPart
+		if total >= 0 {
+			total = basePart + total
+		} else {
+			total = basePart - total
+		}
+		if total < p.baseValue {
+			total += rangeSize
+		}
+	}
+	return ctx.setParsedField(p.field, total, position, pos)
+}
+
+// isFixedWidth reports whether this printer-parser can be the subsequent value in adjacent value parsing.
+func (p numberPrinterParser) isFixedWidth() bool {
+	return p.minWidth == p.maxWidth && p.signStyle == SignStyleNotNegative
+}
+
+// fractionPrinterParser prints and parses a field with a fixed range as a decimal fraction,
+// such as the nano-of-second 123_000_000 as ".123".
+type fractionPrinterParser struct {
+	field        Field
+	minWidth     int
+	maxWidth     int
+	decimalPoint bool
+}
+
+var bigNanosPerSecond = big.NewInt(1000_000_000)
+
+func (p fractionPrinterParser) format(ctx *dateTimePrintContext, b []byte) ([]byte, error) {
+	value, e := ctx.getValue(p.field)
+	if e != nil {
+		return b, e
+	}
+	if e = p.field.check(value); e != nil {
+		return b, e
+	}
+	var r = p.field.fieldRange()
+	// fraction = (value - min) / (max - min + 1), scaled to 9 digits
+	var fraction = new(big.Int).Sub(big.NewInt(value), big.NewInt(r.Min))
+	fraction.Mul(fraction, bigNanosPerSecond)
+	fraction.Quo(fraction, new(big.Int).Add(new(big.Int).Sub(big.NewInt(r.Max), big.NewInt(r.Min)), big.NewInt(1)))
+	var digits = strconv.FormatInt(fraction.Int64()+1000_000_000, 10)[1:]
+	var scale = len(strings.TrimRight(digits, "0"))
+	var outputScale = min(max(scale, p.minWidth), p.maxWidth)
+	if outputScale == 0 {
+		return b, nil
+	}
+	if p.decimalPoint {
+		b = append(b, '.')
+	}
+	return append(b, digits[:outputScale]...), nil
+}
+
+func (p fractionPrinterParser) parse(ctx *dateTimeParseContext, text string, position int) int {
+	var length = len(text)
+	if position == length {
+		if p.minWidth > 0 {
+			return ^position
+		}
+		return position
+	}
+	if p.decimalPoint {
+		if text[position] != '.' {
+			if p.minWidth > 0 {
+				return ^position
+			}
+			return position
+		}
+		position++
+	}
+	var minEndPos = position + p.minWidth
+	if minEndPos > length {
+		return ^position
+	}
+	var maxEndPos = min(position+p.maxWidth, length)
+	var pos = position
+	for pos < maxEndPos {
+		var ch = text[pos]
+		if ch < '0' || ch > '9' {
+			if pos < minEndPos {
+				return ^position
+			}
+			break
+		}
+		pos++
+	}
+	var r = p.field.fieldRange()
+	// value = fraction * (max - min + 1) + min
+	var value = big.NewInt(parseFraction([]byte(text[position:pos])))
+	value.Mul(value, new(big.Int).Add(new(big.Int).Sub(big.NewInt(r.Max), big.NewInt(r.Min)), big.NewInt(1)))
+	value.Quo(value, bigNanosPerSecond)
+	value.Add(value, big.NewInt(r.Min))
+	return ctx.setParsedField(p.field, value.Int64(), position, pos)
+}
+
+// textStyle is the style of the text of a field.
+type textStyle int
+
+const (
+	textStyleFull textStyle = iota
+	textStyleShort
+	textStyleNarrow
+)
+
+var (
+	eraTexts  = [...][2]string{textStyleFull: {"Before Christ", "Anno Domini"}, textStyleShort: {"BC", "AD"}, textStyleNarrow: {"B", "A"}}
+	amPmTexts = [...][2]string{textStyleFull: {"AM", "PM"}, textStyleShort: {"AM", "PM"}, textStyleNarrow: {"a", "p"}}
+)
+
+// fieldText returns the English text of the value, or false if the field has no text.
+func fieldText(field Field, style textStyle, value int64) (string, bool) {
+	var full string
+	switch field {
+	case FieldMonthOfYear:
+		if value < 1 || value > 12 {
+			return "", false
+		}
+		full = Month(value).String()
+	case FieldDayOfWeek:
+		if value < 1 || value > 7 {
+			return "", false
+		}
+		full = DayOfWeek(value).String()
+	case FieldAmPmOfDay:
+		if value < 0 || value > 1 {
+			return "", false
+		}
+		return amPmTexts[style][value], true
+	case FieldEra:
+		if value < 0 || value > 1 {
+			return "", false
+		}
+		return eraTexts[style][value], true
+	default:
+		return "", false
+	}
+	switch style {
+	case textStyleShort:
+		return full[:3], true
+	case textStyleNarrow:
+		return full[:1], true
+	default:
+		return full, true
+	}
+}
+
+// textPrinterParser prints and parses the English text of a field, such as "Mar" or "Monday".
+// Fields without text are printed and parsed as numbers.
+type textPrinterParser struct {
+	field Field
+	style textStyle
+}
+
+func (p textPrinterParser) numberPrinterParser() numberPrinterParser {
+	return numberPrinterParser{field: p.field, minWidth: 1, maxWidth: 19, signStyle: SignStyleNormal}
+}
+
+func (p textPrinterParser) format(ctx *dateTimePrintContext, b []byte) ([]byte, error) {
+	value, e := ctx.getValue(p.field)
+	if e != nil {
+		return b, e
+	}
+	if text, ok := fieldText(p.field, p.style, value); ok {
+		return append(b, text...), nil
+	}
+	return p.numberPrinterParser().format(ctx, b)
+}
+
+func (p textPrinterParser) parse(ctx *dateTimeParseContext, text string, position int) int {
+	if position > len(text) {
+		return ^position
+	}
+	var r = p.field.fieldRange()
+	if _, ok := fieldText(p.field, p.style, r.Min); !ok {
+		return p.numberPrinterParser().parse(ctx, text, position)
+	}
+	// the longest match wins, so "May" is not parsed as "M" in narrow style
+	var matched int64
+	var matchedLen = 0
+	for v := r.Min; v <= r.Max; v++ {
+		var s, _ = fieldText(p.field, p.style, v)
+		if len(s) > matchedLen && ctx.subSequenceEquals(text, position, s) {
+			matched, matchedLen = v, len(s)
+		}
+	}
+	if matchedLen == 0 {
+		return ^position
+	}
+	return ctx.setParsedField(p.field, matched, position, position+matchedLen)
+}
+
+// offsetPatterns are the patterns supported by offsetPrinterParser.
+// Upper case letters are required, lower case letters are optional and only printed if non-zero.
+var offsetPatterns = []string{
+	"+HH", "+HHmm", "+HH:mm", "+HHMM", "+HH:MM", "+HHMMss", "+HH:MM:ss", "+HHMMSS", "+HH:MM:SS", "+HHmmss", "+HH:mm:ss",
+}
+
+// offsetPrinterParser prints and parses an offset such as "+08:00".
+// A zero offset is printed as noOffsetText.
+type offsetPrinterParser struct {
+	noOffsetText string
+	typ          int
+}
+
+func newOffsetPrinterParser(pattern, noOffsetText string) (offsetPrinterParser, error) {
+	for i, it := range offsetPatterns {
+		if it == pattern {
+			return offsetPrinterParser{noOffsetText: noOffsetText, typ: i}, nil
+		}
+	}
+	return offsetPrinterParser{}, newError("invalid zone offset pattern: %s", pattern)
+}
+
+func (p offsetPrinterParser) colon() bool {
+	return p.typ > 0 && p.typ%2 == 0
+}
+
+func (p offsetPrinterParser) format(ctx *dateTimePrintContext, b []byte) ([]byte, error) {
+	totalSecs, e := ctx.getValue(FieldOffsetSeconds)
+	if e != nil {
+		return b, e
+	}
+	if totalSecs == 0 {
+		return append(b, p.noOffsetText...), nil
+	}
+	var absHours = abs(totalSecs / 3600 % 100)
+	var absMinutes = abs(totalSecs / 60 % 60)
+	var absSeconds = abs(totalSecs % 60)
+	var bufPos = len(b)
+	var output = absHours
+	if totalSecs < 0 {
+		b = append(b, '-')
+	} else {
+		b = append(b, '+')
+	}
+	b = append(b, byte('0'+absHours/10), byte('0'+absHours%10))
+	if p.typ >= 3 || (p.typ >= 1 && absMinutes > 0) || (p.typ >= 9 && absSeconds > 0) {
+		if p.colon() {
+			b = append(b, ':')
+		}
+		b = append(b, byte('0'+absMinutes/10), byte('0'+absMinutes%10))
+		output += absMinutes
+		if p.typ == 7 || p.typ == 8 || (p.typ >= 5 && absSeconds > 0) {
+			if p.colon() {
+				b = append(b, ':')
+			}
+			b = append(b, byte('0'+absSeconds/10), byte('0'+absSeconds%10))
+			output += absSeconds
+		}
+	}
+	if output == 0 {
+		// the printed offset is zero, such as "+00" for +00:30 with pattern "+HH"
+		b = append(b[:bufPos], p.noOffsetText...)
+	}
+	return b, nil
+}
+
+func (p offsetPrinterParser) parse(ctx *dateTimeParseContext, text string, position int) int {
+	if position > len(text) {
+		return ^position
+	}
+	if position < len(text) && (text[position] == '+' || text[position] == '-') {
+		if offsetSecs, pos, ok := p.parseOffset(text, position); ok {
+			return ctx.setParsedField(FieldOffsetSeconds, offsetSecs, position, pos)
+		}
+	}
+	if ctx.subSequenceEquals(text, position, p.noOffsetText) {
+		return ctx.setParsedField(FieldOffsetSeconds, 0, position, position+len(p.noOffsetText))
+	}
+	return ^position
+}
+
+// parseOffset parses the signed offset starting at position.
+func (p offsetPrinterParser) parseOffset(text string, position int) (offsetSecs int64, pos int, ok bool) {
+	var parts [3]int64
+	pos = position + 1
+	// minutes are absent for "+HH", seconds are absent for the patterns without "ss" or "SS"
+	var required = [3]bool{true, p.typ >= 3 && p.typ <= 8, p.typ == 7 || p.typ == 8}
+	var present = [3]bool{true, p.typ >= 1, p.typ >= 5}
+	for i := range parts {
+		if !present[i] {
+			break
+		}
+		var start = pos
+		if i > 0 && p.colon() {
+			if start < len(text) && text[start] == ':' {
+				start++
+			} else if required[i] {
+				return 0, 0, false
+			} else {
+				break
+			}
+		}
+		if start+2 > len(text) || text[start] < '0' || text[start] > '9' || text[start+1] < '0' || text[start+1] > '9' {
+			if required[i] {
+				return 0, 0, false
+			}
+			break
+		}
+		parts[i] = int64(text[start]-'0')*10 + int64(text[start+1]-'0')
+		pos = start + 2
+	}
+	if parts[1] > 59 || parts[2] > 59 {
+		return 0, 0, false
+	}
+	offsetSecs = parts[0]*3600 + parts[1]*60 + parts[2]
+	if text[position] == '-' {
+		offsetSecs = -offsetSecs
+	}
+	if FieldOffsetSeconds.check(offsetSecs) != nil {
+		return 0, 0, false
+	}
+	return offsetSecs, pos, true
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// localizedOffsetPrinterParser prints and parses an offset such as "GMT+08:00".
+type localizedOffsetPrinterParser struct{}
+
+func (p localizedOffsetPrinterParser) format(ctx *dateTimePrintContext, b []byte) ([]byte, error) {
+	totalSecs, e := ctx.getValue(FieldOffsetSeconds)
+	if e != nil {
+		return b, e
+	}
+	b = append(b, "GMT"...)
+	if totalSecs == 0 {
+		return b, nil
+	}
+	return offsetPrinterParser{typ: 6}.format(ctx, b)
+}
+
+func (p localizedOffsetPrinterParser) parse(ctx *dateTimeParseContext, text string, position int) int {
+	if !ctx.subSequenceEquals(text, position, "GMT") {
+		return ^position
+	}
+	position += 3
+	if position == len(text) || (text[position] != '+' && text[position] != '-') {
+		return ctx.setParsedField(FieldOffsetSeconds, 0, position, position)
+	}
+	return offsetPrinterParser{typ: 6}.parse(ctx, text, position)
+}
+
+// caseSensitivePrinterParser changes the case sensitivity of the subsequent parsing,
+// it prints nothing.
+type caseSensitivePrinterParser struct {
+	caseSensitive bool
+}
+
+func (p caseSensitivePrinterParser) format(_ *dateTimePrintContext, b []byte) ([]byte, error) {
+	return b, nil
+}
+
+func (p caseSensitivePrinterParser) parse(ctx *dateTimeParseContext, _ string, position int) int {
+	ctx.caseSensitive = p.caseSensitive
+	return position
+}
+
+// defaultValueParser sets the value of the field if it hasn't been parsed, it prints nothing.
+type defaultValueParser struct {
+	field Field
+	value int64
+}
+
+func (p defaultValueParser) format(_ *dateTimePrintContext, b []byte) ([]byte, error) {
+	return b, nil
+}
+
+func (p defaultValueParser) parse(ctx *dateTimeParseContext, _ string, position int) int {
+	if _, ok := ctx.fields[p.field]; !ok {
+		ctx.fields[p.field] = p.value
+	}
+	return position
+}
+
+// zoneIdPrinterParser prints and parses a zone id such as "Europe/Paris".
+type zoneIdPrinterParser struct{}
+
+func (p zoneIdPrinterParser) format(ctx *dateTimePrintContext, b []byte) ([]byte, error) {
+	zone, e := ctx.getZone()
+	if e != nil {
+		return b, e
+	}
+	return zone.AppendText(b)
+}
+
+func (p zoneIdPrinterParser) parse(ctx *dateTimeParseContext, text string, position int) int {
+	var pos = position
+	for pos < len(text) {
+		var ch = text[pos]
+		if ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || strings.IndexByte("/_+-:.~", ch) >= 0 {
+			pos++
+			continue
+		}
+		break
+	}
+	if pos == position {
+		return ^position
+	}
+	zone, e := ZoneIdOf(text[position:pos])
+	if e != nil {
+		return ^position
+	}
+	if !ctx.zone.IsZero() && ctx.zone != zone {
+		return ^position
+	}
+	ctx.zone = zone
+	return pos
+}
@@ -0,0 +1,324 @@
+package goda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateTimeFormatter_Format(t *testing.T) {
+	var ldt = MustLocalDateTimeParse("2024-03-05T14:07:09.012345678")
+	var odt = ldt.AtOffset(MustZoneOffsetOfHoursMinutes(5, 30))
+	for _, it := range []struct {
+		pattern  string
+		temporal TemporalAccessor
+		expected string
+	}{
+		{"dd/MM/yyyy HH:mm", ldt, "05/03/2024 14:07"},
+		{"d/M/y H:m:s", ldt, "5/3/2024 14:7:9"},
+		{"d-MMM-yyyy", ldt.LocalDate(), "5-Mar-2024"},
+		{"EEEE, MMMM d, uuuu", ldt, "Tuesday, March 5, 2024"},
+		{"EEE MMMMM EEEEE", ldt, "Tue M T"},
+		{"hh:mm a", ldt, "02:07 PM"},
+		{"h K k a", MustLocalTimeParse("00:30"), "12 0 24 AM"},
+		{"yy-MM", ldt, "24-03"},
+		{"D DD DDD", ldt, "65 65 065"},
+		{"HH:mm:ss.SSS", ldt, "14:07:09.012"},
+		{"ss.SSSSSSSSS n", ldt, "09.012345678 12345678"},
+		{"S", ldt, "0"},
+		{"G GGGG GGGGG", ldt, "AD Anno Domini A"},
+		{"yyyy-MM-dd'T'HH:mm", ldt, "2024-03-05T14:07"},
+		{"'o''clock' ''", ldt, "o'clock '"},
+		{"yyyy-MM-dd[ HH:mm]", ldt, "2024-03-05 14:07"},
+		{"yyyy-MM-dd[ HH:mm]", ldt.LocalDate(), "2024-03-05"},
+		{"HH:mm[ XXX]", ldt, "14:07"},
+		{"X XX XXX XXXX XXXXX", odt, "+0530 +0530 +05:30 +0530 +05:30"},
+		{"x xx xxx Z ZZZZ ZZZZZ", odt, "+0530 +0530 +05:30 +0530 GMT+05:30 +05:30"},
+		{"X x xx xxx Z ZZZZ ZZZZZ", ldt.AtOffset(ZoneOffsetUTC()), "Z +00 +0000 +00:00 +0000 GMT Z"},
+		{"X XX XXX", ldt.AtOffset(MustZoneOffsetOfHours(-8)), "-08 -0800 -08:00"},
+		{"XXXXX XXXX", ldt.AtOffset(MustZoneOffsetOf(1, 2, 3)), "+01:02:03 +010203"},
+		{"yyyy-MM-dd HH:mm VV", MustZonedDateTimeOf(ldt, MustZoneIdOf("Europe/Paris")), "2024-03-05 14:07 Europe/Paris"},
+		{"uuuu", MustLocalDateOf(12345, 1, 1), "+12345"},
+		{"uuuu", MustLocalDateOf(-45, 1, 1), "-0045"},
+		{"yyyy G", MustLocalDateOf(-45, 1, 1), "0046 BC"},
+		{"MM/uuuu", MustYearMonthOf(2024, March), "03/2024"},
+	} {
+		var f = MustDateTimeFormatterOfPattern(it.pattern)
+		actual, err := f.Format(it.temporal)
+		require.NoError(t, err, it.pattern)
+		assert.Equal(t, it.expected, actual, it.pattern)
+	}
+
+	var f = MustDateTimeFormatterOfPattern("yyyy-MM-dd")
+	s, err := f.Format(LocalDate{})
+	require.NoError(t, err)
+	assert.Equal(t, "", s)
+	s, err = f.Format(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", s)
+
+	b, err := f.AppendFormat([]byte("date: "), MustLocalDateParse("2024-03-05"))
+	require.NoError(t, err)
+	assert.Equal(t, "date: 2024-03-05", string(b))
+}
+
+func TestDateTimeFormatter_FormatError(t *testing.T) {
+	_, err := MustDateTimeFormatterOfPattern("yyyy-MM-dd HH:mm").Format(MustLocalDateParse("2024-03-05"))
+	assert.ErrorIs(t, err, ErrUnsupported)
+
+	_, err = MustDateTimeFormatterOfPattern("HH:mm VV").Format(MustOffsetDateTimeParse("2024-03-05T14:07:09+08:00"))
+	assert.Error(t, err)
+
+	_, err = MustDateTimeFormatterOfPattern("yy").Format(MustLocalDateOf(-5, 1, 1))
+	assert.NoError(t, err)
+
+	b, err := MustDateTimeFormatterOfPattern("HH:mm").AppendFormat([]byte("x"), MustLocalDateParse("2024-03-05"))
+	assert.Error(t, err)
+	assert.Equal(t, "x", string(b))
+}
+
+func TestDateTimeFormatterOfPattern_Invalid(t *testing.T) {
+	for _, pattern := range []string{
+		"yyyyyyyyyyyyyyyyyyyy",
+		"MMMMMM",
+		"ddd",
+		"EEEEEE",
+		"aa",
+		"SSSSSSSSSS",
+		"V",
+		"VVV",
+		"XXXXXX",
+		"ZZZZZZ",
+		"'abc",
+		"yyyy]",
+		"{",
+		"#",
+		"q",
+		"O",
+	} {
+		_, err := DateTimeFormatterOfPattern(pattern)
+		assert.Error(t, err, pattern)
+	}
+	assert.Panics(t, func() { MustDateTimeFormatterOfPattern("q") })
+
+	// unclosed optional sections are closed automatically
+	f, err := DateTimeFormatterOfPattern("yyyy[-MM")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03", f.MustFormat(MustLocalDateParse("2024-03-05")))
+	assert.False(t, f.IsZero())
+	assert.True(t, DateTimeFormatter{}.IsZero())
+}
+
+func TestDateTimeFormatter_ParseLocalDate(t *testing.T) {
+	for _, it := range [][3]string{
+		{"dd/MM/yyyy", "15/03/2024", "2024-03-15"},
+		{"d/M/y", "5/3/2024", "2024-03-05"},
+		{"dd-MMM-yyyy", "15-Mar-2024", "2024-03-15"},
+		{"EEEE, MMMM d, uuuu", "Friday, March 15, 2024", "2024-03-15"},
+		{"yyyyMMdd", "20240315", "2024-03-15"},
+		{"yyyyMMdd", "+120240315", "12024-03-15"},
+		{"yMMdd", "20240315", "2024-03-15"},
+		{"yyMMdd", "240315", "2024-03-15"},
+		{"dd.MM.yy", "15.03.99", "2099-03-15"},
+		{"yyyy-DDD", "2024-075", "2024-03-15"},
+		{"uuuu-MM-dd", "-0045-03-15", "-0045-03-15"},
+		{"uuuu-MM-dd", "+12345-03-15", "12345-03-15"},
+		{"yyyy-MM-dd G", "0046-03-15 BC", "-0045-03-15"},
+		{"yyyy-MM-dd[ HH:mm]", "2024-03-15", "2024-03-15"},
+		{"yyyy-MM-dd[ HH:mm]", "2024-03-15 10:30", "2024-03-15"},
+		{"yyyy-MM-dd[ EEE]", "2024-03-15 Fri", "2024-03-15"},
+	} {
+		var f = MustDateTimeFormatterOfPattern(it[0])
+		d, err := f.ParseLocalDate(it[1])
+		require.NoError(t, err, "%s %s", it[0], it[1])
+		assert.Equal(t, it[2], d.String(), "%s %s", it[0], it[1])
+	}
+
+	d, err := MustDateTimeFormatterOfPattern("yyyy-MM-dd").ParseLocalDate("")
+	require.NoError(t, err)
+	assert.True(t, d.IsZero())
+}
+
+func TestDateTimeFormatter_ParseError(t *testing.T) {
+	for _, it := range [][2]string{
+		{"yyyy-MM-dd", "2024-03-15x"},
+		{"yyyyMMdd", "120240315"},
+		{"yyyy-MM-dd", "2024-3-15"},
+		{"yyyy-MM-dd", "2024-13-01"},
+		{"yyyy-MM-dd", "+2024-03-15"},
+		{"yyyy-MM-dd", "-0000-03-15"},
+		{"uuuu-MM-dd", "+2024-03-15"},
+		{"dd-MMM-yyyy", "15-mar-2024"},
+		{"dd-MMM-yyyy", "15-March-2024"},
+		{"yyyy-MM-dd EEE", "2024-03-15 Mon"},
+		{"yyyy-MM", "2024-03"},
+		{"HH:mm", "10:30"},
+		{"yyyy-MM-dd uuuu", "2024-03-15 2025"},
+		{"yyyy-MM-dd", "99999999999999999999-03-15"},
+	} {
+		_, err := MustDateTimeFormatterOfPattern(it[0]).ParseLocalDate(it[1])
+		assert.Error(t, err, "%s %s", it[0], it[1])
+	}
+	_, err := DateTimeFormatter{}.ParseLocalDate("2024-03-15")
+	assert.Error(t, err)
+
+	_, err = MustDateTimeFormatterOfPattern("yyyy-MM-dd").ParseLocalDate("2024-03-15x")
+	assert.ErrorContains(t, err, "unparsed text found at index 10")
+	_, err = MustDateTimeFormatterOfPattern("yyyy-MM-dd").ParseLocalDate("2024/03/15")
+	assert.ErrorContains(t, err, "could not be parsed at index 4")
+}
+
+func TestDateTimeFormatter_ParseLocalTime(t *testing.T) {
+	for _, it := range [][3]string{
+		{"HH:mm", "14:30", "14:30:00"},
+		{"HH", "14", "14:00:00"},
+		{"HH:mm:ss.SSS", "14:30:15.123", "14:30:15.123"},
+		{"HHmmssSSS", "143015123", "14:30:15.123"},
+		{"HH:mm:ss.n", "14:30:15.5", "14:30:15.000000005"},
+		{"hh:mm a", "02:30 PM", "14:30:00"},
+		{"hh:mm a", "12:30 AM", "00:30:00"},
+		{"hh:mm a", "12:30 PM", "12:30:00"},
+		{"K:mm a", "0:30 PM", "12:30:00"},
+		{"kk:mm", "24:00", "00:00:00"},
+		{"HH:mm a", "14:30 PM", "14:30:00"},
+		{"HH:mm[:ss]", "14:30", "14:30:00"},
+		{"HH:mm[:ss]", "14:30:15", "14:30:15"},
+	} {
+		var f = MustDateTimeFormatterOfPattern(it[0])
+		lt, err := f.ParseLocalTime(it[1])
+		require.NoError(t, err, "%s %s", it[0], it[1])
+		assert.Equal(t, it[2], lt.String(), "%s %s", it[0], it[1])
+	}
+	for _, it := range [][2]string{
+		{"HH:mm a", "14:30 AM"},
+		{"HH:mm", "24:01"},
+		{"hh:mm", "13:00"},
+		{"HH:mm:ss.SSS", "14:30:15.12"},
+		{"yyyy-MM-dd", "2024-03-15"},
+	} {
+		_, err := MustDateTimeFormatterOfPattern(it[0]).ParseLocalTime(it[1])
+		assert.Error(t, err, "%s %s", it[0], it[1])
+	}
+}
+
+func TestDateTimeFormatter_ParseLocalDateTime(t *testing.T) {
+	var f = MustDateTimeFormatterOfPattern("dd/MM/yyyy HH:mm")
+	ldt, err := f.ParseLocalDateTime("15/03/2024 14:30")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15T14:30:00", ldt.String())
+
+	f = MustDateTimeFormatterOfPattern("yyyyMMddHHmmssSSS")
+	ldt, err = f.ParseLocalDateTime("20240315143015123")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15T14:30:15.123", ldt.String())
+	assert.Equal(t, "20240315143015123", f.MustFormat(ldt))
+
+	_, err = MustDateTimeFormatterOfPattern("yyyy-MM-dd").ParseLocalDateTime("2024-03-15")
+	assert.Error(t, err)
+}
+
+func TestDateTimeFormatter_ParseOffsetDateTime(t *testing.T) {
+	for _, it := range [][3]string{
+		{"yyyy-MM-dd HH:mmXXX", "2024-03-15 14:30+05:30", "2024-03-15T14:30:00+05:30"},
+		{"yyyy-MM-dd HH:mmXXX", "2024-03-15 14:30Z", "2024-03-15T14:30:00Z"},
+		{"yyyy-MM-dd HH:mmX", "2024-03-15 14:30-08", "2024-03-15T14:30:00-08:00"},
+		{"yyyy-MM-dd HH:mmX", "2024-03-15 14:30+0530", "2024-03-15T14:30:00+05:30"},
+		{"yyyy-MM-dd HH:mm xx", "2024-03-15 14:30 +0000", "2024-03-15T14:30:00Z"},
+		{"yyyy-MM-dd HH:mm x", "2024-03-15 14:30 +00", "2024-03-15T14:30:00Z"},
+		{"yyyy-MM-dd HH:mm Z", "2024-03-15 14:30 -0800", "2024-03-15T14:30:00-08:00"},
+		{"yyyy-MM-dd HH:mm ZZZZ", "2024-03-15 14:30 GMT+08:00", "2024-03-15T14:30:00+08:00"},
+		{"yyyy-MM-dd HH:mm ZZZZ", "2024-03-15 14:30 GMT", "2024-03-15T14:30:00Z"},
+		{"yyyy-MM-dd HH:mm XXXXX", "2024-03-15 14:30 +01:02:03", "2024-03-15T14:30:00+01:02:03"},
+		{"yyyy-MM-dd HH:mm VV", "2024-03-15 14:30 UTC+03:00", "2024-03-15T14:30:00+03:00"},
+	} {
+		var f = MustDateTimeFormatterOfPattern(it[0])
+		odt, err := f.ParseOffsetDateTime(it[1])
+		require.NoError(t, err, "%s %s", it[0], it[1])
+		assert.Equal(t, it[2], odt.String(), "%s %s", it[0], it[1])
+	}
+	for _, it := range [][2]string{
+		{"yyyy-MM-dd HH:mm", "2024-03-15 14:30"},
+		{"yyyy-MM-dd HH:mmXXX", "2024-03-15 14:30+0530"},
+		{"yyyy-MM-dd HH:mmXX", "2024-03-15 14:30+05:30"},
+		{"yyyy-MM-dd HH:mmXXX", "2024-03-15 14:30+19:00"},
+		{"yyyy-MM-dd HH:mm VV", "2024-03-15 14:30 Europe/Paris"},
+		{"yyyy-MM-dd HH:mm VV", "2024-03-15 14:30 Mars/Olympus"},
+	} {
+		_, err := MustDateTimeFormatterOfPattern(it[0]).ParseOffsetDateTime(it[1])
+		assert.Error(t, err, "%s %s", it[0], it[1])
+	}
+}
+
+func TestDateTimeFormatter_ParseOffsetTime(t *testing.T) {
+	for _, it := range [][3]string{
+		{"HH:mmXXX", "09:00+08:00", "09:00:00+08:00"},
+		{"HH:mm:ssX", "14:30:45-07", "14:30:45-07:00"},
+		{"hh:mm a VV", "02:30 PM UTC", "14:30:00Z"},
+	} {
+		var f = MustDateTimeFormatterOfPattern(it[0])
+		ot, err := f.ParseOffsetTime(it[1])
+		require.NoError(t, err, "%s %s", it[0], it[1])
+		assert.Equal(t, it[2], ot.String(), "%s %s", it[0], it[1])
+	}
+	assert.Equal(t, "14:30:45-07", MustDateTimeFormatterOfPattern("HH:mm:ssX").MustFormat(MustOffsetTimeParse("14:30:45-07:00")))
+	for _, it := range [][2]string{
+		{"HH:mm", "09:00"},
+		{"XXX", "+08:00"},
+		{"HH:mm VV", "09:00 Europe/Paris"},
+	} {
+		_, err := MustDateTimeFormatterOfPattern(it[0]).ParseOffsetTime(it[1])
+		assert.Error(t, err, "%s %s", it[0], it[1])
+	}
+	ot, err := MustDateTimeFormatterOfPattern("HH:mmXXX").ParseOffsetTime("")
+	require.NoError(t, err)
+	assert.True(t, ot.IsZero())
+}
+
+func TestDateTimeFormatter_ParseZonedDateTime(t *testing.T) {
+	var f = MustDateTimeFormatterOfPattern("yyyy-MM-dd HH:mm[XXX] VV")
+	zdt, err := f.ParseZonedDateTime("2024-10-27 02:30 Europe/Paris")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-10-27T02:30:00+02:00[Europe/Paris]", zdt.String())
+
+	zdt, err = f.ParseZonedDateTime("2024-10-27 02:30+01:00 Europe/Paris")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-10-27T02:30:00+01:00[Europe/Paris]", zdt.String())
+	assert.Equal(t, "2024-10-27 02:30+01:00 Europe/Paris", f.MustFormat(zdt))
+
+	zdt, err = MustDateTimeFormatterOfPattern("yyyy-MM-dd HH:mmXXX").ParseZonedDateTime("2024-10-27 02:30+01:00")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-10-27T02:30:00+01:00", zdt.String())
+
+	_, err = MustDateTimeFormatterOfPattern("yyyy-MM-dd HH:mm").ParseZonedDateTime("2024-10-27 02:30")
+	assert.Error(t, err)
+}
+
+func TestDateTimeFormatter_ParseYearMonth(t *testing.T) {
+	ym, err := MustDateTimeFormatterOfPattern("MM/yyyy").ParseYearMonth("03/2024")
+	require.NoError(t, err)
+	assert.Equal(t, MustYearMonthOf(2024, March), ym)
+
+	ym, err = MustDateTimeFormatterOfPattern("MMMM uuuu").ParseYearMonth("December 1999")
+	require.NoError(t, err)
+	assert.Equal(t, MustYearMonthOf(1999, December), ym)
+
+	_, err = MustDateTimeFormatterOfPattern("yyyy").ParseYearMonth("2024")
+	assert.Error(t, err)
+}
+
+func TestYearMonth_GetField(t *testing.T) {
+	var ym = MustYearMonthOf(2024, March)
+	assert.True(t, ym.IsSupportedField(FieldProlepticMonth))
+	assert.False(t, ym.IsSupportedField(FieldDayOfMonth))
+	assert.Equal(t, int64(3), ym.GetField(FieldMonthOfYear).Int64())
+	assert.Equal(t, int64(2024*12+2), ym.GetField(FieldProlepticMonth).Int64())
+	assert.Equal(t, int64(2024), ym.GetField(FieldYear).Int64())
+	assert.Equal(t, int64(1), ym.GetField(FieldEra).Int64())
+	assert.True(t, ym.GetField(FieldDayOfMonth).Unsupported())
+	assert.True(t, YearMonth{}.GetField(FieldYear).Unsupported())
+
+	var bc = MustYearMonthOf(-1, March)
+	assert.Equal(t, int64(2), bc.GetField(FieldYearOfEra).Int64())
+	assert.Equal(t, int64(0), bc.GetField(FieldEra).Int64())
+}
@@ -0,0 +1,289 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Instant represents an instantaneous point on the UTC time-line,
+// such as 2024-03-15T14:30:45.123456789Z. It stores the number of seconds
+// since the Unix epoch (1970-01-01T00:00:00Z) and the nanosecond within that second.
+//
+// Instant is intended for machine timestamps such as event times and audit records.
+// Use OffsetDateTime or LocalDateTime when a human-readable local date-time is needed.
+//
+// Instant is comparable and can be used as a map key.
+// The zero value represents an unset instant and IsZero returns true for it.
+// Note: the epoch instant 1970-01-01T00:00:00Z is a valid instant and is different from the zero value.
+//
+// Instant implements sql.Scanner and driver.Valuer for database operations,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]Z (e.g., "2024-03-15T14:30:45.123456789Z").
+// The output always uses UTC and ends with 'Z'. When parsing, any offset is accepted
+// and the value is converted to UTC.
+type Instant struct {
+	seconds int64
+	nanos   int32
+	valid   bool
+}
+
+// EpochSecond returns the number of seconds since Unix epoch (1970-01-01T00:00:00Z).
+// Returns 0 for zero value.
+func (i Instant) EpochSecond() int64 {
+	return i.seconds
+}
+
+// Nano returns the nanosecond within the second (0-999,999,999).
+// Returns 0 for zero value.
+func (i Instant) Nano() int {
+	return int(i.nanos)
+}
+
+// EpochMilli returns the number of milliseconds since Unix epoch (1970-01-01T00:00:00Z).
+// The nanosecond component is truncated towards negative infinity.
+// If the result cannot be represented as int64, it is clamped to math.MinInt64 or math.MaxInt64.
+// Returns 0 for zero value.
+func (i Instant) EpochMilli() int64 {
+	millis, overflow := mulExact(i.seconds, 1000)
+	if !overflow {
+		millis, overflow = addExactly(millis, int64(i.nanos)/1000_000)
+	}
+	if overflow {
+		if i.seconds < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return millis
+}
+
+// IsZero returns true if this is the zero value of Instant.
+func (i Instant) IsZero() bool {
+	return !i.valid
+}
+
+// IsSupportedField returns true if the field is supported by Instant.
+func (i Instant) IsSupportedField(field Field) bool {
+	switch field {
+	case FieldInstantSeconds, FieldNanoOfSecond, FieldMicroOfSecond, FieldMilliOfSecond:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetField returns the value of the specified field as a TemporalValue.
+// This method queries the instant for the value of the specified field.
+// The returned value may be unsupported if the field is not supported by Instant.
+//
+// If the instant is zero (IsZero() returns true), an unsupported TemporalValue is returned.
+//
+// Supported fields include:
+//   - FieldInstantSeconds: returns the seconds since Unix epoch
+//   - FieldNanoOfSecond: returns the nanosecond within the second (0-999,999,999)
+//   - FieldMicroOfSecond: returns the microsecond within the second (0-999,999)
+//   - FieldMilliOfSecond: returns the millisecond within the second (0-999)
+//
+// Overflow Analysis:
+// None of the supported fields can overflow int64, the epoch seconds are stored as int64.
+func (i Instant) GetField(field Field) TemporalValue {
+	if i.IsZero() {
+		return TemporalValue{unsupported: true}
+	}
+	var v int64
+	switch field {
+	case FieldInstantSeconds:
+		v = i.seconds
+	case FieldNanoOfSecond:
+		v = int64(i.nanos)
+	case FieldMicroOfSecond:
+		v = int64(i.nanos) / 1000
+	case FieldMilliOfSecond:
+		v = int64(i.nanos) / 1000_000
+	default:
+		return TemporalValue{unsupported: true}
+	}
+	return TemporalValue{v: v}
+}
+
+// Compare compares this instant with another instant.
+// Returns -1 if this instant is before other, 0 if equal, and 1 if after.
+// Zero values are considered less than non-zero values.
+func (i Instant) Compare(other Instant) int {
+	return doCompare(i, other, compareZero, comparing(Instant.EpochSecond), comparing(Instant.Nano))
+}
+
+// IsBefore returns true if this instant is before the specified instant.
+func (i Instant) IsBefore(other Instant) bool {
+	return i.Compare(other) < 0
+}
+
+// IsAfter returns true if this instant is after the specified instant.
+func (i Instant) IsAfter(other Instant) bool {
+	return i.Compare(other) > 0
+}
+
+// UntilUnit returns the amount of time until another instant in terms of the unit, such as the number of complete seconds.
+// The start is included, but the end is not, the result is negative if the end is before the start.
+//
+// The units from UnitNanos to UnitDays are supported, a day is 86400 seconds.
+// Otherwise an error wrapping ErrUnsupported is returned.
+// Returns an error if the result overflows, or zero if either instant is zero.
+func (i Instant) UntilUnit(endExclusive Instant, unit Unit) (int64, error) {
+	if i.IsZero() || endExclusive.IsZero() {
+		return 0, nil
+	}
+	if !unit.IsTimeBased() && unit != UnitDays {
+		return 0, unsupportedUnit(unit)
+	}
+	return unitsBetween(endExclusive.seconds-i.seconds, int64(endExclusive.nanos)-int64(i.nanos), unit)
+}
+
+// GoTime converts this instant to a time.Time in UTC.
+// Returns time.Time{} (zero) for zero value.
+func (i Instant) GoTime() time.Time {
+	if i.IsZero() {
+		return time.Time{}
+	}
+	return time.Unix(i.seconds, int64(i.nanos)).UTC()
+}
+
+// AtOffset combines this instant with an offset to create an OffsetDateTime.
+// Returns zero value for zero value.
+func (i Instant) AtOffset(offset ZoneOffset) OffsetDateTime {
+	if i.IsZero() {
+		return OffsetDateTime{}
+	}
+	return LocalDateTimeOfInstant(i, offset).AtOffset(offset)
+}
+
+func (i Instant) Chain() (chain InstantChain) {
+	chain.value = i
+	return
+}
+
+func (i Instant) chainWithError(e error) (chain InstantChain) {
+	chain = i.Chain()
+	chain.eError = e
+	return
+}
+
+// InstantOfEpochSecond creates an Instant from seconds since Unix epoch and a nanosecond adjustment.
+// The nanosecond adjustment may be outside the range 0-999,999,999 (including negative),
+// it is normalized into the seconds.
+//
+// Returns an error if the result overflows.
+func InstantOfEpochSecond(epochSecond int64, nanoAdjustment int64) (r Instant, e error) {
+	seconds, overflow := addExactly(epochSecond, floorDiv(nanoAdjustment, 1000_000_000))
+	if overflow {
+		e = overflowError()
+		return
+	}
+	return Instant{
+		seconds: seconds,
+		nanos:   int32(floorMod(nanoAdjustment, 1000_000_000)),
+		valid:   true,
+	}, nil
+}
+
+// MustInstantOfEpochSecond creates an Instant from seconds since Unix epoch and a nanosecond adjustment.
+// Panics if the result overflows. Use InstantOfEpochSecond for error handling.
+func MustInstantOfEpochSecond(epochSecond int64, nanoAdjustment int64) Instant {
+	return mustValue(InstantOfEpochSecond(epochSecond, nanoAdjustment))
+}
+
+// InstantOfEpochMilli creates an Instant from milliseconds since Unix epoch.
+func InstantOfEpochMilli(epochMilli int64) Instant {
+	return Instant{
+		seconds: floorDiv(epochMilli, 1000),
+		nanos:   int32(floorMod(epochMilli, 1000) * 1000_000),
+		valid:   true,
+	}
+}
+
+// InstantOfGoTime creates an Instant from a time.Time.
+// The location of t is irrelevant, only the instant on the time-line is kept.
+// Returns zero value if t.IsZero().
+func InstantOfGoTime(t time.Time) Instant {
+	if t.IsZero() {
+		return Instant{}
+	}
+	return Instant{
+		seconds: t.Unix(),
+		nanos:   int32(t.Nanosecond()),
+		valid:   true,
+	}
+}
+
+// InstantNow returns the current instant from the system clock.
+// This is equivalent to InstantOfGoTime(time.Now()).
+func InstantNow() Instant {
+	return InstantOfGoTime(time.Now())
+}
+
+// InstantNowClock returns the current instant of the clock, the time zone of the clock is ignored.
+func InstantNowClock(clock Clock) Instant {
+	return clock.Instant()
+}
+
+// InstantEpoch returns the instant of the Unix epoch, 1970-01-01T00:00:00Z.
+func InstantEpoch() Instant {
+	return Instant{valid: true}
+}
+
+// InstantMin returns the minimum supported instant.
+func InstantMin() Instant {
+	return Instant{seconds: math.MinInt64, valid: true}
+}
+
+// InstantMax returns the maximum supported instant.
+func InstantMax() Instant {
+	return Instant{seconds: math.MaxInt64, nanos: 999_999_999, valid: true}
+}
+
+// InstantParse parses an instant string in yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]Z format.
+// Any offset accepted by OffsetDateTimeParse is also accepted, the result is converted to UTC.
+// Returns an error if the string is invalid.
+//
+// Example:
+//
+//	i, err := InstantParse("2024-03-15T14:30:45.123Z")
+//	if err != nil {
+//	    // handle error
+//	}
+func InstantParse(s string) (Instant, error) {
+	var i Instant
+	err := i.UnmarshalText([]byte(s))
+	return i, err
+}
+
+// MustInstantParse parses an instant string in yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]Z format.
+// Panics if the string is invalid. Use InstantParse for error handling.
+func MustInstantParse(s string) Instant {
+	return mustValue(InstantParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*Instant)(nil)
+	_ fmt.Stringer             = (*Instant)(nil)
+	_ encoding.TextMarshaler   = (*Instant)(nil)
+	_ encoding.TextUnmarshaler = (*Instant)(nil)
+	_ json.Marshaler           = (*Instant)(nil)
+	_ json.Unmarshaler         = (*Instant)(nil)
+	_ driver.Valuer            = (*Instant)(nil)
+	_ sql.Scanner              = (*Instant)(nil)
+	_ TemporalAccessor         = (*Instant)(nil)
+)
+
+// Compile-time check that Instant is comparable
+func _assertInstantIsComparable[T comparable](t T) {}
+
+var _ = _assertInstantIsComparable[Instant]
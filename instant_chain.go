@@ -0,0 +1,171 @@
+package goda
+
+import "math"
+
+type InstantChain struct {
+	Chain[Instant]
+}
+
+func (i InstantChain) PlusSeconds(seconds int64) InstantChain {
+	defer i.leaveFunction(tyInstant, fnPlusSeconds)
+	return i.plus(seconds, 0)
+}
+
+func (i InstantChain) MinusSeconds(seconds int64) InstantChain {
+	defer i.leaveFunction(tyInstant, fnMinusSeconds)
+	if seconds == math.MinInt64 {
+		return i.PlusSeconds(math.MaxInt64).PlusSeconds(1)
+	}
+	return i.PlusSeconds(-seconds)
+}
+
+func (i InstantChain) PlusMillis(millis int64) InstantChain {
+	defer i.leaveFunction(tyInstant, fnPlusMillis)
+	return i.plus(millis/1000, millis%1000*1000_000)
+}
+
+func (i InstantChain) MinusMillis(millis int64) InstantChain {
+	defer i.leaveFunction(tyInstant, fnMinusMillis)
+	if millis == math.MinInt64 {
+		return i.PlusMillis(math.MaxInt64).PlusMillis(1)
+	}
+	return i.PlusMillis(-millis)
+}
+
+func (i InstantChain) PlusNanos(nanos int64) InstantChain {
+	defer i.leaveFunction(tyInstant, fnPlusNanos)
+	return i.plus(0, nanos)
+}
+
+func (i InstantChain) MinusNanos(nanos int64) InstantChain {
+	defer i.leaveFunction(tyInstant, fnMinusNanos)
+	if nanos == math.MinInt64 {
+		return i.PlusNanos(math.MaxInt64).PlusNanos(1)
+	}
+	return i.PlusNanos(-nanos)
+}
+
+// Plus returns a copy of this instant with the specified amount added.
+// A Period is only supported if it has no years and months, a day is treated as 86,400 seconds.
+func (i InstantChain) Plus(amount TemporalAmount) InstantChain {
+	defer i.leaveFunction(tyInstant, fnPlus)
+	switch a := amount.(type) {
+	case Duration:
+		return i.plus(a.seconds, int64(a.nanos))
+	case Period:
+		return i.plusPeriod(a, 1)
+	}
+	return i
+}
+
+// Minus returns a copy of this instant with the specified amount subtracted.
+// A Period is only supported if it has no years and months, a day is treated as 86,400 seconds.
+func (i InstantChain) Minus(amount TemporalAmount) InstantChain {
+	defer i.leaveFunction(tyInstant, fnMinus)
+	switch a := amount.(type) {
+	case Duration:
+		if a.seconds == math.MinInt64 {
+			return i.plus(math.MaxInt64, 0).plus(1, -int64(a.nanos))
+		}
+		return i.plus(-a.seconds, -int64(a.nanos))
+	case Period:
+		return i.plusPeriod(a, -1)
+	}
+	return i
+}
+
+// PlusUnit returns a copy of this instant with the amount of the unit added, such as 90 UnitMinutes.
+// The units from UnitNanos to UnitDays are supported, a day is 86400 seconds.
+// Otherwise an error wrapping ErrUnsupported is returned.
+func (i InstantChain) PlusUnit(amount int64, unit Unit) InstantChain {
+	defer i.leaveFunction(tyInstant, fnPlusUnit)
+	if !i.ok() {
+		return i
+	}
+	if !unit.IsTimeBased() && unit != UnitDays {
+		i.eError = unsupportedUnit(unit)
+		return i
+	}
+	seconds, nanos, overflow := unit.secondsNanos(amount)
+	if overflow {
+		i.eError = overflowError()
+		return i
+	}
+	return i.plus(seconds, nanos)
+}
+
+// MinusUnit returns a copy of this instant with the amount of the unit subtracted, see PlusUnit.
+func (i InstantChain) MinusUnit(amount int64, unit Unit) InstantChain {
+	defer i.leaveFunction(tyInstant, fnMinusUnit)
+	if amount == math.MinInt64 {
+		return i.PlusUnit(math.MaxInt64, unit).PlusUnit(1, unit)
+	}
+	return i.PlusUnit(-amount, unit)
+}
+
+func (i InstantChain) plusPeriod(p Period, sign int64) InstantChain {
+	if !i.ok() {
+		return i
+	}
+	if p.years|p.months != 0 {
+		i.eError = unsupportedAmount(p)
+		return i
+	}
+	seconds, overflow := mulExact(p.days, 86400*sign)
+	if overflow {
+		i.eError = overflowError()
+		return i
+	}
+	return i.plus(seconds, 0)
+}
+
+func (i InstantChain) plus(seconds, nanos int64) InstantChain {
+	if !i.ok() {
+		return i
+	}
+	if seconds|nanos == 0 {
+		return i
+	}
+	s, overflow := addExactly(i.value.seconds, seconds)
+	if !overflow {
+		s, overflow = addExactly(s, nanos/1000_000_000)
+	}
+	if overflow {
+		i.eError = overflowError()
+		return i
+	}
+	i.value, i.eError = InstantOfEpochSecond(s, int64(i.value.nanos)+nanos%1000_000_000)
+	return i
+}
+
+// WithField returns a copy of this Instant with the specified field replaced.
+// Zero values return zero immediately.
+//
+// Supported fields mirror Java's Instant#with(TemporalField, long):
+//   - FieldInstantSeconds: replaces the epoch seconds while keeping nano-of-second.
+//   - FieldNanoOfSecond: replaces the nano-of-second while keeping epoch seconds.
+//   - FieldMicroOfSecond: replaces the nano-of-second with micro-of-second × 1,000.
+//   - FieldMilliOfSecond: replaces the nano-of-second with milli-of-second × 1,000,000.
+//
+// Fields outside this list return an error. Range violations propagate the validation error.
+func (i InstantChain) WithField(field Field, value TemporalValue) InstantChain {
+	defer i.leaveFunction(tyInstant, fnWithField)
+	field.checkSetE(value.Int64(), &i.eError)
+	if !i.ok() {
+		return i
+	}
+	newValue := value.v
+	switch field {
+	case FieldInstantSeconds:
+		i.value.seconds = newValue
+	case FieldNanoOfSecond:
+		i.value.nanos = int32(newValue)
+	case FieldMicroOfSecond:
+		i.value.nanos = int32(newValue * 1000)
+	case FieldMilliOfSecond:
+		i.value.nanos = int32(newValue * 1000_000)
+	default:
+		i.eError = unsupportedField(field)
+	}
+	return i
+}
@@ -0,0 +1,206 @@
+package goda
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstantOfEpochSecond(t *testing.T) {
+	t.Run("normalizes nano adjustment", func(t *testing.T) {
+		i, err := InstantOfEpochSecond(10, 1_500_000_000)
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), i.EpochSecond())
+		assert.Equal(t, 500_000_000, i.Nano())
+
+		i, err = InstantOfEpochSecond(10, -1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(9), i.EpochSecond())
+		assert.Equal(t, 999_999_999, i.Nano())
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		_, err := InstantOfEpochSecond(math.MaxInt64, 1_000_000_000)
+		assert.ErrorIs(t, err, ErrArithmeticOverflow)
+	})
+
+	t.Run("epoch is not zero", func(t *testing.T) {
+		assert.False(t, InstantEpoch().IsZero())
+		assert.True(t, Instant{}.IsZero())
+		assert.Equal(t, InstantEpoch(), MustInstantOfEpochSecond(0, 0))
+	})
+}
+
+func TestInstantOfEpochMilli(t *testing.T) {
+	i := InstantOfEpochMilli(-1)
+	assert.Equal(t, int64(-1), i.EpochSecond())
+	assert.Equal(t, 999_000_000, i.Nano())
+	assert.Equal(t, int64(-1), i.EpochMilli())
+
+	i = InstantOfEpochMilli(1710513045123)
+	assert.Equal(t, "2024-03-15T14:30:45.123Z", i.String())
+	assert.Equal(t, int64(1710513045123), i.EpochMilli())
+
+	assert.Equal(t, int64(math.MaxInt64), InstantMax().EpochMilli())
+	assert.Equal(t, int64(math.MinInt64), InstantMin().EpochMilli())
+}
+
+func TestInstant_GoTime(t *testing.T) {
+	goTime := time.Date(2024, time.March, 15, 14, 30, 45, 123456789, time.FixedZone("", 3600))
+	i := InstantOfGoTime(goTime)
+	assert.Equal(t, goTime.Unix(), i.EpochSecond())
+	assert.Equal(t, 123456789, i.Nano())
+	assert.True(t, goTime.Equal(i.GoTime()))
+	assert.Equal(t, time.UTC, i.GoTime().Location())
+
+	assert.True(t, InstantOfGoTime(time.Time{}).IsZero())
+	assert.True(t, Instant{}.GoTime().IsZero())
+}
+
+func TestInstant_Compare(t *testing.T) {
+	i1 := MustInstantOfEpochSecond(100, 1)
+	i2 := MustInstantOfEpochSecond(100, 2)
+	i3 := MustInstantOfEpochSecond(101, 0)
+	var zero Instant
+
+	assert.Equal(t, -1, i1.Compare(i2))
+	assert.Equal(t, -1, i2.Compare(i3))
+	assert.Equal(t, 0, i1.Compare(i1))
+	assert.Equal(t, 1, i3.Compare(i1))
+	assert.Equal(t, -1, zero.Compare(i1))
+	assert.True(t, i1.IsBefore(i3))
+	assert.True(t, i3.IsAfter(i1))
+}
+
+func TestInstant_GetField(t *testing.T) {
+	i := MustInstantOfEpochSecond(1710513045, 123456789)
+	assert.True(t, i.IsSupportedField(FieldInstantSeconds))
+	assert.False(t, i.IsSupportedField(FieldHourOfDay))
+	assert.Equal(t, int64(1710513045), i.GetField(FieldInstantSeconds).Int64())
+	assert.Equal(t, int64(123456789), i.GetField(FieldNanoOfSecond).Int64())
+	assert.Equal(t, int64(123456), i.GetField(FieldMicroOfSecond).Int64())
+	assert.Equal(t, int64(123), i.GetField(FieldMilliOfSecond).Int64())
+	assert.True(t, i.GetField(FieldYear).Unsupported())
+	assert.True(t, Instant{}.GetField(FieldInstantSeconds).Unsupported())
+}
+
+func TestInstantChain(t *testing.T) {
+	i := MustInstantParse("2024-03-15T14:30:45Z")
+
+	assert.Equal(t, "2024-03-15T14:30:46Z", i.Chain().PlusSeconds(1).MustGet().String())
+	assert.Equal(t, "2024-03-15T14:30:44.999Z", i.Chain().MinusMillis(1).MustGet().String())
+	assert.Equal(t, "2024-03-15T14:30:45.000000001Z", i.Chain().PlusNanos(1).MustGet().String())
+	assert.Equal(t, "2024-03-15T14:30:44.999999999Z", i.Chain().MinusNanos(1).MustGet().String())
+	assert.Equal(t, "2024-03-15T14:30:47.500Z", i.Chain().PlusMillis(2500).MustGet().String())
+	assert.Equal(t, i, i.Chain().PlusNanos(3_000_000_001).MinusSeconds(3).MinusNanos(1).MustGet())
+
+	_, err := InstantMax().Chain().PlusNanos(1).GetResult()
+	assert.ErrorIs(t, err, ErrArithmeticOverflow)
+	_, err = InstantMin().Chain().MinusSeconds(1).GetResult()
+	assert.ErrorIs(t, err, ErrArithmeticOverflow)
+
+	assert.Equal(t, "2024-03-15T14:30:45.007Z", i.Chain().WithField(FieldMilliOfSecond, TemporalValueOf(7)).MustGet().String())
+	assert.Equal(t, "1970-01-01T00:00:00Z", i.Chain().WithField(FieldInstantSeconds, TemporalValueOf(0)).MustGet().String())
+	_, err = i.Chain().WithField(FieldHourOfDay, TemporalValueOf(1)).GetResult()
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestInstant_Conversion(t *testing.T) {
+	offset := MustZoneOffsetOfHours(8)
+	odt := MustOffsetDateTimeParse("2024-03-15T22:30:45.5+08:00")
+	i := odt.ToInstant()
+	assert.Equal(t, odt.EpochSecond(), i.EpochSecond())
+	assert.Equal(t, "2024-03-15T14:30:45.500Z", i.String())
+	assert.Equal(t, odt, i.AtOffset(offset))
+	assert.Equal(t, odt, OffsetDateTimeOfInstant(i, offset))
+	assert.Equal(t, odt.LocalDateTime(), LocalDateTimeOfInstant(i, offset))
+	assert.Equal(t, i, odt.LocalDateTime().ToInstant(offset))
+
+	assert.True(t, OffsetDateTime{}.ToInstant().IsZero())
+	assert.True(t, Instant{}.AtOffset(offset).IsZero())
+
+	// LocalDateTimeOfEpochSecond must not overflow near the int64 bounds
+	ldt := LocalDateTimeOfInstant(InstantMax(), MustZoneOffsetOfHours(18))
+	assert.Equal(t, InstantMax(), ldt.ToInstant(MustZoneOffsetOfHours(18)))
+	ldt = LocalDateTimeOfInstant(InstantMin(), MustZoneOffsetOfHours(-18))
+	assert.Equal(t, InstantMin(), ldt.ToInstant(MustZoneOffsetOfHours(-18)))
+}
+
+func TestInstant_Text(t *testing.T) {
+	t.Run("format", func(t *testing.T) {
+		assert.Equal(t, "1970-01-01T00:00:00Z", InstantEpoch().String())
+		assert.Equal(t, "1969-12-31T23:59:59.999999999Z", MustInstantOfEpochSecond(0, -1).String())
+		assert.Equal(t, "", Instant{}.String())
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		i, err := InstantParse("2024-03-15T14:30:45.123Z")
+		require.NoError(t, err)
+		assert.Equal(t, InstantOfEpochMilli(1710513045123), i)
+
+		i, err = InstantParse("2024-03-15T16:30:45.123+02:00")
+		require.NoError(t, err)
+		assert.Equal(t, InstantOfEpochMilli(1710513045123), i)
+
+		i, err = InstantParse("")
+		require.NoError(t, err)
+		assert.True(t, i.IsZero())
+
+		_, err = InstantParse("2024-03-15T14:30:45")
+		assert.Error(t, err)
+		assert.Panics(t, func() { MustInstantParse("invalid") })
+	})
+
+	t.Run("json", func(t *testing.T) {
+		type S struct {
+			At Instant `json:"at"`
+		}
+		data, err := json.Marshal(S{At: InstantOfEpochMilli(1710513045123)})
+		require.NoError(t, err)
+		assert.Equal(t, `{"at":"2024-03-15T14:30:45.123Z"}`, string(data))
+
+		var s S
+		require.NoError(t, json.Unmarshal(data, &s))
+		assert.Equal(t, InstantOfEpochMilli(1710513045123), s.At)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"at":null}`), &s))
+		assert.True(t, s.At.IsZero())
+	})
+}
+
+func TestInstant_Scan(t *testing.T) {
+	var i Instant
+	require.NoError(t, i.Scan("2024-03-15T14:30:45Z"))
+	assert.Equal(t, int64(1710513045), i.EpochSecond())
+
+	require.NoError(t, i.Scan([]byte("2024-03-15T14:30:46Z")))
+	assert.Equal(t, int64(1710513046), i.EpochSecond())
+
+	require.NoError(t, i.Scan(time.Unix(1710513047, 0)))
+	assert.Equal(t, int64(1710513047), i.EpochSecond())
+
+	require.NoError(t, i.Scan(nil))
+	assert.True(t, i.IsZero())
+
+	assert.Error(t, i.Scan(1))
+
+	v, err := InstantOfEpochMilli(0).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "1970-01-01T00:00:00Z", v)
+	v, err = Instant{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestInstant_ValuePostgres(t *testing.T) {
+	var pg = GetPG(t)
+	expected := MustInstantParse("2000-12-29T12:00:00.123456Z")
+	var actual Instant
+	err := pg.QueryRow("SELECT $1::timestamptz", expected).Scan(&actual)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
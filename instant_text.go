@@ -0,0 +1,90 @@
+package goda
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// String returns the ISO 8601 string representation (yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]Z).
+func (i Instant) String() string {
+	return stringImpl(i)
+}
+
+// AppendText implements encoding.TextAppender.
+// The instant is always formatted in UTC with a 'Z' suffix.
+func (i Instant) AppendText(b []byte) ([]byte, error) {
+	if i.IsZero() {
+		return b, nil
+	}
+	b, _ = LocalDateTimeOfInstant(i, ZoneOffsetUTC()).AppendText(b)
+	return append(b, 'Z'), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i Instant) MarshalText() ([]byte, error) {
+	return marshalTextImpl(i)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Accepts ISO 8601 format: yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]Z or any offset accepted by OffsetDateTime.
+// Empty input is treated as zero value.
+func (i *Instant) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*i = Instant{}
+		return nil
+	}
+	var odt OffsetDateTime
+	if e = odt.UnmarshalText(text); e != nil {
+		return
+	}
+	seconds, overflow := odt.epochSecondOverflow()
+	if overflow {
+		return overflowError()
+	}
+	*i = Instant{seconds: seconds, nanos: int32(odt.Nanosecond()), valid: true}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i Instant) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(i)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Instant) UnmarshalJSON(data []byte) error {
+	if len(data) == 4 && string(data) == "null" {
+		*i = Instant{}
+		return nil
+	}
+	return unmarshalJsonImpl(i, data)
+}
+
+// Scan implements sql.Scanner.
+// It supports scanning from nil, string, []byte, and time.Time.
+// Nil values are converted to the zero value of Instant.
+func (i *Instant) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*i = Instant{}
+		return nil
+	case string:
+		return i.UnmarshalText([]byte(v))
+	case []byte:
+		return i.UnmarshalText(v)
+	case time.Time:
+		*i = InstantOfGoTime(v)
+		return nil
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+// Value implements driver.Valuer.
+// It returns nil for zero values, otherwise returns the instant as a string in UTC.
+func (i Instant) Value() (driver.Value, error) {
+	if i.IsZero() {
+		return nil, nil
+	}
+	return i.String(), nil
+}
@@ -0,0 +1,293 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// Interval represents a half-open interval of instants on the time-line, such as a booking from 10:00 to 12:00.
+// The interval includes the start and excludes the end, an interval whose start equals its end is empty.
+// Either end may be unbounded, represented by InstantMin as the start or InstantMax as the end.
+//
+// Interval is comparable and can be used as a map key.
+// The zero value represents an unset interval and IsZero returns true for it.
+//
+// Interval implements sql.Scanner and driver.Valuer for PostgreSQL tstzrange,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: ISO 8601 start/end (e.g., "2024-03-15T10:00:00Z/2024-03-15T12:00:00Z").
+// When parsing, start/duration and duration/end (e.g., "2024-03-15T10:00:00Z/PT2H") are also accepted.
+//
+// Note: PostgreSQL interval is an amount of time, which maps to Duration or Period instead.
+//
+// This is similar to Interval of ThreeTen-Extra.
+type Interval struct {
+	start Instant
+	end   Instant
+}
+
+// Start returns the start of the interval, inclusive. Returns InstantMin if the start is unbounded.
+func (i Interval) Start() Instant {
+	return i.start
+}
+
+// End returns the end of the interval, exclusive. Returns InstantMax if the end is unbounded.
+func (i Interval) End() Instant {
+	return i.end
+}
+
+// IsZero returns true if this is the zero value.
+func (i Interval) IsZero() bool {
+	return i.start.IsZero()
+}
+
+// IsEmpty returns true if the interval contains no instants, or it's the zero value.
+func (i Interval) IsEmpty() bool {
+	return i.start == i.end
+}
+
+// IsUnboundedStart returns true if the start is unbounded.
+func (i Interval) IsUnboundedStart() bool {
+	return i.start == InstantMin()
+}
+
+// IsUnboundedEnd returns true if the end is unbounded.
+func (i Interval) IsUnboundedEnd() bool {
+	return i.end == InstantMax()
+}
+
+// Duration returns the duration of the interval.
+// Returns zero value if either end is unbounded, or for zero value.
+func (i Interval) Duration() Duration {
+	if i.IsZero() || i.IsUnboundedStart() || i.IsUnboundedEnd() {
+		return Duration{}
+	}
+	seconds, overflow := addExactly(i.end.seconds, -i.start.seconds)
+	if overflow {
+		return Duration{}
+	}
+	d, _ := DurationOfSeconds(seconds, int64(i.end.nanos-i.start.nanos))
+	return d
+}
+
+// Contains returns true if the instant is in the interval.
+// An unbounded end contains InstantMax.
+func (i Interval) Contains(instant Instant) bool {
+	return !i.IsZero() && !instant.IsZero() && !instant.IsBefore(i.start) && (instant.IsBefore(i.end) || i.IsUnboundedEnd())
+}
+
+// Encloses returns true if all the instants of other are in this interval.
+func (i Interval) Encloses(other Interval) bool {
+	return !i.IsZero() && !other.IsZero() && !other.start.IsBefore(i.start) && !other.end.IsAfter(i.end)
+}
+
+// Overlaps returns true if the intervals share at least one instant.
+// An empty interval overlaps no interval.
+func (i Interval) Overlaps(other Interval) bool {
+	return !i.IsEmpty() && !other.IsEmpty() && i.start.IsBefore(other.end) && other.start.IsBefore(i.end)
+}
+
+// Abuts returns true if one interval ends where the other starts, such as 10:00-12:00 and 12:00-14:00.
+// Abutting intervals share no instant.
+func (i Interval) Abuts(other Interval) bool {
+	return !i.IsZero() && !other.IsZero() && (i.end == other.start) != (i.start == other.end)
+}
+
+// IsConnected returns true if the intervals overlap or abut.
+// Connected intervals have an Intersection and a Union.
+func (i Interval) IsConnected(other Interval) bool {
+	return !i.IsZero() && !other.IsZero() && !i.start.IsAfter(other.end) && !other.start.IsAfter(i.end)
+}
+
+// Gap returns the interval between the intervals, which is empty if they abut.
+// Returns an error if the intervals overlap. Returns zero value if either is zero value.
+func (i Interval) Gap(other Interval) (Interval, error) {
+	if i.IsZero() || other.IsZero() {
+		return Interval{}, nil
+	}
+	if i.Overlaps(other) {
+		return Interval{}, newError("intervals %v and %v overlap", i, other)
+	}
+	if other.start.IsBefore(i.start) {
+		i, other = other, i
+	}
+	if other.start.IsBefore(i.end) {
+		// an empty interval within the other one
+		return Interval{start: other.start, end: other.start}, nil
+	}
+	return Interval{start: i.end, end: other.start}, nil
+}
+
+// Intersection returns the instants in both intervals, which is empty if the intervals only abut.
+// Returns an error if the intervals are not connected, see IsConnected. Returns zero value if either is zero value.
+func (i Interval) Intersection(other Interval) (Interval, error) {
+	if i.IsZero() || other.IsZero() {
+		return Interval{}, nil
+	}
+	if !i.IsConnected(other) {
+		return Interval{}, newError("intervals %v and %v are not connected", i, other)
+	}
+	return Interval{start: maxInstant(i.start, other.start), end: minInstant(i.end, other.end)}, nil
+}
+
+// Union returns the instants in either interval.
+// Returns an error if the intervals are not connected, as the union would have a gap, see Span for that case.
+// Returns zero value if either is zero value.
+func (i Interval) Union(other Interval) (Interval, error) {
+	if i.IsZero() || other.IsZero() {
+		return Interval{}, nil
+	}
+	if !i.IsConnected(other) {
+		return Interval{}, newError("intervals %v and %v are not connected", i, other)
+	}
+	return i.Span(other), nil
+}
+
+// Span returns the smallest interval enclosing both intervals, including the gap between them if any.
+// Returns zero value if either is zero value.
+func (i Interval) Span(other Interval) Interval {
+	if i.IsZero() || other.IsZero() {
+		return Interval{}
+	}
+	return Interval{start: minInstant(i.start, other.start), end: maxInstant(i.end, other.end)}
+}
+
+// SplitByDay returns an iterator over the dates of the interval in the time zone,
+// with the part of the interval in each date, split at the start of each day.
+// The start of a day is midnight, or the first instant after it if midnight is in a daylight saving gap.
+// Yields nothing for an empty or unbounded interval, or if the zone is zero.
+func (i Interval) SplitByDay(zone ZoneId) iter.Seq2[LocalDate, Interval] {
+	return func(yield func(LocalDate, Interval) bool) {
+		if i.IsEmpty() || i.IsUnboundedStart() || i.IsUnboundedEnd() || zone.IsZero() {
+			return
+		}
+		zdt, e := ZonedDateTimeOfInstant(i.start, zone)
+		if e != nil {
+			return
+		}
+		var midnight = MustLocalTimeOf(0, 0, 0, 0)
+		for start, date := i.start, zdt.LocalDate(); start.IsBefore(i.end); {
+			var end = i.end
+			next, e := date.Chain().PlusDays(1).GetResult()
+			if e == nil {
+				zdt, e = ZonedDateTimeOf(next.AtTime(midnight), zone)
+			}
+			if e == nil {
+				end = minInstant(zdt.ToInstant(), i.end)
+			}
+			if !yield(date, Interval{start: start, end: end}) {
+				return
+			}
+			start, date = end, next
+		}
+	}
+}
+
+func minInstant(a, b Instant) Instant {
+	if a.IsAfter(b) {
+		return b
+	}
+	return a
+}
+
+func maxInstant(a, b Instant) Instant {
+	if a.IsBefore(b) {
+		return b
+	}
+	return a
+}
+
+// IntervalOf creates an interval from the start, inclusive, to the end, exclusive.
+// Use InstantMin or InstantMax for an unbounded start or end.
+// Returns an error if either is zero, or the end is before the start.
+func IntervalOf(startInclusive, endExclusive Instant) (i Interval, e error) {
+	if startInclusive.IsZero() || endExclusive.IsZero() {
+		return i, newError("start and end of interval must not be zero")
+	}
+	if endExclusive.IsBefore(startInclusive) {
+		return i, newError("end of interval %v is before start %v", endExclusive, startInclusive)
+	}
+	return Interval{start: startInclusive, end: endExclusive}, nil
+}
+
+// MustIntervalOf creates an interval from the start, inclusive, to the end, exclusive.
+// Panics if either is zero, or the end is before the start.
+func MustIntervalOf(startInclusive, endExclusive Instant) Interval {
+	return mustValue(IntervalOf(startInclusive, endExclusive))
+}
+
+// IntervalOfOffsetDateTime creates an interval from the instants of the start, inclusive, and the end, exclusive.
+// Returns an error if either is zero, or the end is before the start.
+func IntervalOfOffsetDateTime(startInclusive, endExclusive OffsetDateTime) (Interval, error) {
+	return IntervalOf(startInclusive.ToInstant(), endExclusive.ToInstant())
+}
+
+// MustIntervalOfOffsetDateTime creates an interval from the instants of the start, inclusive, and the end, exclusive.
+// Panics if either is zero, or the end is before the start.
+func MustIntervalOfOffsetDateTime(startInclusive, endExclusive OffsetDateTime) Interval {
+	return mustValue(IntervalOfOffsetDateTime(startInclusive, endExclusive))
+}
+
+// IntervalOfDuration creates an interval from the start, inclusive, lasting the duration.
+// Returns an error if the start or the duration is zero value, the duration is negative, or the end overflows.
+func IntervalOfDuration(startInclusive Instant, duration Duration) (Interval, error) {
+	if duration.IsZero() {
+		return Interval{}, newError("duration of interval must not be zero")
+	}
+	if duration.IsNegative() {
+		return Interval{}, newError("duration of interval %v is negative", duration)
+	}
+	end, e := startInclusive.Chain().PlusSeconds(duration.Seconds()).PlusNanos(int64(duration.Nano())).GetResult()
+	if e != nil {
+		return Interval{}, e
+	}
+	return IntervalOf(startInclusive, end)
+}
+
+// MustIntervalOfDuration creates an interval from the start, inclusive, lasting the duration.
+// Panics if the start or the duration is invalid.
+func MustIntervalOfDuration(startInclusive Instant, duration Duration) Interval {
+	return mustValue(IntervalOfDuration(startInclusive, duration))
+}
+
+// IntervalAll returns the interval of all instants, both ends are unbounded.
+func IntervalAll() Interval {
+	return Interval{start: InstantMin(), end: InstantMax()}
+}
+
+// IntervalParse parses an interval string in ISO 8601 start/end, start/duration or duration/end format,
+// such as "2024-03-15T10:00:00Z/2024-03-15T12:00:00Z" or "2024-03-15T10:00:00+08:00/PT2H".
+// Returns an error if the string is invalid.
+func IntervalParse(s string) (Interval, error) {
+	var i Interval
+	err := i.UnmarshalText([]byte(s))
+	return i, err
+}
+
+// MustIntervalParse parses an interval string in ISO 8601 start/end, start/duration or duration/end format.
+// Panics if the string is invalid.
+func MustIntervalParse(s string) Interval {
+	return mustValue(IntervalParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*Interval)(nil)
+	_ fmt.Stringer             = (*Interval)(nil)
+	_ encoding.TextMarshaler   = (*Interval)(nil)
+	_ encoding.TextUnmarshaler = (*Interval)(nil)
+	_ json.Marshaler           = (*Interval)(nil)
+	_ json.Unmarshaler         = (*Interval)(nil)
+	_ driver.Valuer            = (*Interval)(nil)
+	_ sql.Scanner              = (*Interval)(nil)
+)
+
+// Compile-time check that Interval is comparable
+func _assertIntervalIsComparable[T comparable](t T) {}
+
+var _ = _assertIntervalIsComparable[Interval]
@@ -0,0 +1,315 @@
+package goda
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntervalOf(t *testing.T) {
+	var start, end = MustInstantParse("2024-03-15T10:00:00Z"), MustInstantParse("2024-03-15T12:00:00Z")
+	i, e := IntervalOf(start, end)
+	require.NoError(t, e)
+	assert.Equal(t, start, i.Start())
+	assert.Equal(t, end, i.End())
+	assert.Equal(t, MustDurationParse("PT2H"), i.Duration())
+	assert.False(t, i.IsZero())
+	assert.False(t, i.IsEmpty())
+	assert.False(t, i.IsUnboundedStart())
+	assert.False(t, i.IsUnboundedEnd())
+	assert.Equal(t, i, MustIntervalOfDuration(start, MustDurationParse("PT2H")))
+	assert.Equal(t, i, MustIntervalOfOffsetDateTime(
+		MustOffsetDateTimeParse("2024-03-15T18:00+08:00"), MustOffsetDateTimeParse("2024-03-15T07:00-05:00")))
+
+	var empty = MustIntervalOf(start, start)
+	assert.True(t, empty.IsEmpty())
+	assert.Equal(t, DurationZero(), empty.Duration())
+
+	var all = IntervalAll()
+	assert.True(t, all.IsUnboundedStart())
+	assert.True(t, all.IsUnboundedEnd())
+	assert.True(t, all.Duration().IsZero())
+	assert.True(t, MustIntervalOf(start, InstantMax()).Duration().IsZero())
+
+	_, e = IntervalOf(end, start)
+	assert.Error(t, e)
+	_, e = IntervalOf(Instant{}, end)
+	assert.Error(t, e)
+	_, e = IntervalOfDuration(start, MustDurationParse("-PT1H"))
+	assert.Error(t, e)
+	_, e = IntervalOfDuration(start, Duration{})
+	assert.Error(t, e)
+	_, e = IntervalOfDuration(InstantMax(), MustDurationParse("PT1S"))
+	assert.Error(t, e)
+	_, e = IntervalOfOffsetDateTime(OffsetDateTime{}, MustOffsetDateTimeParse("2024-03-15T07:00-05:00"))
+	assert.Error(t, e)
+	assert.Panics(t, func() { MustIntervalOf(end, start) })
+
+	var zero Interval
+	assert.True(t, zero.IsZero())
+	assert.True(t, zero.IsEmpty())
+	assert.True(t, zero.Duration().IsZero())
+}
+
+func TestInterval_Relations(t *testing.T) {
+	var morning = MustIntervalParse("2024-03-15T08:00:00Z/2024-03-15T12:00:00Z")
+	var noon = MustIntervalParse("2024-03-15T11:00:00Z/2024-03-15T13:00:00Z")
+	var afternoon = MustIntervalParse("2024-03-15T12:00:00Z/2024-03-15T18:00:00Z")
+	var evening = MustIntervalParse("2024-03-15T19:00:00Z/2024-03-15T22:00:00Z")
+
+	assert.True(t, morning.Contains(MustInstantParse("2024-03-15T08:00:00Z")))
+	assert.True(t, morning.Contains(MustInstantParse("2024-03-15T11:59:59.999999999Z")))
+	assert.False(t, morning.Contains(MustInstantParse("2024-03-15T12:00:00Z")))
+	assert.False(t, morning.Contains(Instant{}))
+	assert.True(t, IntervalAll().Contains(InstantMax()))
+	assert.True(t, IntervalAll().Contains(InstantMin()))
+
+	assert.True(t, morning.Overlaps(noon))
+	assert.True(t, noon.Overlaps(afternoon))
+	assert.False(t, morning.Overlaps(afternoon))
+	assert.False(t, morning.Overlaps(MustIntervalParse("2024-03-15T09:00:00Z/PT0S")))
+	assert.True(t, IntervalAll().Overlaps(morning))
+
+	assert.True(t, morning.Abuts(afternoon))
+	assert.True(t, afternoon.Abuts(morning))
+	assert.False(t, morning.Abuts(noon))
+	assert.False(t, morning.Abuts(evening))
+	assert.True(t, morning.IsConnected(afternoon))
+	assert.False(t, morning.IsConnected(evening))
+
+	assert.True(t, morning.Encloses(MustIntervalParse("2024-03-15T09:00:00Z/PT1H")))
+	assert.False(t, morning.Encloses(noon))
+	assert.True(t, IntervalAll().Encloses(morning))
+
+	gap, e := afternoon.Gap(evening)
+	require.NoError(t, e)
+	assert.Equal(t, MustIntervalParse("2024-03-15T18:00:00Z/2024-03-15T19:00:00Z"), gap)
+	gap, e = evening.Gap(afternoon)
+	require.NoError(t, e)
+	assert.Equal(t, MustIntervalParse("2024-03-15T18:00:00Z/PT1H"), gap)
+	gap, e = morning.Gap(afternoon)
+	require.NoError(t, e)
+	assert.True(t, gap.IsEmpty())
+	assert.Equal(t, MustInstantParse("2024-03-15T12:00:00Z"), gap.Start())
+	_, e = morning.Gap(noon)
+	assert.Error(t, e)
+
+	r, e := morning.Intersection(noon)
+	require.NoError(t, e)
+	assert.Equal(t, MustIntervalParse("2024-03-15T11:00:00Z/PT1H"), r)
+	r, e = morning.Intersection(IntervalAll())
+	require.NoError(t, e)
+	assert.Equal(t, morning, r)
+	_, e = morning.Intersection(evening)
+	assert.Error(t, e)
+
+	r, e = morning.Union(afternoon)
+	require.NoError(t, e)
+	assert.Equal(t, MustIntervalParse("2024-03-15T08:00:00Z/PT10H"), r)
+	_, e = morning.Union(evening)
+	assert.Error(t, e)
+	assert.Equal(t, MustIntervalParse("2024-03-15T08:00:00Z/2024-03-15T22:00:00Z"), evening.Span(morning))
+
+	assert.False(t, morning.Overlaps(Interval{}))
+	assert.False(t, morning.Abuts(Interval{}))
+	assert.True(t, morning.Span(Interval{}).IsZero())
+	r, e = morning.Gap(Interval{})
+	assert.NoError(t, e)
+	assert.True(t, r.IsZero())
+}
+
+func TestInterval_SplitByDay(t *testing.T) {
+	var zone = MustZoneIdOf("America/New_York")
+	var i = MustIntervalParse("2024-03-09T20:00:00-05:00/2024-03-11T03:00:00-04:00")
+	var dates []LocalDate
+	var parts []Interval
+	for date, part := range i.SplitByDay(zone) {
+		dates = append(dates, date)
+		parts = append(parts, part)
+	}
+	assert.Equal(t, []LocalDate{
+		MustLocalDateOf(2024, March, 9),
+		MustLocalDateOf(2024, March, 10),
+		MustLocalDateOf(2024, March, 11),
+	}, dates)
+	assert.Equal(t, []Interval{
+		MustIntervalParse("2024-03-09T20:00:00-05:00/PT4H"),
+		// 2024-03-10 has 23 hours in New York
+		MustIntervalParse("2024-03-10T00:00:00-05:00/PT23H"),
+		MustIntervalParse("2024-03-11T00:00:00-04:00/PT3H"),
+	}, parts)
+
+	for date, part := range MustIntervalParse("2024-03-15T10:00:00Z/PT2H").SplitByDay(MustZoneIdOf("Asia/Tokyo")) {
+		assert.Equal(t, MustLocalDateOf(2024, March, 15), date)
+		assert.Equal(t, MustIntervalParse("2024-03-15T10:00:00Z/PT2H"), part)
+	}
+	var n int
+	for range MustIntervalParse("2024-03-15T10:00:00Z/P10D").SplitByDay(ZoneIdUTC()) {
+		n++
+	}
+	assert.Equal(t, 11, n)
+	for range MustIntervalParse("2024-03-15T10:00:00Z/P10D").SplitByDay(ZoneIdUTC()) {
+		break
+	}
+	for range IntervalAll().SplitByDay(ZoneIdUTC()) {
+		assert.Fail(t, "unbounded interval is not split")
+	}
+	for range MustIntervalParse("2024-03-15T10:00:00Z/PT0S").SplitByDay(ZoneIdUTC()) {
+		assert.Fail(t, "empty interval is not split")
+	}
+	for range MustIntervalParse("2024-03-15T10:00:00Z/PT2H").SplitByDay(ZoneId{}) {
+		assert.Fail(t, "zero zone is not supported")
+	}
+}
+
+func TestInterval_Text(t *testing.T) {
+	var expected = MustIntervalOf(MustInstantParse("2024-03-15T10:00:00Z"), MustInstantParse("2024-03-15T12:00:00Z"))
+	assert.Equal(t, "2024-03-15T10:00:00Z/2024-03-15T12:00:00Z", expected.String())
+	for _, s := range []string{
+		"2024-03-15T10:00:00Z/2024-03-15T12:00:00Z",
+		"2024-03-15T18:00:00+08:00/2024-03-15T12:00:00Z",
+		"2024-03-15T10:00:00Z/PT2H",
+		"2024-03-15T10:00:00Z/PT120M",
+		"PT2H/2024-03-15T12:00:00Z",
+	} {
+		i, e := IntervalParse(s)
+		if assert.NoError(t, e, s) {
+			assert.Equal(t, expected, i, s)
+		}
+	}
+	for _, s := range []string{
+		"2024-03-15T10:00:00Z",
+		"2024-03-15T12:00:00Z/2024-03-15T10:00:00Z",
+		"2024-03-15T10:00:00Z/-PT2H",
+		"PT2H/PT2H",
+		"2024-03-15T10:00:00/2024-03-15T12:00:00",
+		"/",
+	} {
+		_, e := IntervalParse(s)
+		assert.Error(t, e, s)
+	}
+
+	for _, s := range []string{"../2024-03-15T12:00:00Z", "2024-03-15T10:00:00Z/..", "../.."} {
+		i := MustIntervalParse(s)
+		assert.Equal(t, s, i.String())
+		assert.Equal(t, i, MustIntervalParse(i.String()))
+	}
+	assert.Equal(t, IntervalAll(), MustIntervalParse("../.."))
+
+	i, e := IntervalParse("")
+	assert.NoError(t, e)
+	assert.True(t, i.IsZero())
+	assert.Equal(t, "", Interval{}.String())
+
+	var s struct {
+		Interval Interval `json:"interval"`
+	}
+	s.Interval = expected
+	data, e := json.Marshal(s)
+	require.NoError(t, e)
+	assert.JSONEq(t, `{"interval":"2024-03-15T10:00:00Z/2024-03-15T12:00:00Z"}`, string(data))
+	s.Interval = Interval{}
+	require.NoError(t, json.Unmarshal(data, &s))
+	assert.Equal(t, expected, s.Interval)
+	require.NoError(t, json.Unmarshal([]byte(`{"interval":null}`), &s))
+	assert.True(t, s.Interval.IsZero())
+}
+
+func TestInterval_SQL(t *testing.T) {
+	var expected = MustIntervalParse("2024-03-15T10:00:00Z/2024-03-15T12:00:00Z")
+	v, e := expected.Value()
+	require.NoError(t, e)
+	assert.Equal(t, `["2024-03-15T10:00:00Z","2024-03-15T12:00:00Z")`, v)
+	for _, it := range []struct {
+		interval Interval
+		value    string
+	}{
+		{MustIntervalParse("../2024-03-15T12:00:00Z"), `(,"2024-03-15T12:00:00Z")`},
+		{MustIntervalParse("2024-03-15T10:00:00Z/.."), `["2024-03-15T10:00:00Z",)`},
+		{IntervalAll(), `(,)`},
+		{MustIntervalParse("2024-03-15T10:00:00Z/PT0S"), `empty`},
+	} {
+		v, e = it.interval.Value()
+		require.NoError(t, e)
+		assert.Equal(t, it.value, v)
+	}
+	v, e = Interval{}.Value()
+	require.NoError(t, e)
+	assert.Nil(t, v)
+
+	var i Interval
+	for _, it := range []struct {
+		value    string
+		expected Interval
+	}{
+		{`["2024-03-15 10:00:00+00","2024-03-15 12:00:00+00")`, expected},
+		{`["2024-03-15 18:00:00+08","2024-03-15 20:00:00+08")`, expected},
+		{`["2024-03-15T10:00:00Z","2024-03-15T12:00:00Z")`, expected},
+		{`("2024-03-15 09:59:59.999999+00","2024-03-15 11:59:59.999999+00"]`, expected},
+		{`(,"2024-03-15 12:00:00+00")`, MustIntervalParse("../2024-03-15T12:00:00Z")},
+		{`["2024-03-15 10:00:00+00",)`, MustIntervalParse("2024-03-15T10:00:00Z/..")},
+		{`[-infinity,infinity)`, IntervalAll()},
+		{`(,)`, IntervalAll()},
+		{`empty`, MustIntervalOf(InstantEpoch(), InstantEpoch())},
+		{`2024-03-15T10:00:00Z/PT2H`, expected},
+	} {
+		if assert.NoError(t, i.Scan(it.value), it.value) {
+			assert.Equal(t, it.expected, i, it.value)
+		}
+		if assert.NoError(t, i.Scan([]byte(it.value)), it.value) {
+			assert.Equal(t, it.expected, i, it.value)
+		}
+	}
+	require.NoError(t, i.Scan(nil))
+	assert.True(t, i.IsZero())
+	assert.Error(t, i.Scan(`["2024-03-15 12:00:00+00","2024-03-15 10:00:00+00")`))
+	assert.Error(t, i.Scan(`["2024-03-15 12:00:00+00"`))
+	assert.Error(t, i.Scan(`[2024-03-15 12:00:00,)`))
+	assert.Error(t, i.Scan(1))
+}
+
+func TestInterval_ValuePostgres(t *testing.T) {
+	var pg = GetPG(t)
+
+	for _, s := range []string{
+		"2024-03-15T10:00:00.123456Z/2024-03-15T12:00:00Z",
+		"../2024-03-15T12:00:00Z",
+		"2024-03-15T10:00:00Z/..",
+		"../..",
+	} {
+		t.Run(s, func(t *testing.T) {
+			expected := MustIntervalParse(s)
+			var actual Interval
+			err := pg.QueryRow("SELECT $1::tstzrange", expected).Scan(&actual)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, actual)
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		var actual Interval
+		var expectedTrue bool
+		err := pg.QueryRow("SELECT 'empty'::tstzrange, isempty($1::tstzrange)", MustIntervalParse("2024-03-15T10:00:00Z/PT0S")).Scan(&actual, &expectedTrue)
+		assert.NoError(t, err)
+		assert.True(t, actual.IsEmpty())
+		assert.True(t, expectedTrue)
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		var expectedTrue bool
+		err := pg.QueryRow("SELECT $1::tstzrange @> '2024-03-15 11:00:00+00'::timestamptz", MustIntervalParse("2024-03-15T10:00:00Z/PT2H")).Scan(&expectedTrue)
+		assert.NoError(t, err)
+		assert.True(t, expectedTrue)
+	})
+
+	t.Run("null_value", func(t *testing.T) {
+		var actual Interval
+		var expectedTrue bool
+		var e = pg.QueryRow("SELECT NULL::tstzrange, $1::tstzrange is null", actual).Scan(&actual, &expectedTrue)
+		assert.NoError(t, e)
+		assert.True(t, actual.IsZero())
+		assert.True(t, expectedTrue)
+	})
+}
@@ -0,0 +1,204 @@
+package goda
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON strings in ISO 8601 interval format or JSON null.
+func (i *Interval) UnmarshalJSON(bytes []byte) error {
+	if len(bytes) == 4 && string(bytes) == "null" {
+		*i = Interval{}
+		return nil
+	}
+	return unmarshalJsonImpl(i, bytes)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It returns the interval as a JSON string in ISO 8601 start/end format, or empty string for zero value.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(i)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the interval in ISO 8601 start/end format, or empty for zero value.
+func (i Interval) MarshalText() (text []byte, err error) {
+	return marshalTextImpl(i)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses intervals in ISO 8601 start/end, start/duration or duration/end format,
+// the start and the end may have any offset, and ".." means an unbounded end as in ISO 8601-2.
+// Empty input is treated as zero value.
+func (i *Interval) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*i = Interval{}
+		return nil
+	}
+	var slash = bytes.IndexByte(text, '/')
+	if slash < 0 {
+		return errors.New("start/end required")
+	}
+	var first, second = text[:slash], text[slash+1:]
+	var start, end Instant
+	var duration Duration
+	switch {
+	case isDurationText(first):
+		if e = duration.UnmarshalText(first); e != nil {
+			return
+		}
+		if e = end.UnmarshalText(second); e != nil {
+			return
+		}
+		if start, e = end.Chain().MinusSeconds(duration.Seconds()).MinusNanos(int64(duration.Nano())).GetResult(); e != nil {
+			return
+		}
+	case isDurationText(second):
+		if e = start.UnmarshalText(first); e != nil {
+			return
+		}
+		if e = duration.UnmarshalText(second); e != nil {
+			return
+		}
+		*i, e = IntervalOfDuration(start, duration)
+		return
+	default:
+		if start, e = parseIntervalBound(first, InstantMin()); e != nil {
+			return
+		}
+		if end, e = parseIntervalBound(second, InstantMax()); e != nil {
+			return
+		}
+	}
+	*i, e = IntervalOf(start, end)
+	return
+}
+
+func isDurationText(b []byte) bool {
+	if len(b) > 0 && (b[0] == '-' || b[0] == '+') {
+		b = b[1:]
+	}
+	return len(b) > 0 && (b[0] == 'P' || b[0] == 'p')
+}
+
+func parseIntervalBound(b []byte, unbounded Instant) (i Instant, e error) {
+	if string(b) == ".." {
+		return unbounded, nil
+	}
+	e = i.UnmarshalText(b)
+	return
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the interval in ISO 8601 start/end format to b, or nothing for zero value.
+// An unbounded end is formatted as "..".
+func (i Interval) AppendText(b []byte) ([]byte, error) {
+	if i.IsZero() {
+		return b, nil
+	}
+	if i.IsUnboundedStart() {
+		b = append(b, '.', '.')
+	} else {
+		b, _ = i.start.AppendText(b)
+	}
+	b = append(b, '/')
+	if i.IsUnboundedEnd() {
+		return append(b, '.', '.'), nil
+	}
+	return i.end.AppendText(b)
+}
+
+// String returns the interval in ISO 8601 start/end format, or empty string for zero value.
+func (i Interval) String() string {
+	return stringImpl(i)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil, in PostgreSQL tstzrange format such as
+// ["2024-03-15 10:00:00+00","2024-03-15 12:00:00+00") or in ISO 8601 interval format.
+// Missing and infinite bounds are unbounded, an exclusive start or an inclusive end is moved by a microsecond,
+// the resolution of PostgreSQL, to make the interval half-open.
+// An "empty" range is scanned as an empty interval at the Unix epoch.
+func (i *Interval) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*i = Interval{}
+		return nil
+	case string:
+		return i.scanText([]byte(v))
+	case []byte:
+		return i.scanText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+func (i *Interval) scanText(text []byte) (e error) {
+	if len(text) == 0 || text[0] != '[' && text[0] != '(' && string(text) != "empty" {
+		return i.UnmarshalText(text)
+	}
+	defer deferOpInParse(text, &e)
+	if string(text) == "empty" {
+		*i = Interval{start: InstantEpoch(), end: InstantEpoch()}
+		return nil
+	}
+	var comma = bytes.IndexByte(text, ',')
+	var last = len(text) - 1
+	if comma < 0 || text[last] != ')' && text[last] != ']' {
+		return errors.New("range of timestamps required")
+	}
+	start, e := parseRangeBound(unquoteRangeBound(text[1:comma]), InstantMin(), text[0] == '(')
+	if e != nil {
+		return
+	}
+	end, e := parseRangeBound(unquoteRangeBound(text[comma+1:last]), InstantMax(), text[last] == ']')
+	if e != nil {
+		return
+	}
+	*i, e = IntervalOf(start, end)
+	return
+}
+
+func parseRangeBound(b []byte, unbounded Instant, plusMicro bool) (i Instant, e error) {
+	switch string(b) {
+	case "", "infinity", "-infinity":
+		return unbounded, nil
+	}
+	if e = i.UnmarshalText(b); e != nil {
+		return
+	}
+	if plusMicro {
+		return i.Chain().PlusNanos(1000).GetResult()
+	}
+	return
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the interval in PostgreSQL tstzrange format such as ["2024-03-15T10:00:00Z","2024-03-15T12:00:00Z"),
+// with unbounded ends left empty, "empty" for an empty interval, or nil for zero value.
+func (i Interval) Value() (driver.Value, error) {
+	if i.IsZero() {
+		return nil, nil
+	}
+	if i.IsEmpty() {
+		return "empty", nil
+	}
+	var b = make([]byte, 0, 64)
+	if i.IsUnboundedStart() {
+		b = append(b, '(')
+	} else {
+		b = append(b, '[', '"')
+		b, _ = i.start.AppendText(b)
+		b = append(b, '"')
+	}
+	b = append(b, ',')
+	if !i.IsUnboundedEnd() {
+		b = append(b, '"')
+		b, _ = i.end.AppendText(b)
+		b = append(b, '"')
+	}
+	return string(append(b, ')')), nil
+}
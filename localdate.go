@@ -65,6 +65,26 @@ func (d LocalDate) DayOfYear() int {
 	return d.Month().FirstDayOfYear(d.IsLeapYear()) - 1 + d.DayOfMonth()
 }
 
+// weekBasedYear returns the ISO-8601 week-based-year and week-of-week-based-year of this date,
+// which are those of the Thursday of the week.
+func (d LocalDate) weekBasedYear() (year Year, week int) {
+	year = d.Year()
+	var dayOfYear = d.DayOfYear() + int(Thursday-d.DayOfWeek())
+	if dayOfYear < 1 {
+		year--
+		dayOfYear += year.Length()
+	} else if dayOfYear > year.Length() {
+		dayOfYear -= year.Length()
+		year++
+	}
+	return year, (dayOfYear-1)/7 + 1
+}
+
+// dayOfQuarter returns the day-of-quarter of this date (1-92).
+func (d LocalDate) dayOfQuarter() int {
+	return d.DayOfYear() - d.Month().Quarter().FirstMonth().FirstDayOfYear(d.IsLeapYear()) + 1
+}
+
 // IsLeapYear returns true if the year of this date is a leap year.
 // A leap year is divisible by 4, unless it's divisible by 100 (but not 400).
 func (d LocalDate) IsLeapYear() bool {
@@ -151,10 +171,12 @@ func (d LocalDate) UnixEpochDays() int64 {
 // IsSupportedField returns true if the field is supported by LocalDate.
 func (d LocalDate) IsSupportedField(field Field) bool {
 	switch field {
-	case FieldDayOfWeek, FieldDayOfMonth, FieldDayOfYear, FieldEpochDay, FieldMonthOfYear, FieldProlepticMonth, FieldYearOfEra, FieldYear, FieldEra:
+	case FieldDayOfWeek, FieldDayOfMonth, FieldDayOfYear, FieldEpochDay, FieldMonthOfYear, FieldProlepticMonth, FieldYearOfEra, FieldYear, FieldEra,
+		FieldQuarterOfYear, FieldDayOfQuarter, FieldWeekOfWeekBasedYear, FieldWeekBasedYear:
 		return true
 	default:
-		return false
+		_, _, ok := field.weekFields()
+		return ok
 	}
 }
 
@@ -175,6 +197,11 @@ func (d LocalDate) IsSupportedField(field Field) bool {
 //   - FieldEra: returns the era (0=BCE, 1=CE)
 //   - FieldEpochDay: returns the number of days since Unix epoch (1970-01-01)
 //   - FieldProlepticMonth: returns the number of months since year 0
+//   - FieldQuarterOfYear: returns the quarter (1-4)
+//   - FieldDayOfQuarter: returns the day of quarter (1-92)
+//   - FieldWeekOfWeekBasedYear: returns the ISO-8601 week (1-53)
+//   - FieldWeekBasedYear: returns the ISO-8601 week-based-year, 2024-12-30 is in the week 1 of 2025
+//   - Fields of WeekFields: returns the localized day-of-week, week-of-month, week-of-year, week-of-week-based-year or week-based-year
 //
 // Overflow Analysis:
 // None of the supported fields can overflow int64 in practice:
@@ -191,6 +218,9 @@ func (d LocalDate) IsSupportedField(field Field) bool {
 //     However, LocalDate stores Year in the upper 48 bits of a 64-bit value,
 //     limiting the practical range to approximately ±140 trillion years, making overflow impossible
 //     in any realistic scenario.
+//   - FieldQuarterOfYear/FieldDayOfQuarter/FieldWeekOfWeekBasedYear: small ranges, cannot overflow
+//   - FieldWeekBasedYear: Year ± 1, cannot overflow
+//   - Fields of WeekFields: small ranges or Year ± 1, cannot overflow
 func (d LocalDate) GetField(field Field) TemporalValue {
 	if d.IsZero() {
 		return TemporalValue{v: 0, unsupported: true}
@@ -232,8 +262,22 @@ func (d LocalDate) GetField(field Field) TemporalValue {
 		// Year is stored in 48 bits internally, limiting range to ±140 trillion years
 		// Year * 12 cannot overflow int64 in this constrained range
 		v = int64(d.Year())*12 + int64(d.Month()) - 1
+	case FieldQuarterOfYear:
+		v = int64(d.Month().Quarter())
+	case FieldDayOfQuarter:
+		v = int64(d.dayOfQuarter())
+	case FieldWeekOfWeekBasedYear:
+		_, week := d.weekBasedYear()
+		v = int64(week)
+	case FieldWeekBasedYear:
+		year, _ := d.weekBasedYear()
+		v = year.Int64()
 	default:
-		return TemporalValue{unsupported: true}
+		wf, kind, ok := field.weekFields()
+		if !ok {
+			return TemporalValue{unsupported: true}
+		}
+		v = wf.getFrom(d, kind)
 	}
 	return TemporalValue{v: v}
 }
@@ -246,6 +290,60 @@ func (d LocalDate) AtTime(time LocalTime) LocalDateTime {
 	}
 }
 
+// Until returns the period between this date and another date as years, months and days.
+// The start date is included, but the end date is not.
+//
+// The result is calculated as in Java's LocalDate#until(ChronoLocalDate): complete months are removed first,
+// then the remaining number of days, so 2024-01-31 until 2024-03-01 is P1M1D.
+// The years, months and days have the same sign, the result is negative if the end is before the start.
+//
+// Returns zero value if either date is zero.
+func (d LocalDate) Until(endExclusive LocalDate) Period {
+	if d.IsZero() || endExclusive.IsZero() {
+		return Period{}
+	}
+	totalMonths := endExclusive.prolepticMonth() - d.prolepticMonth()
+	days := int64(endExclusive.DayOfMonth() - d.DayOfMonth())
+	if totalMonths > 0 && days < 0 {
+		totalMonths--
+		calcDate := d.Chain().PlusMonths(totalMonths).MustGet()
+		days = endExclusive.UnixEpochDays() - calcDate.UnixEpochDays()
+	} else if totalMonths < 0 && days > 0 {
+		totalMonths++
+		days -= int64(endExclusive.LengthOfMonth())
+	}
+	return PeriodOf(totalMonths/12, totalMonths%12, days)
+}
+
+// UntilUnit returns the amount of time until another date in terms of the unit, such as the number of complete months.
+// The start date is included, but the end date is not, the result is negative if the end is before the start.
+//
+// The units from UnitDays to UnitEras are supported, otherwise an error wrapping ErrUnsupported is returned.
+// As in Java, 2024-01-31 until 2024-02-29 is 0 months, and UnitEras counts the change of era.
+// Returns zero if either date is zero.
+func (d LocalDate) UntilUnit(endExclusive LocalDate, unit Unit) (int64, error) {
+	if d.IsZero() || endExclusive.IsZero() {
+		return 0, nil
+	}
+	switch unit {
+	case UnitDays:
+		return endExclusive.UnixEpochDays() - d.UnixEpochDays(), nil
+	case UnitWeeks:
+		return (endExclusive.UnixEpochDays() - d.UnixEpochDays()) / 7, nil
+	case UnitMonths, UnitYears, UnitDecades, UnitCenturies, UnitMillennia:
+		var packed1 = d.prolepticMonth()*32 + int64(d.DayOfMonth())
+		var packed2 = endExclusive.prolepticMonth()*32 + int64(endExclusive.DayOfMonth())
+		return (packed2 - packed1) / 32 / unit.months(), nil
+	case UnitEras:
+		return endExclusive.GetField(FieldEra).Int64() - d.GetField(FieldEra).Int64(), nil
+	}
+	return 0, unsupportedUnit(unit)
+}
+
+func (d LocalDate) prolepticMonth() int64 {
+	return int64(d.Year())*12 + int64(d.Month()) - 1
+}
+
 // LengthOfMonth returns the number of days in the month of this date.
 // Returns 28, 29, 30, or 31 depending on the month and whether it's a leap year.
 // Returns 0 for zero value.
@@ -350,6 +448,12 @@ func LocalDateNowUTC() LocalDate {
 	return LocalDateOfGoTime(time.Now().UTC())
 }
 
+// LocalDateNowClock returns the current date of the clock in the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func LocalDateNowClock(clock Clock) LocalDate {
+	return zonedDateTimeNowClock(clock).LocalDate()
+}
+
 // LocalDateParse parses a date string in yyyy-MM-dd format.
 // Returns an error if the string is invalid or represents an invalid date.
 //
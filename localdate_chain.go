@@ -108,6 +108,114 @@ func (l LocalDateChain) MinusWeeks(weeks int64) LocalDateChain {
 	return l.PlusWeeks(-weeks)
 }
 
+// Plus returns a copy of this date with the specified amount added.
+// For a Period, the total months (years × 12 + months) are added first,
+// clamping the day-of-month to the end of the month as PlusMonths does, then the days are added.
+// A Duration is only supported if it has zero length.
+func (l LocalDateChain) Plus(amount TemporalAmount) LocalDateChain {
+	defer l.leaveFunction(tyLocalDate, fnPlus)
+	if !l.ok() {
+		return l
+	}
+	switch a := amount.(type) {
+	case Period:
+		totalMonths, overflow := a.totalMonths()
+		if overflow {
+			l.eError = overflowError()
+			return l
+		}
+		return l.PlusMonths(totalMonths).PlusDays(a.days)
+	case Duration:
+		if a.seconds|int64(a.nanos) != 0 {
+			l.eError = unsupportedAmount(a)
+		}
+	}
+	return l
+}
+
+// Minus returns a copy of this date with the specified amount subtracted.
+// For a Period, the total months (years × 12 + months) are subtracted first,
+// clamping the day-of-month to the end of the month as MinusMonths does, then the days are subtracted.
+// A Duration is only supported if it has zero length.
+func (l LocalDateChain) Minus(amount TemporalAmount) LocalDateChain {
+	defer l.leaveFunction(tyLocalDate, fnMinus)
+	if !l.ok() {
+		return l
+	}
+	switch a := amount.(type) {
+	case Period:
+		totalMonths, overflow := a.totalMonths()
+		if overflow {
+			l.eError = overflowError()
+			return l
+		}
+		return l.MinusMonths(totalMonths).MinusDays(a.days)
+	case Duration:
+		if a.seconds|int64(a.nanos) != 0 {
+			l.eError = unsupportedAmount(a)
+		}
+	}
+	return l
+}
+
+// PlusUnit returns a copy of this date with the amount of the unit added, such as 3 UnitMonths.
+// The units from UnitDays to UnitEras are supported, otherwise an error wrapping ErrUnsupported is returned.
+// Month-based units clamp the day-of-month as PlusMonths does, UnitEras changes the era keeping the year-of-era.
+func (l LocalDateChain) PlusUnit(amount int64, unit Unit) LocalDateChain {
+	defer l.leaveFunction(tyLocalDate, fnPlusUnit)
+	if !l.ok() {
+		return l
+	}
+	switch unit {
+	case UnitDays:
+		return l.PlusDays(amount)
+	case UnitWeeks:
+		return l.PlusWeeks(amount)
+	case UnitMonths:
+		return l.PlusMonths(amount)
+	case UnitYears, UnitDecades, UnitCenturies, UnitMillennia:
+		years, overflow := mulExact(amount, unit.months()/12)
+		if overflow {
+			l.eError = overflowError()
+			return l
+		}
+		return l.PlusYears(years)
+	case UnitEras:
+		era, overflow := addExactly(l.value.GetField(FieldEra).Int64(), amount)
+		if overflow {
+			l.eError = overflowError()
+			return l
+		}
+		return l.WithField(FieldEra, TemporalValueOf(era))
+	}
+	l.eError = unsupportedUnit(unit)
+	return l
+}
+
+// MinusUnit returns a copy of this date with the amount of the unit subtracted, see PlusUnit.
+func (l LocalDateChain) MinusUnit(amount int64, unit Unit) LocalDateChain {
+	defer l.leaveFunction(tyLocalDate, fnMinusUnit)
+	if amount == math.MinInt64 {
+		return l.PlusUnit(math.MaxInt64, unit).PlusUnit(1, unit)
+	}
+	return l.PlusUnit(-amount, unit)
+}
+
+// With returns a copy of this date adjusted by the adjuster, such as LastDayOfMonth().
+// Returns an error if the adjuster is nil or fails.
+func (l LocalDateChain) With(adjuster TemporalAdjuster) LocalDateChain {
+	defer l.leaveFunction(tyLocalDate, fnWith)
+	if !l.ok() {
+		return l
+	}
+	if adjuster == nil {
+		l.eError = newError("adjuster is nil")
+		return l
+	}
+	l.value, l.eError = adjuster.AdjustDate(l.value)
+	return l
+}
+
 func (l LocalDateChain) WithDayOfMonth(dayOfMonth int) LocalDateChain {
 	defer l.leaveFunction(tyLocalDate, fnWithDayOfMonth)
 	if !l.ok() {
@@ -158,6 +266,14 @@ func (l LocalDateChain) WithYear(year Year) LocalDateChain {
 //   - FieldEra: switches between BCE/CE eras while preserving year, month, and day.
 //   - FieldEpochDay: sets the date based on days since Unix epoch (1970-01-01).
 //   - FieldProlepticMonth: sets the date based on months since year 0.
+//   - FieldQuarterOfYear: moves by whole quarters, keeping the month within the quarter and the day-of-month (adjusted if necessary).
+//   - FieldDayOfQuarter: sets the day-of-quarter within the quarter, which must not exceed the length of the quarter.
+//   - FieldWeekOfWeekBasedYear: moves by whole weeks, keeping the day-of-week.
+//     Week 53 is out of range for a week-based-year with 52 weeks.
+//   - FieldWeekBasedYear: sets the week-based-year keeping the week and the day-of-week, week 53 becomes week 52 if absent.
+//   - Fields of WeekFields: the localized day-of-week moves within the week, the weeks move by whole weeks keeping
+//     the localized day-of-week and must stay in the month, the year or the week-based-year, and the week-based-year
+//     keeps the week (clamped to the last week) and the day-of-week.
 //
 // Fields outside this list return an error. Range violations propagate the validation error.
 func (l LocalDateChain) WithField(field Field, value TemporalValue) LocalDateChain {
@@ -200,7 +316,31 @@ func (l LocalDateChain) WithField(field Field, value TemporalValue) LocalDateCha
 			return l
 		}
 		return l.WithYear(1 - l.value.Year())
+	case FieldQuarterOfYear:
+		return l.PlusMonths((newValue - l.value.GetField(FieldQuarterOfYear).Int64()) * 3)
+	case FieldDayOfQuarter:
+		if newValue > int64(l.value.YearQuarter().LengthOfQuarter()) {
+			l.eError = fieldOutOfRangeError(field, newValue)
+			return l
+		}
+		return l.WithDayOfYear(l.value.DayOfYear() + int(newValue) - l.value.dayOfQuarter())
+	case FieldWeekOfWeekBasedYear:
+		year, week := l.value.weekBasedYear()
+		if newValue > int64(year.weeksOfWeekBasedYear()) {
+			l.eError = fieldOutOfRangeError(field, newValue)
+			return l
+		}
+		return l.PlusWeeks(newValue - int64(week))
+	case FieldWeekBasedYear:
+		_, week := l.value.weekBasedYear()
+		var yw YearWeek
+		if yw, l.eError = YearWeekOf(Year(newValue), min(week, Year(newValue).weeksOfWeekBasedYear())); l.eError == nil {
+			l.value, l.eError = yw.atDay(l.value.DayOfWeek())
+		}
 	default:
+		if wf, kind, ok := field.weekFields(); ok {
+			return wf.adjustInto(l, kind, newValue)
+		}
 		l.eError = unsupportedField(field)
 	}
 	return l
@@ -1,6 +1,8 @@
 package goda
 
 import (
+	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"testing"
 	"time"
@@ -380,6 +382,56 @@ func TestLocalDate_Value(t *testing.T) {
 	assert.Nil(t, val)
 }
 
+func TestLocalDate_ScanInfinityAndEra(t *testing.T) {
+	for _, tt := range []struct {
+		src      any
+		expected LocalDate
+	}{
+		{"infinity", LocalDateMax()},
+		{[]byte("-infinity"), LocalDateMin()},
+		{"0044-03-15 BC", MustLocalDateOf(-43, March, 15)},
+		{"0001-02-29 BC", MustLocalDateOf(0, February, 29)},
+		{"2024-03-15", MustLocalDateOf(2024, March, 15)},
+	} {
+		var d LocalDate
+		require.NoError(t, d.Scan(tt.src), "%v", tt.src)
+		assert.Equal(t, tt.expected, d)
+	}
+	var d = MustLocalDateOf(2024, March, 15)
+	require.NoError(t, d.Scan("0000-00-00"))
+	assert.True(t, d.IsZero())
+	assert.Error(t, d.Scan("0000-00-01"))
+	assert.Error(t, d.Scan("0000-03-15 BC"))
+	assert.Error(t, d.Scan("-0044-03-15 BC"))
+	assert.Error(t, d.Scan("Infinity"))
+}
+
+func TestLocalDate_ValueInfinityAndEra(t *testing.T) {
+	var check = func(v driver.Valuer, expected string) {
+		t.Helper()
+		val, err := v.Value()
+		require.NoError(t, err)
+		assert.Equal(t, expected, val)
+	}
+	check(LocalDateMax(), LocalDateMax().String())
+	check(MustLocalDateOf(-43, March, 15), "-0043-03-15")
+
+	check(PostgresValueOf(LocalDateMax()), "infinity")
+	check(PostgresValueOf(LocalDateMin()), "-infinity")
+	check(PostgresValueOf(MustLocalDateOf(-43, March, 15)), "0044-03-15 BC")
+	check(PostgresValueOf(MustLocalDateOf(0, February, 29)), "0001-02-29 BC")
+	check(PostgresValueOf(MustLocalDateOf(2024, March, 15)), "2024-03-15")
+	val, err := PostgresValue[LocalDate]{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, val)
+
+	var p PostgresValue[LocalDate]
+	require.NoError(t, p.Scan("0044-03-15 BC"))
+	assert.Equal(t, MustLocalDateOf(-43, March, 15), p.V)
+	require.NoError(t, p.Scan(nil))
+	assert.True(t, p.V.IsZero())
+}
+
 func TestLocalDate_AppendText(t *testing.T) {
 	d := MustLocalDateOf(2024, March, 15)
 	buf := []byte("LocalDate: ")
@@ -524,6 +576,24 @@ func TestLocalDate_ValuePostgres(t *testing.T) {
 		assert.True(t, actual.IsZero())
 		assert.True(t, expectedTrue)
 	})
+	t.Run("infinity and BC", func(t *testing.T) {
+		for _, it := range []struct {
+			value LocalDate
+			text  string
+		}{
+			{LocalDateMax(), "infinity"},
+			{LocalDateMin(), "-infinity"},
+			{MustLocalDateOf(-43, March, 15), "0044-03-15 BC"},
+		} {
+			var actual, actualText LocalDate
+			var expectedTrue bool
+			var e = pg.QueryRow("SELECT $1::date, $1::date::text, $1::date = $2::date", PostgresValueOf(it.value), it.text).Scan(&actual, &actualText, &expectedTrue)
+			assert.NoError(t, e)
+			assert.Equal(t, it.value, actual)
+			assert.Equal(t, it.value, actualText)
+			assert.True(t, expectedTrue)
+		}
+	})
 }
 
 func TestLocalDate_ValueMySQL(t *testing.T) {
@@ -545,6 +615,19 @@ func TestLocalDate_ValueMySQL(t *testing.T) {
 		assert.True(t, actual.IsZero())
 		assert.True(t, expectedTrue)
 	})
+	t.Run("zero_date", func(t *testing.T) {
+		for _, params := range []string{"", "parseTime=true"} {
+			conn, e := GetMySQLWithParams(t, params).Conn(context.Background())
+			require.NoError(t, e)
+			_, e = conn.ExecContext(context.Background(), "SET SESSION sql_mode = ''")
+			require.NoError(t, e)
+			var actual = MustLocalDateOf(2000, December, 29)
+			e = conn.QueryRowContext(context.Background(), "SELECT CAST('0000-00-00' AS DATE)").Scan(&actual)
+			assert.NoError(t, e, params)
+			assert.True(t, actual.IsZero(), params)
+			_ = conn.Close()
+		}
+	})
 }
 
 func TestLocalDate_LengthOfMonth(t *testing.T) {
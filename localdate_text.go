@@ -80,6 +80,7 @@ func (d LocalDate) AppendText(b []byte) ([]byte, error) {
 
 // Value implements the driver.Valuer interface.
 // It returns nil for zero values, otherwise returns the date as a string in yyyy-MM-dd format.
+// Wrap it with PostgresValueOf for the PostgreSQL infinity and BC forms.
 func (d LocalDate) Value() (driver.Value, error) {
 	if d.IsZero() {
 		return nil, nil
@@ -90,15 +91,18 @@ func (d LocalDate) Value() (driver.Value, error) {
 // Scan implements the sql.Scanner interface.
 // It supports scanning from nil, string, []byte, and time.Time.
 // Nil values are converted to the zero value of LocalDate.
+// The PostgreSQL "infinity" and "-infinity" are converted to LocalDateMax and LocalDateMin,
+// and dates with the BC era such as "0044-03-15 BC" to non-positive years.
+// The MySQL zero date "0000-00-00" is converted to the zero value.
 func (d *LocalDate) Scan(src any) error {
 	switch v := src.(type) {
 	case nil:
 		*d = LocalDate{}
 		return nil
 	case string:
-		return d.UnmarshalText([]byte(v))
+		return d.scanText([]byte(v))
 	case []byte:
-		return d.UnmarshalText(v)
+		return d.scanText(v)
 	case time.Time:
 		*d = LocalDateOfGoTime(v)
 		return nil
@@ -106,3 +110,24 @@ func (d *LocalDate) Scan(src any) error {
 		return sqlScannerDefaultBranch(v)
 	}
 }
+
+func (d *LocalDate) scanText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if isMySQLZeroDate(text) {
+		*d = LocalDate{}
+		return nil
+	}
+	switch postgresInfinity(text) {
+	case 1:
+		*d = LocalDateMax()
+		return nil
+	case -1:
+		*d = LocalDateMin()
+		return nil
+	}
+	iso, e := postgresEraToISO(text)
+	if e != nil {
+		return
+	}
+	return d.UnmarshalText(iso)
+}
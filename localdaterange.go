@@ -0,0 +1,269 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// LocalDateRange represents a range of dates, such as [2024-01-01,2024-02-01).
+// The range is half-open, it includes the start and excludes the end, LocalDateRangeOfClosed creates it from
+// an inclusive end. A range whose start equals its end is empty.
+// It's suitable for iterating over the days of a backfill or splitting a period by month.
+//
+// LocalDateRange is comparable and can be used as a map key.
+// The zero value represents an unset range and IsZero returns true for it.
+//
+// LocalDateRange implements sql.Scanner and driver.Valuer for database operations, such as PostgreSQL daterange,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: [yyyy-MM-dd,yyyy-MM-dd) (e.g., "[2024-01-01,2024-02-01)"), the canonical form of PostgreSQL daterange.
+//
+// This is similar to LocalDateRange of ThreeTen-Extra.
+type LocalDateRange struct {
+	start LocalDate
+	end   LocalDate
+}
+
+// Start returns the first date of the range, inclusive.
+func (r LocalDateRange) Start() LocalDate {
+	return r.start
+}
+
+// End returns the end of the range, exclusive.
+func (r LocalDateRange) End() LocalDate {
+	return r.end
+}
+
+// EndInclusive returns the last date of the range.
+// Returns the day before the start for an empty range, and zero value for zero value.
+func (r LocalDateRange) EndInclusive() LocalDate {
+	if r.IsZero() {
+		return LocalDate{}
+	}
+	d, _ := r.end.Chain().MinusDays(1).GetResult()
+	return d
+}
+
+// IsZero returns true if this is the zero value.
+func (r LocalDateRange) IsZero() bool {
+	return r.start.IsZero()
+}
+
+// IsEmpty returns true if the range contains no dates, or it's the zero value.
+func (r LocalDateRange) IsEmpty() bool {
+	return r.start == r.end
+}
+
+// LengthInDays returns the number of days in the range, 0 for an empty range or zero value.
+func (r LocalDateRange) LengthInDays() int64 {
+	return r.end.UnixEpochDays() - r.start.UnixEpochDays()
+}
+
+// Contains returns true if the date is in the range.
+func (r LocalDateRange) Contains(date LocalDate) bool {
+	return !r.IsZero() && !date.IsZero() && !date.IsBefore(r.start) && date.IsBefore(r.end)
+}
+
+// Encloses returns true if all the dates of other are in this range.
+// An empty range is enclosed if it's within or at the bounds of this range.
+func (r LocalDateRange) Encloses(other LocalDateRange) bool {
+	return !r.IsZero() && !other.IsZero() && !other.start.IsBefore(r.start) && !other.end.IsAfter(r.end)
+}
+
+// Overlaps returns true if the ranges share at least one date.
+// An empty range overlaps no range.
+func (r LocalDateRange) Overlaps(other LocalDateRange) bool {
+	return !r.IsEmpty() && !other.IsEmpty() && r.start.IsBefore(other.end) && other.start.IsBefore(r.end)
+}
+
+// IsConnected returns true if the ranges overlap or abut, such as [2024-01-01,2024-02-01) and [2024-02-01,2024-03-01).
+// Connected ranges have an Intersection and a Union.
+func (r LocalDateRange) IsConnected(other LocalDateRange) bool {
+	return !r.IsZero() && !other.IsZero() && !r.start.IsAfter(other.end) && !other.start.IsAfter(r.end)
+}
+
+// Intersection returns the dates in both ranges, which is empty if the ranges only abut.
+// Returns an error if the ranges are not connected, see IsConnected. Returns zero value if either is zero value.
+func (r LocalDateRange) Intersection(other LocalDateRange) (LocalDateRange, error) {
+	if r.IsZero() || other.IsZero() {
+		return LocalDateRange{}, nil
+	}
+	if !r.IsConnected(other) {
+		return LocalDateRange{}, newError("ranges %v and %v are not connected", r, other)
+	}
+	return LocalDateRange{start: maxLocalDate(r.start, other.start), end: minLocalDate(r.end, other.end)}, nil
+}
+
+// Union returns the dates in either range.
+// Returns an error if the ranges are not connected, as the union would have a gap, see Span for that case.
+// Returns zero value if either is zero value.
+func (r LocalDateRange) Union(other LocalDateRange) (LocalDateRange, error) {
+	if r.IsZero() || other.IsZero() {
+		return LocalDateRange{}, nil
+	}
+	if !r.IsConnected(other) {
+		return LocalDateRange{}, newError("ranges %v and %v are not connected", r, other)
+	}
+	return r.Span(other), nil
+}
+
+// Span returns the smallest range enclosing both ranges, including the gap between them if any.
+// Returns zero value if either is zero value.
+func (r LocalDateRange) Span(other LocalDateRange) LocalDateRange {
+	if r.IsZero() || other.IsZero() {
+		return LocalDateRange{}
+	}
+	return LocalDateRange{start: minLocalDate(r.start, other.start), end: maxLocalDate(r.end, other.end)}
+}
+
+// Days returns an iterator over the dates of the range in order.
+func (r LocalDateRange) Days() iter.Seq[LocalDate] {
+	return r.Step(1, UnitDays)
+}
+
+// Weeks returns an iterator over the dates of the range every 7 days from the start.
+func (r LocalDateRange) Weeks() iter.Seq[LocalDate] {
+	return r.Step(1, UnitWeeks)
+}
+
+// Months returns an iterator over the dates of the range every month from the start.
+// Each date is computed from the start, so the day-of-month is clamped at month ends without drifting,
+// 2024-01-31 is followed by 2024-02-29 and 2024-03-31.
+func (r LocalDateRange) Months() iter.Seq[LocalDate] {
+	return r.Step(1, UnitMonths)
+}
+
+// Step returns an iterator over the dates of the range every amount of the unit from the start,
+// such as every 2 UnitWeeks. The n-th date is the start plus n times the amount, see LocalDateChain.PlusUnit.
+// Yields nothing if the amount is not positive, and stops at the first date the unit can't be added,
+// such as for a time-based unit.
+func (r LocalDateRange) Step(amount int64, unit Unit) iter.Seq[LocalDate] {
+	return func(yield func(LocalDate) bool) {
+		if r.IsZero() || amount <= 0 {
+			return
+		}
+		for i := int64(0); ; i++ {
+			n, overflow := mulExact(i, amount)
+			if overflow {
+				return
+			}
+			d, e := r.start.Chain().PlusUnit(n, unit).GetResult()
+			if e != nil || !d.IsBefore(r.end) || !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// SplitByYearMonth returns an iterator over the months of the range in order,
+// with the part of the range in each month, such as [2024-01-15,2024-02-01) for January of [2024-01-15,2024-03-10).
+func (r LocalDateRange) SplitByYearMonth() iter.Seq2[YearMonth, LocalDateRange] {
+	return func(yield func(YearMonth, LocalDateRange) bool) {
+		for start := r.start; start.IsBefore(r.end); {
+			var ym = start.YearMonth()
+			next, e := LocalDateOf(ym.Year(), ym.Month(), ym.LengthOfMonth())
+			if e == nil {
+				next, e = next.Chain().PlusDays(1).GetResult()
+			}
+			if e != nil {
+				// the end of the last supported month
+				next = r.end
+			}
+			var end = minLocalDate(next, r.end)
+			if !yield(ym, LocalDateRange{start: start, end: end}) {
+				return
+			}
+			start = end
+		}
+	}
+}
+
+func minLocalDate(a, b LocalDate) LocalDate {
+	if a.IsAfter(b) {
+		return b
+	}
+	return a
+}
+
+func maxLocalDate(a, b LocalDate) LocalDate {
+	if a.IsBefore(b) {
+		return b
+	}
+	return a
+}
+
+// LocalDateRangeOf creates a range from the start, inclusive, to the end, exclusive.
+// Returns an error if either is zero, or the end is before the start.
+func LocalDateRangeOf(startInclusive, endExclusive LocalDate) (r LocalDateRange, e error) {
+	if startInclusive.IsZero() || endExclusive.IsZero() {
+		return r, newError("start and end of range must not be zero")
+	}
+	if endExclusive.IsBefore(startInclusive) {
+		return r, newError("end of range %v is before start %v", endExclusive, startInclusive)
+	}
+	return LocalDateRange{start: startInclusive, end: endExclusive}, nil
+}
+
+// MustLocalDateRangeOf creates a range from the start, inclusive, to the end, exclusive.
+// Panics if either is zero, or the end is before the start.
+func MustLocalDateRangeOf(startInclusive, endExclusive LocalDate) LocalDateRange {
+	return mustValue(LocalDateRangeOf(startInclusive, endExclusive))
+}
+
+// LocalDateRangeOfClosed creates a range from the start to the end, both inclusive.
+// The end may be the day before the start for an empty range.
+// Returns an error if either is zero, or the end is before that.
+func LocalDateRangeOfClosed(startInclusive, endInclusive LocalDate) (LocalDateRange, error) {
+	if endInclusive.IsZero() {
+		return LocalDateRange{}, newError("start and end of range must not be zero")
+	}
+	endExclusive, e := endInclusive.Chain().PlusDays(1).GetResult()
+	if e != nil {
+		return LocalDateRange{}, e
+	}
+	return LocalDateRangeOf(startInclusive, endExclusive)
+}
+
+// MustLocalDateRangeOfClosed creates a range from the start to the end, both inclusive.
+// Panics if either is zero, or the end is before the day before the start.
+func MustLocalDateRangeOfClosed(startInclusive, endInclusive LocalDate) LocalDateRange {
+	return mustValue(LocalDateRangeOfClosed(startInclusive, endInclusive))
+}
+
+// LocalDateRangeParse parses a range string such as "[2024-01-01,2024-02-01)".
+// Both inclusive '[' ']' and exclusive '(' ')' bounds are accepted and converted to the half-open form,
+// so "[2024-01-01,2024-01-31]" equals "[2024-01-01,2024-02-01)".
+// Returns an error if the string is invalid.
+func LocalDateRangeParse(s string) (LocalDateRange, error) {
+	var r LocalDateRange
+	err := r.UnmarshalText([]byte(s))
+	return r, err
+}
+
+// MustLocalDateRangeParse parses a range string such as "[2024-01-01,2024-02-01)".
+// Panics if the string is invalid.
+func MustLocalDateRangeParse(s string) LocalDateRange {
+	return mustValue(LocalDateRangeParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*LocalDateRange)(nil)
+	_ fmt.Stringer             = (*LocalDateRange)(nil)
+	_ encoding.TextMarshaler   = (*LocalDateRange)(nil)
+	_ encoding.TextUnmarshaler = (*LocalDateRange)(nil)
+	_ json.Marshaler           = (*LocalDateRange)(nil)
+	_ json.Unmarshaler         = (*LocalDateRange)(nil)
+	_ driver.Valuer            = (*LocalDateRange)(nil)
+	_ sql.Scanner              = (*LocalDateRange)(nil)
+)
+
+// Compile-time check that LocalDateRange is comparable
+func _assertLocalDateRangeIsComparable[T comparable](t T) {}
+
+var _ = _assertLocalDateRangeIsComparable[LocalDateRange]
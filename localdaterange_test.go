@@ -0,0 +1,321 @@
+package goda
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDateRangeOf(t *testing.T) {
+	var jan1, feb1 = MustLocalDateOf(2024, January, 1), MustLocalDateOf(2024, February, 1)
+	r, e := LocalDateRangeOf(jan1, feb1)
+	require.NoError(t, e)
+	assert.Equal(t, jan1, r.Start())
+	assert.Equal(t, feb1, r.End())
+	assert.Equal(t, MustLocalDateOf(2024, January, 31), r.EndInclusive())
+	assert.Equal(t, int64(31), r.LengthInDays())
+	assert.False(t, r.IsZero())
+	assert.False(t, r.IsEmpty())
+	assert.Equal(t, r, MustLocalDateRangeOfClosed(jan1, MustLocalDateOf(2024, January, 31)))
+
+	var empty = MustLocalDateRangeOf(jan1, jan1)
+	assert.True(t, empty.IsEmpty())
+	assert.Equal(t, int64(0), empty.LengthInDays())
+	assert.Equal(t, MustLocalDateOf(2023, December, 31), empty.EndInclusive())
+	assert.Equal(t, empty, MustLocalDateRangeOfClosed(jan1, MustLocalDateOf(2023, December, 31)))
+
+	_, e = LocalDateRangeOf(feb1, jan1)
+	assert.Error(t, e)
+	_, e = LocalDateRangeOf(LocalDate{}, jan1)
+	assert.Error(t, e)
+	_, e = LocalDateRangeOf(jan1, LocalDate{})
+	assert.Error(t, e)
+	_, e = LocalDateRangeOfClosed(jan1, MustLocalDateOf(2023, December, 30))
+	assert.Error(t, e)
+	_, e = LocalDateRangeOfClosed(jan1, LocalDate{})
+	assert.Error(t, e)
+	assert.Panics(t, func() { MustLocalDateRangeOf(feb1, jan1) })
+
+	var zero LocalDateRange
+	assert.True(t, zero.IsZero())
+	assert.True(t, zero.IsEmpty())
+	assert.True(t, zero.EndInclusive().IsZero())
+	assert.Equal(t, int64(0), zero.LengthInDays())
+}
+
+func TestLocalDateRange_SetOperations(t *testing.T) {
+	var jan = MustLocalDateRangeParse("[2024-01-01,2024-02-01)")
+	var feb = MustLocalDateRangeParse("[2024-02-01,2024-03-01)")
+	var mid = MustLocalDateRangeParse("[2024-01-15,2024-02-15)")
+	var mar = MustLocalDateRangeParse("[2024-03-01,2024-04-01)")
+
+	assert.True(t, jan.Contains(MustLocalDateOf(2024, January, 1)))
+	assert.True(t, jan.Contains(MustLocalDateOf(2024, January, 31)))
+	assert.False(t, jan.Contains(MustLocalDateOf(2024, February, 1)))
+	assert.False(t, jan.Contains(MustLocalDateOf(2023, December, 31)))
+	assert.False(t, jan.Contains(LocalDate{}))
+	assert.False(t, LocalDateRange{}.Contains(MustLocalDateOf(2024, January, 1)))
+
+	assert.True(t, jan.Overlaps(mid))
+	assert.True(t, mid.Overlaps(feb))
+	assert.False(t, jan.Overlaps(feb))
+	assert.False(t, jan.Overlaps(mar))
+	assert.False(t, jan.Overlaps(LocalDateRange{}))
+	assert.False(t, jan.Overlaps(MustLocalDateRangeParse("[2024-01-10,2024-01-10)")))
+
+	assert.True(t, jan.IsConnected(feb))
+	assert.False(t, jan.IsConnected(mar))
+	assert.True(t, jan.Encloses(MustLocalDateRangeParse("[2024-01-10,2024-01-20)")))
+	assert.True(t, jan.Encloses(jan))
+	assert.False(t, jan.Encloses(mid))
+
+	r, e := jan.Intersection(mid)
+	require.NoError(t, e)
+	assert.Equal(t, MustLocalDateRangeParse("[2024-01-15,2024-02-01)"), r)
+	r, e = jan.Intersection(feb)
+	require.NoError(t, e)
+	assert.True(t, r.IsEmpty())
+	assert.Equal(t, MustLocalDateOf(2024, February, 1), r.Start())
+	_, e = jan.Intersection(mar)
+	assert.Error(t, e)
+
+	r, e = jan.Union(feb)
+	require.NoError(t, e)
+	assert.Equal(t, MustLocalDateRangeParse("[2024-01-01,2024-03-01)"), r)
+	r, e = mid.Union(jan)
+	require.NoError(t, e)
+	assert.Equal(t, MustLocalDateRangeParse("[2024-01-01,2024-02-15)"), r)
+	_, e = jan.Union(mar)
+	assert.Error(t, e)
+
+	assert.Equal(t, MustLocalDateRangeParse("[2024-01-01,2024-04-01)"), jan.Span(mar))
+	assert.Equal(t, MustLocalDateRangeParse("[2024-01-01,2024-04-01)"), mar.Span(jan))
+	assert.True(t, jan.Span(LocalDateRange{}).IsZero())
+	r, e = jan.Union(LocalDateRange{})
+	assert.NoError(t, e)
+	assert.True(t, r.IsZero())
+}
+
+func TestLocalDateRange_Iterate(t *testing.T) {
+	var r = MustLocalDateRangeParse("[2024-01-30,2024-02-02)")
+	assert.Equal(t, []LocalDate{
+		MustLocalDateOf(2024, January, 30),
+		MustLocalDateOf(2024, January, 31),
+		MustLocalDateOf(2024, February, 1),
+	}, slices.Collect(r.Days()))
+
+	r = MustLocalDateRangeParse("[2024-01-31,2024-06-01)")
+	assert.Equal(t, []LocalDate{
+		MustLocalDateOf(2024, January, 31),
+		MustLocalDateOf(2024, February, 29),
+		MustLocalDateOf(2024, March, 31),
+		MustLocalDateOf(2024, April, 30),
+		MustLocalDateOf(2024, May, 31),
+	}, slices.Collect(r.Months()))
+	assert.Equal(t, []LocalDate{
+		MustLocalDateOf(2024, January, 31),
+		MustLocalDateOf(2024, February, 7),
+		MustLocalDateOf(2024, February, 14),
+	}, slices.Collect(MustLocalDateRangeParse("[2024-01-31,2024-02-15)").Weeks()))
+	assert.Equal(t, []LocalDate{
+		MustLocalDateOf(2024, January, 31),
+		MustLocalDateOf(2024, April, 30),
+	}, slices.Collect(r.Step(3, UnitMonths)))
+	assert.Len(t, slices.Collect(r.Step(2, UnitMonths)), 3)
+	assert.Len(t, slices.Collect(MustLocalDateRangeParse("[2024-01-01,2025-01-01)").Days()), 366)
+
+	assert.Empty(t, slices.Collect(r.Step(0, UnitDays)))
+	assert.Empty(t, slices.Collect(r.Step(-1, UnitDays)))
+	assert.Empty(t, slices.Collect(r.Step(1, UnitHours)))
+	assert.Empty(t, slices.Collect(MustLocalDateRangeParse("[2024-01-01,2024-01-01)").Days()))
+	assert.Empty(t, slices.Collect(LocalDateRange{}.Days()))
+
+	var n int
+	for range r.Days() {
+		if n++; n == 3 {
+			break
+		}
+	}
+	assert.Equal(t, 3, n)
+}
+
+func TestLocalDateRange_SplitByYearMonth(t *testing.T) {
+	var months []YearMonth
+	var parts []LocalDateRange
+	for ym, part := range MustLocalDateRangeParse("[2023-12-15,2024-03-10)").SplitByYearMonth() {
+		months = append(months, ym)
+		parts = append(parts, part)
+	}
+	assert.Equal(t, []YearMonth{
+		MustYearMonthOf(2023, December),
+		MustYearMonthOf(2024, January),
+		MustYearMonthOf(2024, February),
+		MustYearMonthOf(2024, March),
+	}, months)
+	assert.Equal(t, []LocalDateRange{
+		MustLocalDateRangeParse("[2023-12-15,2024-01-01)"),
+		MustLocalDateRangeParse("[2024-01-01,2024-02-01)"),
+		MustLocalDateRangeParse("[2024-02-01,2024-03-01)"),
+		MustLocalDateRangeParse("[2024-03-01,2024-03-10)"),
+	}, parts)
+
+	for ym, part := range MustLocalDateRangeParse("[2024-02-03,2024-02-05)").SplitByYearMonth() {
+		assert.Equal(t, MustYearMonthOf(2024, February), ym)
+		assert.Equal(t, int64(2), part.LengthInDays())
+	}
+	for range MustLocalDateRangeParse("[2024-02-03,2024-02-03)").SplitByYearMonth() {
+		assert.Fail(t, "empty range has no months")
+	}
+	for range MustLocalDateRangeParse("[2023-12-15,2024-03-10)").SplitByYearMonth() {
+		break
+	}
+}
+
+func TestLocalDateRange_Text(t *testing.T) {
+	var expected = MustLocalDateRangeOf(MustLocalDateOf(2024, January, 1), MustLocalDateOf(2024, February, 1))
+	assert.Equal(t, "[2024-01-01,2024-02-01)", expected.String())
+	for _, s := range []string{
+		"[2024-01-01,2024-02-01)",
+		"[2024-01-01,2024-01-31]",
+		"(2023-12-31,2024-02-01)",
+		"(2023-12-31,2024-01-31]",
+		`["2024-01-01","2024-02-01")`,
+	} {
+		r, e := LocalDateRangeParse(s)
+		if assert.NoError(t, e, s) {
+			assert.Equal(t, expected, r, s)
+		}
+	}
+	for _, s := range []string{
+		"empty",
+		"[2024-01-01,)",
+		"(,2024-01-01)",
+		"[2024-01-01;2024-02-01)",
+		"2024-01-01,2024-02-01",
+		"[2024-02-01,2024-01-01)",
+		"[2024-01-01,2024-02-30)",
+		"[,]",
+	} {
+		_, e := LocalDateRangeParse(s)
+		assert.Error(t, e, s)
+	}
+	r, e := LocalDateRangeParse("")
+	assert.NoError(t, e)
+	assert.True(t, r.IsZero())
+	assert.Equal(t, "", LocalDateRange{}.String())
+	assert.Panics(t, func() { MustLocalDateRangeParse("empty") })
+
+	var s struct {
+		Range LocalDateRange `json:"range"`
+	}
+	s.Range = expected
+	data, e := json.Marshal(s)
+	require.NoError(t, e)
+	assert.JSONEq(t, `{"range":"[2024-01-01,2024-02-01)"}`, string(data))
+	s.Range = LocalDateRange{}
+	require.NoError(t, json.Unmarshal(data, &s))
+	assert.Equal(t, expected, s.Range)
+	require.NoError(t, json.Unmarshal([]byte(`{"range":null}`), &s))
+	assert.True(t, s.Range.IsZero())
+
+	text, e := expected.MarshalText()
+	require.NoError(t, e)
+	assert.Equal(t, "[2024-01-01,2024-02-01)", string(text))
+
+	v, e := expected.Value()
+	require.NoError(t, e)
+	assert.Equal(t, "[2024-01-01,2024-02-01)", v)
+	v, e = LocalDateRange{}.Value()
+	require.NoError(t, e)
+	assert.Nil(t, v)
+
+	require.NoError(t, r.Scan([]byte("[2024-01-01,2024-02-01)")))
+	assert.Equal(t, expected, r)
+	require.NoError(t, r.Scan(nil))
+	assert.True(t, r.IsZero())
+	require.NoError(t, r.Scan("[2024-01-01,2024-02-01)"))
+	assert.Equal(t, expected, r)
+	assert.Error(t, r.Scan(1))
+}
+
+func TestLocalDateRange_Scan(t *testing.T) {
+	var jan1 = MustLocalDateOf(2024, January, 1)
+	for _, c := range []struct {
+		src      string
+		expected LocalDateRange
+	}{
+		{"[2024-01-01,2024-02-01)", MustLocalDateRangeOf(jan1, MustLocalDateOf(2024, February, 1))},
+		{"(2023-12-31,2024-01-31]", MustLocalDateRangeOf(jan1, MustLocalDateOf(2024, February, 1))},
+		{"[2024-01-01,)", MustLocalDateRangeOf(jan1, LocalDateMax())},
+		{"(,2024-01-01)", MustLocalDateRangeOf(LocalDateMin(), jan1)},
+		{"[2024-01-01,infinity)", MustLocalDateRangeOf(jan1, LocalDateMax())},
+		{"[-infinity,2024-01-01)", MustLocalDateRangeOf(LocalDateMin(), jan1)},
+		{`["-infinity","infinity")`, MustLocalDateRangeOf(LocalDateMin(), LocalDateMax())},
+		{"(,)", MustLocalDateRangeOf(LocalDateMin(), LocalDateMax())},
+	} {
+		var r LocalDateRange
+		if assert.NoError(t, r.Scan(c.src), c.src) {
+			assert.Equal(t, c.expected, r, c.src)
+		}
+	}
+
+	var r LocalDateRange
+	require.NoError(t, r.Scan([]byte("empty")))
+	assert.False(t, r.IsZero())
+	assert.True(t, r.IsEmpty())
+	assert.Equal(t, int64(0), r.LengthInDays())
+	assert.False(t, r.Contains(MustLocalDateOf(1970, January, 1)))
+
+	for _, s := range []string{
+		"[2024-01-01;2024-02-01)",
+		"[2024-02-01,2024-01-01)",
+		"[2024-01-01,2024-02-30)",
+		"[2024-01-01,2024-02-01",
+	} {
+		assert.Error(t, r.Scan(s), s)
+	}
+}
+
+func TestLocalDateRange_ValuePostgres(t *testing.T) {
+	var pg = GetPG(t)
+
+	t.Run("daterange", func(t *testing.T) {
+		expected := MustLocalDateRangeParse("[2024-01-01,2024-02-01)")
+		var actual LocalDateRange
+		var expectedTrue bool
+		err := pg.QueryRow("SELECT $1::daterange, $1::daterange = daterange('2024-01-01', '2024-01-31', '[]')", expected).Scan(&actual, &expectedTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+		assert.True(t, expectedTrue)
+	})
+
+	t.Run("null_value", func(t *testing.T) {
+		var actual LocalDateRange
+		var expectedTrue bool
+		var e = pg.QueryRow("SELECT NULL::daterange, $1::daterange is null", actual).Scan(&actual, &expectedTrue)
+		assert.NoError(t, e)
+		assert.True(t, actual.IsZero())
+		assert.True(t, expectedTrue)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var d = MustLocalDateOf(2024, January, 1)
+		var actual LocalDateRange
+		var e = pg.QueryRow("SELECT $1::daterange", MustLocalDateRangeOf(d, d)).Scan(&actual)
+		assert.NoError(t, e)
+		assert.True(t, actual.IsEmpty())
+	})
+
+	t.Run("unbounded", func(t *testing.T) {
+		var d = MustLocalDateOf(2024, January, 1)
+		var upper, lower, infinity LocalDateRange
+		var e = pg.QueryRow("SELECT '[2024-01-01,)'::daterange, '[,2024-01-01)'::daterange, '[2024-01-01,infinity)'::daterange").Scan(&upper, &lower, &infinity)
+		assert.NoError(t, e)
+		assert.Equal(t, MustLocalDateRangeOf(d, LocalDateMax()), upper)
+		assert.Equal(t, MustLocalDateRangeOf(LocalDateMin(), d), lower)
+		assert.Equal(t, MustLocalDateRangeOf(d, LocalDateMax()), infinity)
+	})
+}
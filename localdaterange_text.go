@@ -0,0 +1,169 @@
+package goda
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON strings in [yyyy-MM-dd,yyyy-MM-dd) format or JSON null.
+func (r *LocalDateRange) UnmarshalJSON(bytes []byte) error {
+	if len(bytes) == 4 && string(bytes) == "null" {
+		*r = LocalDateRange{}
+		return nil
+	}
+	return unmarshalJsonImpl(r, bytes)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It returns the range as a JSON string in [yyyy-MM-dd,yyyy-MM-dd) format, or empty string for zero value.
+func (r LocalDateRange) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(r)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the range in [yyyy-MM-dd,yyyy-MM-dd) format, or empty for zero value.
+func (r LocalDateRange) MarshalText() (text []byte, err error) {
+	return marshalTextImpl(r)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses ranges such as [yyyy-MM-dd,yyyy-MM-dd), the bounds may be inclusive '[' ']' or exclusive '(' ')',
+// and may be double-quoted as in PostgreSQL output. Empty input is treated as zero value.
+// Unbounded and "empty" PostgreSQL ranges are rejected, as they have no start and end dates; Scan accepts them.
+func (r *LocalDateRange) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*r = LocalDateRange{}
+		return nil
+	}
+	if string(text) == "empty" {
+		return errors.New("empty range has no start and end")
+	}
+	var i = bytes.IndexByte(text, ',')
+	var last = len(text) - 1
+	if i < 0 || last < 2 || text[0] != '[' && text[0] != '(' || text[last] != ')' && text[last] != ']' {
+		return errors.New("[yyyy-MM-dd,yyyy-MM-dd) required")
+	}
+	var lower, upper = unquoteRangeBound(text[1:i]), unquoteRangeBound(text[i+1 : last])
+	if len(lower) == 0 || len(upper) == 0 {
+		return errors.New("unbounded range is not supported")
+	}
+	var start, end LocalDate
+	if e = start.UnmarshalText(lower); e != nil {
+		return
+	}
+	if e = end.UnmarshalText(upper); e != nil {
+		return
+	}
+	var chain = start.Chain()
+	if text[0] == '(' {
+		chain = chain.PlusDays(1)
+	}
+	if start, e = chain.GetResult(); e != nil {
+		return
+	}
+	chain = end.Chain()
+	if text[last] == ']' {
+		chain = chain.PlusDays(1)
+	}
+	if end, e = chain.GetResult(); e != nil {
+		return
+	}
+	*r, e = LocalDateRangeOf(start, end)
+	return
+}
+
+func unquoteRangeBound(b []byte) []byte {
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		return b[1 : len(b)-1]
+	}
+	return b
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the range in [yyyy-MM-dd,yyyy-MM-dd) format to b, or nothing for zero value.
+func (r LocalDateRange) AppendText(b []byte) ([]byte, error) {
+	if r.IsZero() {
+		return b, nil
+	}
+	b = append(b, '[')
+	b, _ = r.start.AppendText(b)
+	b = append(b, ',')
+	b, _ = r.end.AppendText(b)
+	return append(b, ')'), nil
+}
+
+// String returns the range in [yyyy-MM-dd,yyyy-MM-dd) format, or empty string for zero value.
+func (r LocalDateRange) String() string {
+	return stringImpl(r)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil, such as a PostgreSQL daterange.
+// Besides the forms accepted by UnmarshalText, an "empty" daterange scans into an empty range starting at 1970-01-01,
+// and a missing, "infinity" or "-infinity" bound scans into LocalDateMin or LocalDateMax.
+func (r *LocalDateRange) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*r = LocalDateRange{}
+		return nil
+	case string:
+		return r.scanText([]byte(v))
+	case []byte:
+		return r.scanText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+func (r *LocalDateRange) scanText(text []byte) (e error) {
+	if len(text) == 0 || text[0] != '[' && text[0] != '(' && string(text) != "empty" {
+		return r.UnmarshalText(text)
+	}
+	defer deferOpInParse(text, &e)
+	if string(text) == "empty" {
+		var epoch = MustLocalDateOf(1970, January, 1)
+		*r = LocalDateRange{start: epoch, end: epoch}
+		return nil
+	}
+	var comma = bytes.IndexByte(text, ',')
+	var last = len(text) - 1
+	if comma < 0 || text[last] != ')' && text[last] != ']' {
+		return errors.New("range of dates required")
+	}
+	start, e := parseDateRangeBound(unquoteRangeBound(text[1:comma]), LocalDateMin(), text[0] == '(')
+	if e != nil {
+		return
+	}
+	end, e := parseDateRangeBound(unquoteRangeBound(text[comma+1:last]), LocalDateMax(), text[last] == ']')
+	if e != nil {
+		return
+	}
+	*r, e = LocalDateRangeOf(start, end)
+	return
+}
+
+func parseDateRangeBound(b []byte, unbounded LocalDate, plusDay bool) (d LocalDate, e error) {
+	switch string(b) {
+	case "", "infinity", "-infinity":
+		return unbounded, nil
+	}
+	if e = d.UnmarshalText(b); e != nil {
+		return
+	}
+	if plusDay {
+		return d.Chain().PlusDays(1).GetResult()
+	}
+	return
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the range in [yyyy-MM-dd,yyyy-MM-dd) format, or nil for zero value.
+func (r LocalDateRange) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+	return r.String(), nil
+}
@@ -218,14 +218,26 @@ func MustLocalDateTimeOf(year Year, month Month, day, hour, minute, second, nano
 	return mustValue(LocalDateTimeOf(year, month, day, hour, minute, second, nanosecond))
 }
 
+// LocalDateTimeMin returns the minimum supported date-time, the midnight of LocalDateMin.
+func LocalDateTimeMin() LocalDateTime {
+	return LocalDateMin().AtTime(MustLocalTimeOf(0, 0, 0, 0))
+}
+
+// LocalDateTimeMax returns the maximum supported date-time, the last nanosecond of LocalDateMax.
+func LocalDateTimeMax() LocalDateTime {
+	return LocalDateMax().AtTime(MustLocalTimeOf(23, 59, 59, 999_999_999))
+}
+
 func LocalDateTimeOfEpochSecond(epochSecond int64, nanoOfSecond int64, offset ZoneOffset) (r LocalDateTime, e error) {
 	FieldNanoOfSecond.checkSetE(nanoOfSecond, &e)
 	if e != nil {
 		return
 	}
-	var localSecond = epochSecond + int64(offset.TotalSeconds())
-	var localEpochDay = floorDiv(localSecond, 86400)
-	var secsOfDay = floorMod(localSecond, 86400)
+	// Split before applying the offset, so that epoch seconds close to the int64 bounds don't overflow
+	var localEpochDay = floorDiv(epochSecond, 86400)
+	var secsOfDay = floorMod(epochSecond, 86400) + int64(offset.TotalSeconds())
+	localEpochDay += floorDiv(secsOfDay, 86400)
+	secsOfDay = floorMod(secsOfDay, 86400)
 	r.date, e = LocalDateOfEpochDays(localEpochDay)
 	if e != nil {
 		return
@@ -234,6 +246,63 @@ func LocalDateTimeOfEpochSecond(epochSecond int64, nanoOfSecond int64, offset Zo
 	return
 }
 
+// LocalDateTimeOfInstant creates a LocalDateTime from an Instant and a zone offset.
+// Returns zero value if instant.IsZero().
+func LocalDateTimeOfInstant(instant Instant, offset ZoneOffset) LocalDateTime {
+	if instant.IsZero() {
+		return LocalDateTime{}
+	}
+	return mustValue(LocalDateTimeOfEpochSecond(instant.EpochSecond(), int64(instant.Nano()), offset))
+}
+
+// ToInstant converts this date-time to an Instant using the specified offset.
+// Returns zero value for zero value, or if the result cannot be represented as an Instant.
+func (dt LocalDateTime) ToInstant(offset ZoneOffset) Instant {
+	if dt.IsZero() {
+		return Instant{}
+	}
+	return dt.AtOffset(offset).ToInstant()
+}
+
+// UntilUnit returns the amount of time until another date-time in terms of the unit, such as the number of complete days.
+// The start is included, but the end is not, the result is negative if the end is before the start.
+//
+// The units from UnitNanos to UnitEras are supported, otherwise an error wrapping ErrUnsupported is returned.
+// For date units, the time is taken into account, so 2024-01-01T12:00 until 2024-01-02T11:00 is 0 days.
+// Returns an error if the result overflows, or zero if either date-time is zero.
+func (dt LocalDateTime) UntilUnit(endExclusive LocalDateTime, unit Unit) (int64, error) {
+	if dt.IsZero() || endExclusive.IsZero() {
+		return 0, nil
+	}
+	if unit.IsTimeBased() {
+		var days = endExclusive.date.UnixEpochDays() - dt.date.UnixEpochDays()
+		var timePart = endExclusive.time.NanoOfDay() - dt.time.NanoOfDay()
+		if days > 0 && timePart < 0 {
+			days--
+			timePart += 86400_000_000_000
+		} else if days < 0 && timePart > 0 {
+			days++
+			timePart -= 86400_000_000_000
+		}
+		var unitNanos = unit.nanos()
+		r, overflow := mulExact(days, 86400_000_000_000/unitNanos)
+		if !overflow {
+			r, overflow = addExactly(r, timePart/unitNanos)
+		}
+		if overflow {
+			return 0, overflowError()
+		}
+		return r, nil
+	}
+	var endDate = endExclusive.date
+	if endDate.IsAfter(dt.date) && endExclusive.time.IsBefore(dt.time) {
+		endDate = endDate.Chain().MinusDays(1).MustGet()
+	} else if endDate.IsBefore(dt.date) && endExclusive.time.IsAfter(dt.time) {
+		endDate = endDate.Chain().PlusDays(1).MustGet()
+	}
+	return dt.date.UntilUnit(endDate, unit)
+}
+
 // LocalDateTimeNow returns the current date-time in the system's local time zone.
 func LocalDateTimeNow() LocalDateTime {
 	return LocalDateTimeOfGoTime(time.Now())
@@ -249,6 +318,12 @@ func LocalDateTimeNowUTC() LocalDateTime {
 	return LocalDateTimeOfGoTime(time.Now().UTC())
 }
 
+// LocalDateTimeNowClock returns the current date-time of the clock in the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func LocalDateTimeNowClock(clock Clock) LocalDateTime {
+	return zonedDateTimeNowClock(clock).LocalDateTime()
+}
+
 // LocalDateTimeOfGoTime creates a LocalDateTime from a time.Time.
 // Returns zero value if t.IsZero().
 func LocalDateTimeOfGoTime(t time.Time) LocalDateTime {
@@ -298,7 +373,5 @@ func _assertLocalDateTimeIsComparable[T comparable](t T) {}
 
 var _ = _assertLocalDateTimeIsComparable[LocalDateTime]
 
-var localDateTimeMinEpochSecond = mustValue(LocalDateTimeOfEpochSecond(math.MinInt64, 0, ZoneOffsetUTC()))
-var localDateTimeMaxEpochSecond = mustValue(LocalDateTimeOfEpochSecond(math.MaxInt64, 0, ZoneOffsetUTC()))
 var localDateTimeMinEpochSecondOneDay = mustValue(LocalDateTimeOfEpochSecond(math.MinInt64+48*86400, 0, ZoneOffsetUTC()))
 var localDateTimeMaxEpochSecondOneDay = mustValue(LocalDateTimeOfEpochSecond(math.MaxInt64-48*86400, 0, ZoneOffsetUTC()))
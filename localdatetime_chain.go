@@ -1,6 +1,9 @@
 package goda
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 type LocalDateTimeChain struct {
 	Chain[LocalDateTime]
@@ -94,6 +97,63 @@ func (l LocalDateTimeChain) MinusNanos(nanos int64) LocalDateTimeChain {
 	return l.plusWithOverflow(l.value.date, 0, 0, 0, nanos, -1)
 }
 
+// Plus returns a copy of this date-time with the specified amount added.
+// A Period is added to the date as LocalDateChain.Plus does, a Duration is added to the time with day overflow.
+func (l LocalDateTimeChain) Plus(amount TemporalAmount) LocalDateTimeChain {
+	defer l.leaveFunction(tyLocalDateTime, fnPlus)
+	switch a := amount.(type) {
+	case Duration:
+		return l.plusWithOverflow(l.value.date, 0, 0, a.seconds, int64(a.nanos), 1)
+	case Period:
+		l.value.date = l.value.date.chainWithError(l.eError).Plus(a).mergeError(&l.eError)
+	}
+	return l
+}
+
+// Minus returns a copy of this date-time with the specified amount subtracted.
+// A Period is subtracted from the date as LocalDateChain.Minus does, a Duration is subtracted from the time with day overflow.
+func (l LocalDateTimeChain) Minus(amount TemporalAmount) LocalDateTimeChain {
+	defer l.leaveFunction(tyLocalDateTime, fnMinus)
+	switch a := amount.(type) {
+	case Duration:
+		return l.plusWithOverflow(l.value.date, 0, 0, a.seconds, int64(a.nanos), -1)
+	case Period:
+		l.value.date = l.value.date.chainWithError(l.eError).Minus(a).mergeError(&l.eError)
+	}
+	return l
+}
+
+// PlusUnit returns a copy of this date-time with the amount of the unit added, such as 3 UnitMonths.
+// Time units overflow into the date, date units are added to the date as LocalDateChain.PlusUnit does.
+// The units from UnitNanos to UnitEras are supported, otherwise an error wrapping ErrUnsupported is returned.
+func (l LocalDateTimeChain) PlusUnit(amount int64, unit Unit) LocalDateTimeChain {
+	defer l.leaveFunction(tyLocalDateTime, fnPlusUnit)
+	if unit.IsTimeBased() {
+		var unitNanos = unit.nanos()
+		var unitsPerDay = 86400_000_000_000 / unitNanos
+		var newDate = l.value.date.chainWithError(l.eError).PlusDays(amount / unitsPerDay).mergeError(&l.eError)
+		return l.plusWithOverflow(newDate, 0, 0, 0, amount%unitsPerDay*unitNanos, 1)
+	}
+	l.value.date = l.value.date.chainWithError(l.eError).PlusUnit(amount, unit).mergeError(&l.eError)
+	return l
+}
+
+// MinusUnit returns a copy of this date-time with the amount of the unit subtracted, see PlusUnit.
+func (l LocalDateTimeChain) MinusUnit(amount int64, unit Unit) LocalDateTimeChain {
+	defer l.leaveFunction(tyLocalDateTime, fnMinusUnit)
+	if amount == math.MinInt64 {
+		return l.PlusUnit(math.MaxInt64, unit).PlusUnit(1, unit)
+	}
+	return l.PlusUnit(-amount, unit)
+}
+
+// TruncatedTo returns a copy of this date-time with the time truncated to the unit, see LocalTimeChain.TruncatedTo.
+func (l LocalDateTimeChain) TruncatedTo(unit Unit) LocalDateTimeChain {
+	defer l.leaveFunction(tyLocalDateTime, fnTruncatedTo)
+	l.value.time = l.value.time.chainWithError(l.eError).TruncatedTo(unit).mergeError(&l.eError)
+	return l
+}
+
 func (l LocalDateTimeChain) plusWithOverflow(newDate LocalDate, hours, minutes, seconds, nanos, sign int64) LocalDateTimeChain {
 	if !l.ok() {
 		return l
@@ -132,6 +192,14 @@ func (l LocalDateTimeChain) plusWithOverflow(newDate LocalDate, hours, minutes,
 	return l
 }
 
+// With returns a copy of this date-time with the date adjusted by the adjuster, such as LastDayOfMonth().
+// The time is not changed.
+func (l LocalDateTimeChain) With(adjuster TemporalAdjuster) LocalDateTimeChain {
+	defer l.leaveFunction(tyLocalDateTime, fnWith)
+	l.value.date = l.value.date.chainWithError(l.eError).With(adjuster).mergeError(&l.eError)
+	return l
+}
+
 func (l LocalDateTimeChain) WithYear(year Year) LocalDateTimeChain {
 	defer l.leaveFunction(tyLocalDateTime, fnWithYear)
 	l.value.date = l.value.date.chainWithError(l.eError).WithYear(year).mergeError(&l.eError)
@@ -1,6 +1,7 @@
 package goda
 
 import (
+	"database/sql/driver"
 	_ "embed"
 	"encoding/json"
 	"fmt"
@@ -72,6 +73,17 @@ func TestMustNewLocalDateTime(t *testing.T) {
 	})
 }
 
+func TestLocalDateTimeMinMax(t *testing.T) {
+	assert.Equal(t, LocalDateMin(), LocalDateTimeMin().LocalDate())
+	assert.Equal(t, MustLocalTimeOf(0, 0, 0, 0), LocalDateTimeMin().LocalTime())
+	assert.Equal(t, LocalDateMax(), LocalDateTimeMax().LocalDate())
+	assert.Equal(t, MustLocalTimeOf(23, 59, 59, 999_999_999), LocalDateTimeMax().LocalTime())
+	_, e := LocalDateTimeMax().Chain().PlusNanos(1).GetResult()
+	assert.Error(t, e)
+	_, e = LocalDateTimeMin().Chain().MinusNanos(1).GetResult()
+	assert.Error(t, e)
+}
+
 func TestNewLocalDateTimeByGoTime(t *testing.T) {
 	t.Run("valid time", func(t *testing.T) {
 		goTime := time.Date(2024, 3, 15, 14, 30, 45, 123456789, time.UTC)
@@ -328,6 +340,51 @@ func TestLocalDateTime_Scan(t *testing.T) {
 	})
 }
 
+func TestLocalDateTime_ScanInfinityAndEra(t *testing.T) {
+	for _, tt := range []struct {
+		src      any
+		expected LocalDateTime
+	}{
+		{"infinity", LocalDateTimeMax()},
+		{[]byte("-infinity"), LocalDateTimeMin()},
+		{"0044-03-15 10:30:00 BC", MustLocalDateTimeOf(-43, March, 15, 10, 30, 0, 0)},
+		{"0044-03-15T10:30:00.5 BC", MustLocalDateTimeOf(-43, March, 15, 10, 30, 0, 500000000)},
+	} {
+		var dt LocalDateTime
+		require.NoError(t, dt.Scan(tt.src), "%v", tt.src)
+		assert.Equal(t, tt.expected, dt)
+	}
+	var dt LocalDateTime
+	assert.Error(t, dt.Scan("0044-03-15 BC"))
+}
+
+func TestLocalDateTime_ScanMySQL(t *testing.T) {
+	var dt = MustLocalDateTimeOf(2024, March, 15, 10, 30, 0, 0)
+	for _, s := range []string{"0000-00-00 00:00:00", "0000-00-00 00:00:00.000000", "0000-00-00"} {
+		dt = MustLocalDateTimeOf(2024, March, 15, 10, 30, 0, 0)
+		require.NoError(t, dt.Scan(s), s)
+		assert.True(t, dt.IsZero(), s)
+	}
+	assert.Error(t, dt.Scan("0000-00-00 00:00:01"))
+	require.NoError(t, dt.Scan([]byte("2024-03-15 10:30:00.123456")))
+	assert.Equal(t, MustLocalDateTimeOf(2024, March, 15, 10, 30, 0, 123456000), dt)
+}
+
+func TestLocalDateTime_ValueInfinityAndEra(t *testing.T) {
+	var check = func(v driver.Valuer, expected string) {
+		t.Helper()
+		val, err := v.Value()
+		require.NoError(t, err)
+		assert.Equal(t, expected, val)
+	}
+	check(MustLocalDateTimeOf(-43, March, 15, 10, 30, 0, 0), "-0043-03-15T10:30:00")
+
+	check(PostgresValueOf(LocalDateTimeMax()), "infinity")
+	check(PostgresValueOf(LocalDateTimeMin()), "-infinity")
+	check(PostgresValueOf(MustLocalDateTimeOf(-43, March, 15, 10, 30, 0, 0)), "0044-03-15T10:30:00 BC")
+	check(PostgresValueOf(MustLocalDateTimeOf(2024, March, 15, 10, 30, 0, 0)), "2024-03-15T10:30:00")
+}
+
 func TestLocalDateTime_Value(t *testing.T) {
 	t.Run("non-zero", func(t *testing.T) {
 		dt := MustLocalDateTimeOf(2024, March, 15, 14, 30, 45, 123456789)
@@ -534,4 +591,21 @@ func TestLocalDateTime_ValueMySQL(t *testing.T) {
 		assert.True(t, actual.IsZero())
 		assert.True(t, expectedTrue)
 	})
+	t.Run("fraction", func(t *testing.T) {
+		var expected = MustLocalDateTimeParse("2000-12-29T12:00:00.123456")
+		var actual LocalDateTime
+		var e = pg.QueryRow("SELECT CAST(? AS DATETIME(6))", expected).Scan(&actual)
+		assert.NoError(t, e)
+		assert.Equal(t, expected, actual)
+	})
+	t.Run("parseTime", func(t *testing.T) {
+		var db = GetMySQLWithParams(t, "parseTime=true&loc=Asia%2FShanghai")
+		var expected = MustLocalDateTimeParse("2000-12-29T12:00:00.123456")
+		var actual LocalDateTime
+		var actualOffset OffsetDateTime
+		var e = db.QueryRow("SELECT CAST(? AS DATETIME(6)), CAST(? AS DATETIME(6))", expected, expected).Scan(&actual, &actualOffset)
+		assert.NoError(t, e)
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, expected.AtOffset(MustZoneOffsetOfHours(8)), actualOffset)
+	})
 }
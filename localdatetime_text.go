@@ -80,15 +80,20 @@ func (dt *LocalDateTime) UnmarshalJSON(data []byte) error {
 }
 
 // Scan implements sql.Scanner.
+// The PostgreSQL "infinity" and "-infinity" are converted to LocalDateTimeMax and LocalDateTimeMin,
+// and values with the BC era such as "0044-03-15 10:00:00 BC" to non-positive years.
+// The MySQL zero date-time "0000-00-00 00:00:00" is converted to the zero value.
+// A time.Time, such as from the MySQL driver with parseTime=true, is converted by its wall clock
+// in its location, so the loc parameter of the driver should be the time zone the values are stored in.
 func (dt *LocalDateTime) Scan(src any) error {
 	switch v := src.(type) {
 	case nil:
 		*dt = LocalDateTime{}
 		return nil
 	case string:
-		return dt.UnmarshalText([]byte(v))
+		return dt.scanText([]byte(v))
 	case []byte:
-		return dt.UnmarshalText(v)
+		return dt.scanText(v)
 	case time.Time:
 		*dt = LocalDateTimeOfGoTime(v)
 		return nil
@@ -98,9 +103,31 @@ func (dt *LocalDateTime) Scan(src any) error {
 }
 
 // Value implements driver.Valuer.
+// Wrap it with PostgresValueOf for the PostgreSQL infinity and BC forms.
 func (dt LocalDateTime) Value() (driver.Value, error) {
 	if dt.IsZero() {
 		return nil, nil
 	}
 	return dt.String(), nil
 }
+
+func (dt *LocalDateTime) scanText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if isMySQLZeroDate(text) {
+		*dt = LocalDateTime{}
+		return nil
+	}
+	switch postgresInfinity(text) {
+	case 1:
+		*dt = LocalDateTimeMax()
+		return nil
+	case -1:
+		*dt = LocalDateTimeMin()
+		return nil
+	}
+	iso, e := postgresEraToISO(text)
+	if e != nil {
+		return
+	}
+	return dt.UnmarshalText(iso)
+}
@@ -239,6 +239,21 @@ func (t LocalTime) NanoOfDay() int64 {
 	return t.v & localTimeValueMask
 }
 
+// UntilUnit returns the amount of time until another time in terms of the unit, such as the number of complete hours.
+// The start time is included, but the end time is not, the result is negative if the end is before the start.
+//
+// The units from UnitNanos to UnitHalfDays are supported, otherwise an error wrapping ErrUnsupported is returned.
+// Returns zero if either time is zero.
+func (t LocalTime) UntilUnit(endExclusive LocalTime, unit Unit) (int64, error) {
+	if t.IsZero() || endExclusive.IsZero() {
+		return 0, nil
+	}
+	if !unit.IsTimeBased() {
+		return 0, unsupportedUnit(unit)
+	}
+	return (endExclusive.NanoOfDay() - t.NanoOfDay()) / unit.nanos(), nil
+}
+
 func (t LocalTime) Chain() (chain LocalTimeChain) {
 	chain.value = t
 	return
@@ -371,6 +386,12 @@ func LocalTimeNowUTC() LocalTime {
 	return LocalTimeOfGoTime(time.Now().UTC())
 }
 
+// LocalTimeNowClock returns the current time of the clock in the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func LocalTimeNowClock(clock Clock) LocalTime {
+	return zonedDateTimeNowClock(clock).LocalTime()
+}
+
 // LocalTimeParse parses a time string in HH:mm:ss[.nnnnnnnnn] format (24-hour).
 // Returns an error if the string is invalid or represents an invalid time.
 //
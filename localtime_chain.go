@@ -1,5 +1,7 @@
 package goda
 
+import "math"
+
 type LocalTimeChain struct {
 	Chain[LocalTime]
 }
@@ -86,6 +88,78 @@ func (l LocalTimeChain) MinusNanos(nanosToSubtract int64) LocalTimeChain {
 	return l.PlusNanos(-(nanosToSubtract % 86400_000_000_000))
 }
 
+// Plus returns a copy of this time with the specified amount added, wrapping around midnight.
+// A Period is only supported if it has zero length.
+func (l LocalTimeChain) Plus(amount TemporalAmount) LocalTimeChain {
+	defer l.leaveFunction(tyLocalTime, fnPlus)
+	switch a := amount.(type) {
+	case Duration:
+		return l.PlusNanos(a.seconds%86400*1000_000_000 + int64(a.nanos))
+	case Period:
+		if l.ok() && a.years|a.months|a.days != 0 {
+			l.eError = unsupportedAmount(a)
+		}
+	}
+	return l
+}
+
+// Minus returns a copy of this time with the specified amount subtracted, wrapping around midnight.
+// A Period is only supported if it has zero length.
+func (l LocalTimeChain) Minus(amount TemporalAmount) LocalTimeChain {
+	defer l.leaveFunction(tyLocalTime, fnMinus)
+	switch a := amount.(type) {
+	case Duration:
+		return l.PlusNanos(-(a.seconds%86400*1000_000_000 + int64(a.nanos)))
+	case Period:
+		if l.ok() && a.years|a.months|a.days != 0 {
+			l.eError = unsupportedAmount(a)
+		}
+	}
+	return l
+}
+
+// PlusUnit returns a copy of this time with the amount of the unit added, such as 90 UnitMinutes.
+// The calculation wraps around midnight.
+// The units from UnitNanos to UnitHalfDays are supported, otherwise an error wrapping ErrUnsupported is returned.
+func (l LocalTimeChain) PlusUnit(amount int64, unit Unit) LocalTimeChain {
+	defer l.leaveFunction(tyLocalTime, fnPlusUnit)
+	if !l.ok() {
+		return l
+	}
+	if !unit.IsTimeBased() {
+		l.eError = unsupportedUnit(unit)
+		return l
+	}
+	var unitNanos = unit.nanos()
+	return l.PlusNanos(amount % (86400_000_000_000 / unitNanos) * unitNanos)
+}
+
+// MinusUnit returns a copy of this time with the amount of the unit subtracted, see PlusUnit.
+func (l LocalTimeChain) MinusUnit(amount int64, unit Unit) LocalTimeChain {
+	defer l.leaveFunction(tyLocalTime, fnMinusUnit)
+	if amount == math.MinInt64 {
+		return l.PlusUnit(math.MaxInt64, unit).PlusUnit(1, unit)
+	}
+	return l.PlusUnit(-amount, unit)
+}
+
+// TruncatedTo returns a copy of this time truncated to the unit, such as 14:30:45 truncated to UnitHours is 14:00.
+// UnitDays truncates to midnight.
+// The units from UnitNanos to UnitDays are supported, otherwise an error wrapping ErrUnsupported is returned.
+func (l LocalTimeChain) TruncatedTo(unit Unit) LocalTimeChain {
+	defer l.leaveFunction(tyLocalTime, fnTruncatedTo)
+	if !l.ok() {
+		return l
+	}
+	if !unit.IsTimeBased() && unit != UnitDays {
+		l.eError = unsupportedUnit(unit)
+		return l
+	}
+	var unitNanos = unit.nanos()
+	l.value, l.eError = LocalTimeOfNanoOfDay(l.value.NanoOfDay() / unitNanos * unitNanos)
+	return l
+}
+
 func (l LocalTimeChain) WithNano(nanoOfSecond int) LocalTimeChain {
 	defer l.leaveFunction(tyLocalTime, fnWithNano)
 	FieldNanoOfSecond.checkSetE(int64(nanoOfSecond), &l.eError)
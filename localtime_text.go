@@ -15,6 +15,7 @@ func (t LocalTime) Value() (driver.Value, error) {
 }
 
 // Scan implements sql.Scanner for database deserialization.
+// MySQL TIME values beyond 24 hours are rejected, scan them into a Duration instead.
 func (t *LocalTime) Scan(src any) error {
 	switch v := src.(type) {
 	case nil:
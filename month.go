@@ -1,6 +1,11 @@
 package goda
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
 	"strconv"
 	"time"
 )
@@ -102,3 +107,23 @@ func (m Month) Length(isLeap bool) int {
 	}
 	return m.MaxDays()
 }
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = Month(0)
+	_ fmt.Stringer             = Month(0)
+	_ encoding.TextMarshaler   = Month(0)
+	_ encoding.TextUnmarshaler = (*Month)(nil)
+	_ json.Marshaler           = Month(0)
+	_ json.Unmarshaler         = (*Month)(nil)
+	_ driver.Valuer            = Month(0)
+	_ sql.Scanner              = (*Month)(nil)
+	_ encoding.TextAppender    = MonthName(0)
+	_ fmt.Stringer             = MonthName(0)
+	_ encoding.TextMarshaler   = MonthName(0)
+	_ encoding.TextUnmarshaler = (*MonthName)(nil)
+	_ json.Marshaler           = MonthName(0)
+	_ json.Unmarshaler         = (*MonthName)(nil)
+	_ driver.Valuer            = MonthName(0)
+	_ sql.Scanner              = (*MonthName)(nil)
+)
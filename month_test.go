@@ -1,10 +1,12 @@
 package goda
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMonth_IsZero(t *testing.T) {
@@ -272,3 +274,123 @@ func TestMonth_FirstDayConsistency(t *testing.T) {
 		}
 	})
 }
+
+func TestMonth_UnmarshalText(t *testing.T) {
+	for _, s := range []string{"3", "03", "MARCH", "March", "march", "Mar", "MAR"} {
+		var m Month
+		require.NoError(t, m.UnmarshalText([]byte(s)), s)
+		assert.Equal(t, March, m, s)
+	}
+	var m = March
+	require.NoError(t, m.UnmarshalText(nil))
+	assert.True(t, m.IsZero())
+	for _, s := range []string{"13", "-1", "Marc", "M", "Monday"} {
+		assert.Error(t, m.UnmarshalText([]byte(s)), s)
+	}
+}
+
+func TestMonth_Format(t *testing.T) {
+	assert.Equal(t, "9", September.Format(EnumFormatNumber))
+	assert.Equal(t, "SEPTEMBER", September.Format(EnumFormatName))
+	assert.Equal(t, "September", September.Format(EnumFormatDisplayName))
+	assert.Equal(t, "Sep", September.Format(EnumFormatShortName))
+	assert.Equal(t, "", Month(0).Format(EnumFormatName))
+	for _, format := range []EnumFormat{EnumFormatNumber, EnumFormatName, EnumFormatDisplayName, EnumFormatShortName} {
+		var m Month
+		require.NoError(t, m.UnmarshalText([]byte(September.Format(format))))
+		assert.Equal(t, September, m)
+	}
+}
+
+func TestMonth_Codecs(t *testing.T) {
+	text, err := September.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "9", string(text))
+	value, err := September.Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), value)
+
+	var s struct {
+		Month Month `json:"month"`
+	}
+	s.Month = September
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.Equal(t, `{"month":9}`, string(data))
+
+	// the zero value stays a number in JSON, same as the underlying integer
+	s.Month = 0
+	data, err = json.Marshal(s)
+	require.NoError(t, err)
+	assert.Equal(t, `{"month":0}`, string(data))
+	s.Month = March
+	require.NoError(t, json.Unmarshal(data, &s))
+	assert.True(t, s.Month.IsZero())
+	value, err = Month(0).Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestMonthName(t *testing.T) {
+	var s struct {
+		Month MonthName `json:"month"`
+	}
+	s.Month = MonthName(September)
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	assert.Equal(t, `{"month":"SEPTEMBER"}`, string(data))
+	value, err := s.Month.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "SEPTEMBER", value)
+	assert.Equal(t, "SEPTEMBER", s.Month.String())
+	assert.Equal(t, September, s.Month.Month())
+
+	for _, data := range []string{`{"month":9}`, `{"month":"Sep"}`, `{"month":"september"}`} {
+		s.Month = 0
+		require.NoError(t, json.Unmarshal([]byte(data), &s), data)
+		assert.Equal(t, MonthName(September), s.Month, data)
+	}
+	require.NoError(t, s.Month.Scan("SEPTEMBER"))
+	assert.Equal(t, MonthName(September), s.Month)
+
+	s.Month = 0
+	data, err = json.Marshal(s)
+	require.NoError(t, err)
+	assert.Equal(t, `{"month":null}`, string(data))
+	s.Month = MonthName(March)
+	require.NoError(t, json.Unmarshal(data, &s))
+	assert.Equal(t, MonthName(0), s.Month)
+	value, err = s.Month.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestMonth_JSON(t *testing.T) {
+	var s struct {
+		Month Month `json:"month"`
+	}
+	for _, data := range []string{`{"month":3}`, `{"month":"3"}`, `{"month":"MARCH"}`, `{"month":"Mar"}`} {
+		s.Month = 0
+		require.NoError(t, json.Unmarshal([]byte(data), &s), data)
+		assert.Equal(t, March, s.Month, data)
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"month":null}`), &s))
+	assert.True(t, s.Month.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`{"month":13}`), &s))
+	assert.Error(t, json.Unmarshal([]byte(`{"month":true}`), &s))
+}
+
+func TestMonth_Scan(t *testing.T) {
+	var m Month
+	for _, src := range []any{int64(3), "3", []byte("MARCH"), "mar"} {
+		m = 0
+		require.NoError(t, m.Scan(src), "%v", src)
+		assert.Equal(t, March, m)
+	}
+	require.NoError(t, m.Scan(nil))
+	assert.True(t, m.IsZero())
+	require.NoError(t, m.Scan(int64(0)))
+	assert.True(t, m.IsZero())
+	assert.Error(t, m.Scan(int64(13)))
+	assert.Error(t, m.Scan(3.0))
+}
@@ -1,6 +1,9 @@
 package goda
 
-import "time"
+import (
+	"database/sql/driver"
+	"time"
+)
 
 // String returns the English name of the month (e.g., "January", "February").
 // Returns empty string for zero value.
@@ -10,3 +13,151 @@ func (m Month) String() string {
 	}
 	return time.Month(m).String()
 }
+
+// Format returns the month in the format, such as "MARCH" in EnumFormatName or "Mar" in EnumFormatShortName.
+// Returns empty string for zero value.
+func (m Month) Format(format EnumFormat) string {
+	if m.IsZero() {
+		return ""
+	}
+	return string(appendEnumText(nil, FieldMonthOfYear, int64(m), format))
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// The month is written as its number (e.g., "3"), use MonthName for the name.
+// Appends nothing for zero value.
+func (m Month) AppendText(b []byte) ([]byte, error) {
+	if m.IsZero() {
+		return b, nil
+	}
+	return appendEnumText(b, FieldMonthOfYear, int64(m), EnumFormatNumber), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m Month) MarshalText() ([]byte, error) {
+	return marshalTextImpl(m)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the number, the name or the three-letter abbreviation ignoring case,
+// such as "3", "MARCH", "March" or "Mar".
+// Empty input and "0" are treated as zero value.
+func (m *Month) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*m = 0
+		return nil
+	}
+	v, e := parseEnumText(text, FieldMonthOfYear)
+	if e != nil {
+		return
+	}
+	*m = Month(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The month is a JSON number, 0 for zero value, same as the underlying integer.
+func (m Month) MarshalJSON() ([]byte, error) {
+	return appendEnumText(nil, FieldMonthOfYear, int64(m), EnumFormatNumber), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON numbers, JSON strings in any form UnmarshalText accepts, or JSON null.
+func (m *Month) UnmarshalJSON(data []byte) error {
+	if len(data) == 4 && string(data) == "null" {
+		*m = 0
+		return nil
+	}
+	if len(data) > 0 && data[0] != '"' {
+		return m.UnmarshalText(data)
+	}
+	return unmarshalJsonImpl(m, data)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil for zero value, otherwise the number as an int64.
+func (m Month) Value() (driver.Value, error) {
+	if m.IsZero() {
+		return nil, nil
+	}
+	return int64(m), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports scanning from nil, int64, string and []byte.
+// Nil values and 0 are converted to the zero value of Month.
+func (m *Month) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+		return nil
+	case int64:
+		if e := scanEnumInt64(FieldMonthOfYear, v); e != nil {
+			return e
+		}
+		*m = Month(v)
+		return nil
+	case string:
+		return m.UnmarshalText([]byte(v))
+	case []byte:
+		return m.UnmarshalText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+// MonthName is a Month written as its upper case name by its codecs, such as "MARCH",
+// which is how Java serializes Month. Parsing accepts the same forms as Month.
+// The zero value is JSON null and SQL NULL.
+type MonthName Month
+
+// Month returns the month.
+func (m MonthName) Month() Month {
+	return Month(m)
+}
+
+// String returns the upper case name of the month (e.g., "MARCH").
+// Returns empty string for zero value.
+func (m MonthName) String() string {
+	return Month(m).Format(EnumFormatName)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (m MonthName) AppendText(b []byte) ([]byte, error) {
+	return append(b, m.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m MonthName) MarshalText() ([]byte, error) {
+	return marshalTextImpl(m)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, same as Month.UnmarshalText.
+func (m *MonthName) UnmarshalText(text []byte) error {
+	return (*Month)(m).UnmarshalText(text)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m MonthName) MarshalJSON() ([]byte, error) {
+	return marshalEnumNameJson(FieldMonthOfYear, int64(m)), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, same as Month.UnmarshalJSON.
+func (m *MonthName) UnmarshalJSON(data []byte) error {
+	return (*Month)(m).UnmarshalJSON(data)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil for zero value, otherwise the upper case name.
+func (m MonthName) Value() (driver.Value, error) {
+	if Month(m).IsZero() {
+		return nil, nil
+	}
+	return m.String(), nil
+}
+
+// Scan implements the sql.Scanner interface, same as Month.Scan.
+func (m *MonthName) Scan(src any) error {
+	return (*Month)(m).Scan(src)
+}
@@ -0,0 +1,171 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// MonthDay represents a month-day without a year in the ISO-8601 calendar system, such as --12-03.
+// It's suitable for recurring annual dates, such as birthdays and anniversaries.
+//
+// MonthDay is comparable and can be used as a map key.
+// The zero value represents an unset month-day and IsZero returns true for it.
+//
+// MonthDay implements sql.Scanner and driver.Valuer for database operations,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: --MM-dd (e.g., "--12-03"). February 29 is valid, see IsValidYear and AtYear.
+//
+// This is similar to Java's MonthDay.
+type MonthDay struct {
+	v int64
+}
+
+// Month returns the month-of-year component (1-12).
+func (md MonthDay) Month() Month {
+	return Month(md.v >> 8)
+}
+
+// DayOfMonth returns the day-of-month component (1-31).
+func (md MonthDay) DayOfMonth() int {
+	return int(md.v & 0xff)
+}
+
+// IsZero returns true if this is the zero value.
+func (md MonthDay) IsZero() bool {
+	return md.v == 0
+}
+
+// IsValidYear returns true if the month-day is a valid date in the year, which is false only for February 29
+// in a non-leap year.
+func (md MonthDay) IsValidYear(year Year) bool {
+	return !md.IsZero() && md.DayOfMonth() <= md.Month().Length(year.IsLeapYear())
+}
+
+// AtYear combines this month-day with a year to create a LocalDate.
+// February 29 becomes February 28 in a non-leap year.
+// Returns zero value if this is zero or the year is out of range.
+func (md MonthDay) AtYear(year Year) LocalDate {
+	if md.IsZero() {
+		return LocalDate{}
+	}
+	d, e := LocalDateOf(year, md.Month(), min(md.DayOfMonth(), md.Month().Length(year.IsLeapYear())))
+	if e != nil {
+		return LocalDate{}
+	}
+	return d
+}
+
+// Compare compares this month-day with another, by the month then by the day.
+// Returns -1 if this is before other, 0 if equal, 1 if after.
+func (md MonthDay) Compare(other MonthDay) int {
+	return doCompare(md, other, compareZero, comparing(MonthDay.Month), comparing(MonthDay.DayOfMonth))
+}
+
+// IsBefore returns true if this month-day is before the other.
+func (md MonthDay) IsBefore(other MonthDay) bool {
+	return md.Compare(other) < 0
+}
+
+// IsAfter returns true if this month-day is after the other.
+func (md MonthDay) IsAfter(other MonthDay) bool {
+	return md.Compare(other) > 0
+}
+
+// IsSupportedField returns true if the field is supported by MonthDay.
+// MonthDay supports FieldMonthOfYear and FieldDayOfMonth.
+func (md MonthDay) IsSupportedField(field Field) bool {
+	switch field {
+	case FieldMonthOfYear, FieldDayOfMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetField returns the value of the specified field as a TemporalValue.
+// Returns an unsupported TemporalValue for zero values or unsupported fields.
+func (md MonthDay) GetField(field Field) TemporalValue {
+	if md.IsZero() {
+		return TemporalValue{unsupported: true}
+	}
+	switch field {
+	case FieldMonthOfYear:
+		return TemporalValue{v: int64(md.Month())}
+	case FieldDayOfMonth:
+		return TemporalValue{v: int64(md.DayOfMonth())}
+	default:
+		return TemporalValue{unsupported: true}
+	}
+}
+
+// MonthDay returns the month-day of this date.
+// Returns zero value for zero value.
+func (d LocalDate) MonthDay() MonthDay {
+	if d.IsZero() {
+		return MonthDay{}
+	}
+	return MonthDay{v: int64(d.Month())<<8 | int64(d.DayOfMonth())}
+}
+
+// MonthDayOf creates a new MonthDay from the month and the day-of-month.
+// Returns an error if the day is out of range for the month, February 29 is accepted.
+func MonthDayOf(month Month, dayOfMonth int) (md MonthDay, e error) {
+	FieldMonthOfYear.checkSetE(int64(month), &e)
+	FieldDayOfMonth.checkSetE(int64(dayOfMonth), &e)
+	if e != nil {
+		return
+	}
+	if dayOfMonth > month.MaxDays() {
+		return md, newFieldError(FieldDayOfMonth, "invalid date %s %d", month, dayOfMonth)
+	}
+	return MonthDay{v: int64(month)<<8 | int64(dayOfMonth)}, nil
+}
+
+// MustMonthDayOf creates a new MonthDay from the month and the day-of-month.
+// Panics if the month-day is invalid.
+func MustMonthDayOf(month Month, dayOfMonth int) MonthDay {
+	return mustValue(MonthDayOf(month, dayOfMonth))
+}
+
+// MonthDayNowClock returns the current month-day of the clock in the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func MonthDayNowClock(clock Clock) MonthDay {
+	return zonedDateTimeNowClock(clock).LocalDate().MonthDay()
+}
+
+// MonthDayParse parses a month-day string in --MM-dd format (e.g., "--12-03").
+// Returns an error if the string is invalid.
+func MonthDayParse(s string) (MonthDay, error) {
+	var md MonthDay
+	err := md.UnmarshalText([]byte(s))
+	return md, err
+}
+
+// MustMonthDayParse parses a month-day string in --MM-dd format (e.g., "--12-03").
+// Panics if the string is invalid.
+func MustMonthDayParse(s string) MonthDay {
+	return mustValue(MonthDayParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*MonthDay)(nil)
+	_ fmt.Stringer             = (*MonthDay)(nil)
+	_ encoding.TextMarshaler   = (*MonthDay)(nil)
+	_ encoding.TextUnmarshaler = (*MonthDay)(nil)
+	_ json.Marshaler           = (*MonthDay)(nil)
+	_ json.Unmarshaler         = (*MonthDay)(nil)
+	_ driver.Valuer            = (*MonthDay)(nil)
+	_ sql.Scanner              = (*MonthDay)(nil)
+	_ TemporalAccessor         = (*MonthDay)(nil)
+)
+
+// Compile-time check that MonthDay is comparable
+func _assertMonthDayIsComparable[T comparable](t T) {}
+
+var _ = _assertMonthDayIsComparable[MonthDay]
@@ -0,0 +1,122 @@
+package goda
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonthDayOf(t *testing.T) {
+	md, err := MonthDayOf(December, 3)
+	require.NoError(t, err)
+	assert.Equal(t, December, md.Month())
+	assert.Equal(t, 3, md.DayOfMonth())
+	assert.Equal(t, "--12-03", md.String())
+	assert.False(t, md.IsZero())
+	assert.True(t, MonthDay{}.IsZero())
+
+	_, err = MonthDayOf(February, 29)
+	assert.NoError(t, err)
+	for _, it := range [][2]int{{2, 30}, {4, 31}, {0, 1}, {13, 1}, {1, 0}, {1, 32}} {
+		_, err = MonthDayOf(Month(it[0]), it[1])
+		assert.Error(t, err, "%v", it)
+	}
+	assert.Panics(t, func() { MustMonthDayOf(June, 31) })
+	assert.Equal(t, MustMonthDayOf(March, 15), MustLocalDateOf(2024, March, 15).MonthDay())
+	assert.True(t, LocalDate{}.MonthDay().IsZero())
+}
+
+func TestMonthDay_AtYear(t *testing.T) {
+	var leapDay = MustMonthDayOf(February, 29)
+	assert.True(t, leapDay.IsValidYear(2024))
+	assert.False(t, leapDay.IsValidYear(2023))
+	assert.False(t, leapDay.IsValidYear(1900))
+	assert.True(t, leapDay.IsValidYear(2000))
+	assert.Equal(t, MustLocalDateOf(2024, February, 29), leapDay.AtYear(2024))
+	assert.Equal(t, MustLocalDateOf(2023, February, 28), leapDay.AtYear(2023))
+	assert.Equal(t, MustLocalDateOf(2023, December, 31), MustMonthDayOf(December, 31).AtYear(2023))
+	assert.True(t, MustMonthDayOf(December, 31).IsValidYear(2023))
+	assert.False(t, MonthDay{}.IsValidYear(2024))
+	assert.True(t, MonthDay{}.AtYear(2024).IsZero())
+	assert.True(t, leapDay.AtYear(YearMax+1).IsZero())
+}
+
+func TestMonthDay_Compare(t *testing.T) {
+	var a = MustMonthDayOf(February, 29)
+	var b = MustMonthDayOf(March, 1)
+	var c = MustMonthDayOf(March, 15)
+	assert.Equal(t, -1, a.Compare(b))
+	assert.Equal(t, -1, b.Compare(c))
+	assert.Equal(t, 1, c.Compare(a))
+	assert.Equal(t, 0, b.Compare(MustMonthDayParse("--03-01")))
+	assert.True(t, a.IsBefore(b))
+	assert.True(t, c.IsAfter(b))
+	assert.False(t, b.IsAfter(b))
+	assert.Equal(t, -1, MonthDay{}.Compare(a))
+}
+
+func TestMonthDay_GetField(t *testing.T) {
+	var md = MustMonthDayOf(December, 3)
+	assert.True(t, md.IsSupportedField(FieldMonthOfYear))
+	assert.True(t, md.IsSupportedField(FieldDayOfMonth))
+	assert.False(t, md.IsSupportedField(FieldYear))
+	assert.False(t, md.IsSupportedField(FieldDayOfYear))
+	assert.Equal(t, int64(12), md.GetField(FieldMonthOfYear).Int64())
+	assert.Equal(t, int64(3), md.GetField(FieldDayOfMonth).Int64())
+	assert.True(t, md.GetField(FieldYear).Unsupported())
+	assert.True(t, MonthDay{}.GetField(FieldMonthOfYear).Unsupported())
+	assert.Equal(t, "12/03", MustDateTimeFormatterOfPattern("MM/dd").MustFormat(md))
+}
+
+func TestMonthDay_Text(t *testing.T) {
+	for _, text := range []string{"--01-01", "--02-29", "--12-31", "--06-30"} {
+		md, err := MonthDayParse(text)
+		require.NoError(t, err, text)
+		assert.Equal(t, text, md.String())
+	}
+	for _, text := range []string{"12-03", "--12-3", "--1-03", "--12/03", "--02-30", "--13-01", "--00-01", "--12-00", "2024-12-03", "--12-03 "} {
+		_, err := MonthDayParse(text)
+		assert.Error(t, err, text)
+	}
+	md, err := MonthDayParse("")
+	require.NoError(t, err)
+	assert.True(t, md.IsZero())
+	assert.Equal(t, "", md.String())
+}
+
+func TestMonthDay_JSON(t *testing.T) {
+	type person struct {
+		Birthday    MonthDay `json:"birthday"`
+		Anniversary MonthDay `json:"anniversary"`
+	}
+	data, err := json.Marshal(person{Birthday: MustMonthDayOf(February, 29)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"birthday":"--02-29","anniversary":""}`, string(data))
+
+	var p person
+	require.NoError(t, json.Unmarshal([]byte(`{"birthday":"--12-03","anniversary":null}`), &p))
+	assert.Equal(t, MustMonthDayOf(December, 3), p.Birthday)
+	assert.True(t, p.Anniversary.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`{"birthday":"12-03"}`), &p))
+	assert.Error(t, json.Unmarshal([]byte(`{"birthday":1203}`), &p))
+}
+
+func TestMonthDay_Scan(t *testing.T) {
+	var md MonthDay
+	require.NoError(t, md.Scan("--12-03"))
+	assert.Equal(t, MustMonthDayOf(December, 3), md)
+	require.NoError(t, md.Scan([]byte("--02-29")))
+	assert.Equal(t, MustMonthDayOf(February, 29), md)
+	require.NoError(t, md.Scan(nil))
+	assert.True(t, md.IsZero())
+	assert.Error(t, md.Scan(1203))
+
+	v, err := MustMonthDayOf(December, 3).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "--12-03", v)
+	v, err = MonthDay{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
@@ -0,0 +1,91 @@
+package goda
+
+import (
+	"database/sql/driver"
+	"errors"
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON strings in --MM-dd format or JSON null.
+func (md *MonthDay) UnmarshalJSON(bytes []byte) error {
+	if len(bytes) == 4 && string(bytes) == "null" {
+		*md = MonthDay{}
+		return nil
+	}
+	return unmarshalJsonImpl(md, bytes)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It returns the month-day as a JSON string in --MM-dd format, or empty string for zero value.
+func (md MonthDay) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(md)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the month-day in --MM-dd format, or empty for zero value.
+func (md MonthDay) MarshalText() (text []byte, err error) {
+	return marshalTextImpl(md)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses month-days in --MM-dd format. Empty input is treated as zero value.
+func (md *MonthDay) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*md = MonthDay{}
+		return nil
+	}
+	if len(text) != 7 || text[0] != '-' || text[1] != '-' || text[4] != '-' {
+		return errors.New("--MM-dd required")
+	}
+	m, e := parseInt(text[2:4])
+	if e != nil {
+		return
+	}
+	dom, e := parseInt(text[5:7])
+	if e != nil {
+		return
+	}
+	*md, e = MonthDayOf(Month(m), dom)
+	return
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the month-day in --MM-dd format to b, or nothing for zero value.
+func (md MonthDay) AppendText(b []byte) ([]byte, error) {
+	if md.IsZero() {
+		return b, nil
+	}
+	var m, d = md.Month(), md.DayOfMonth()
+	return append(b, '-', '-', byte('0'+m/10), byte('0'+m%10), '-', byte('0'+d/10), byte('0'+d%10)), nil
+}
+
+// String returns the month-day in --MM-dd format, or empty string for zero value.
+func (md MonthDay) String() string {
+	return stringImpl(md)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil.
+func (md *MonthDay) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*md = MonthDay{}
+		return nil
+	case string:
+		return md.UnmarshalText([]byte(v))
+	case []byte:
+		return md.UnmarshalText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the month-day in --MM-dd format, or nil for zero value.
+func (md MonthDay) Value() (driver.Value, error) {
+	if md.IsZero() {
+		return nil, nil
+	}
+	return md.String(), nil
+}
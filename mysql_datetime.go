@@ -0,0 +1,24 @@
+package goda
+
+import "bytes"
+
+// isMySQLZeroDate reports whether the text is the MySQL zero date "0000-00-00",
+// optionally followed by a zero time such as "0000-00-00 00:00:00.000000".
+func isMySQLZeroDate(text []byte) bool {
+	rest, ok := bytes.CutPrefix(text, []byte("0000-00-00"))
+	if !ok {
+		return false
+	}
+	if len(rest) == 0 {
+		return true
+	}
+	if rest[0] != ' ' && rest[0] != 'T' {
+		return false
+	}
+	for _, c := range rest[1:] {
+		if c != '0' && c != ':' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
@@ -17,6 +17,17 @@ func GetMySQL(t *testing.T) *sql.DB {
 	return mysqlC
 }
 
+// GetMySQLWithParams opens another connection pool with the DSN parameters, such as "parseTime=true".
+func GetMySQLWithParams(t *testing.T, params string) *sql.DB {
+	GetMySQL(t)
+	db, e := sql.Open("mysql", "root:123456@/?"+params)
+	if e != nil {
+		t.Fatal(e)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
 func init() {
 	var e error
 	mysqlC, e = sql.Open("mysql", "root:123456@/")
@@ -161,14 +161,34 @@ func (odt OffsetDateTime) EpochSecond() int64 {
 	return i
 }
 
+// ToInstant converts this offset date-time to an Instant.
+// Returns zero value for zero value, or if the epoch second overflows int64.
+func (odt OffsetDateTime) ToInstant() Instant {
+	seconds, overflow := odt.epochSecondOverflow()
+	if odt.IsZero() || overflow {
+		return Instant{}
+	}
+	return Instant{seconds: seconds, nanos: int32(odt.Nanosecond()), valid: true}
+}
+
 func (odt OffsetDateTime) epochSecondOverflow() (i int64, overflow bool) {
 	if odt.IsZero() {
 		return 0, false
 	}
 	epochDay := odt.datetime.LocalDate().UnixEpochDays()
-	secondsOfDay := odt.datetime.LocalTime().GetField(FieldSecondOfDay).Int64()
-	i, overflow = addExactly(epochDay*86400+secondsOfDay, -int64(odt.offset.TotalSeconds()))
-	overflow = overflow || odt.LocalDateTime().Compare(localDateTimeMinEpochSecond) < 0 || odt.LocalDateTime().Compare(localDateTimeMaxEpochSecond) > 0
+	secondsOfDay := odt.datetime.LocalTime().GetField(FieldSecondOfDay).Int64() - int64(odt.offset.TotalSeconds())
+	// Normalize so that epochDay*86400 and secondsOfDay have the same sign,
+	// then epochDay*86400 overflows only if the result does.
+	epochDay += floorDiv(secondsOfDay, 86400)
+	secondsOfDay = floorMod(secondsOfDay, 86400)
+	if epochDay < 0 && secondsOfDay > 0 {
+		epochDay++
+		secondsOfDay -= 86400
+	}
+	i, overflow = mulExact(epochDay, 86400)
+	if !overflow {
+		i, overflow = addExactly(i, secondsOfDay)
+	}
 	return
 }
 
@@ -189,6 +209,22 @@ func (odt OffsetDateTime) IsAfter(other OffsetDateTime) bool {
 	return doCompare(odt, other, compareZero, comparing(OffsetDateTime.EpochSecond), comparing(OffsetDateTime.Nanosecond)) > 0
 }
 
+// UntilUnit returns the amount of time until another date-time in terms of the unit, such as the number of complete days.
+// The end is converted to the offset of this date-time first, then the local date-times are compared
+// as in LocalDateTime.UntilUnit.
+// Returns an error if the unit is unsupported or the result overflows, or zero if either date-time is zero.
+func (odt OffsetDateTime) UntilUnit(endExclusive OffsetDateTime, unit Unit) (int64, error) {
+	if odt.IsZero() || endExclusive.IsZero() {
+		return 0, nil
+	}
+	var diff = int64(odt.offset.TotalSeconds() - endExclusive.offset.TotalSeconds())
+	end, e := endExclusive.datetime.Chain().PlusSeconds(diff).GetResult()
+	if e != nil {
+		return 0, e
+	}
+	return odt.datetime.UntilUnit(end, unit)
+}
+
 func (odt OffsetDateTime) Chain() (chain OffsetDateTimeChain) {
 	chain.value = odt
 	return
@@ -223,6 +259,30 @@ func OffsetDateTimeNowUTC() OffsetDateTime {
 	return OffsetDateTimeOfGoTime(time.Now().UTC())
 }
 
+// OffsetDateTimeNowClock returns the current date-time of the clock with the offset of the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func OffsetDateTimeNowClock(clock Clock) OffsetDateTime {
+	return zonedDateTimeNowClock(clock).ToOffsetDateTime()
+}
+
+// OffsetDateTimeMin returns the minimum supported date-time with offset, LocalDateTimeMin at the offset +18:00,
+// which is the earliest instant of all the offsets.
+func OffsetDateTimeMin() OffsetDateTime {
+	return LocalDateTimeMin().AtOffset(ZoneOffsetMax())
+}
+
+// OffsetDateTimeMax returns the maximum supported date-time with offset, LocalDateTimeMax at the offset -18:00,
+// which is the latest instant of all the offsets.
+func OffsetDateTimeMax() OffsetDateTime {
+	return LocalDateTimeMax().AtOffset(ZoneOffsetMin())
+}
+
+// OffsetDateTimeOfInstant creates an OffsetDateTime from an Instant and a zone offset.
+// Returns zero value if instant.IsZero().
+func OffsetDateTimeOfInstant(instant Instant, offset ZoneOffset) OffsetDateTime {
+	return instant.AtOffset(offset)
+}
+
 // OffsetDateTimeOfGoTime creates an OffsetDateTime from a time.Time.
 // Returns zero value if t.IsZero().
 func OffsetDateTimeOfGoTime(t time.Time) OffsetDateTime {
@@ -102,6 +102,47 @@ func (o OffsetDateTimeChain) MinusNanos(nanos int64) OffsetDateTimeChain {
 	o.value.datetime = o.value.datetime.chainWithError(o.eError).MinusNanos(nanos).mergeError(&o.eError)
 	return o
 }
+func (o OffsetDateTimeChain) Plus(amount TemporalAmount) OffsetDateTimeChain {
+	defer o.leaveFunction(tyOffsetDateTime, fnPlus)
+	o.value.datetime = o.value.datetime.chainWithError(o.eError).Plus(amount).mergeError(&o.eError)
+	return o
+}
+func (o OffsetDateTimeChain) Minus(amount TemporalAmount) OffsetDateTimeChain {
+	defer o.leaveFunction(tyOffsetDateTime, fnMinus)
+	o.value.datetime = o.value.datetime.chainWithError(o.eError).Minus(amount).mergeError(&o.eError)
+	return o
+}
+
+// PlusUnit returns a copy of this date-time with the amount of the unit added, see LocalDateTimeChain.PlusUnit.
+// The offset is not changed.
+func (o OffsetDateTimeChain) PlusUnit(amount int64, unit Unit) OffsetDateTimeChain {
+	defer o.leaveFunction(tyOffsetDateTime, fnPlusUnit)
+	o.value.datetime = o.value.datetime.chainWithError(o.eError).PlusUnit(amount, unit).mergeError(&o.eError)
+	return o
+}
+
+// MinusUnit returns a copy of this date-time with the amount of the unit subtracted, see PlusUnit.
+func (o OffsetDateTimeChain) MinusUnit(amount int64, unit Unit) OffsetDateTimeChain {
+	defer o.leaveFunction(tyOffsetDateTime, fnMinusUnit)
+	o.value.datetime = o.value.datetime.chainWithError(o.eError).MinusUnit(amount, unit).mergeError(&o.eError)
+	return o
+}
+
+// TruncatedTo returns a copy of this date-time with the time truncated to the unit, see LocalTimeChain.TruncatedTo.
+// The offset is not changed.
+func (o OffsetDateTimeChain) TruncatedTo(unit Unit) OffsetDateTimeChain {
+	defer o.leaveFunction(tyOffsetDateTime, fnTruncatedTo)
+	o.value.datetime = o.value.datetime.chainWithError(o.eError).TruncatedTo(unit).mergeError(&o.eError)
+	return o
+}
+
+// With returns a copy of this date-time with the date adjusted by the adjuster, such as LastDayOfMonth().
+// The time and the offset are not changed.
+func (o OffsetDateTimeChain) With(adjuster TemporalAdjuster) OffsetDateTimeChain {
+	defer o.leaveFunction(tyOffsetDateTime, fnWith)
+	o.value.datetime = o.value.datetime.chainWithError(o.eError).With(adjuster).mergeError(&o.eError)
+	return o
+}
 func (o OffsetDateTimeChain) WithYear(year Year) OffsetDateTimeChain {
 	defer o.leaveFunction(tyOffsetDateTime, fnWithYear)
 	o.value.datetime = o.value.datetime.chainWithError(o.eError).WithYear(year).mergeError(&o.eError)
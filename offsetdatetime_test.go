@@ -1,6 +1,8 @@
 package goda
 
 import (
+	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"testing"
 	"time"
@@ -94,6 +96,15 @@ func TestOffsetDateTimeNowUTC(t *testing.T) {
 	assert.Equal(t, 0, nowUTC.Offset().TotalSeconds())
 }
 
+func TestOffsetDateTimeMinMax(t *testing.T) {
+	assert.Equal(t, LocalDateTimeMin(), OffsetDateTimeMin().LocalDateTime())
+	assert.Equal(t, ZoneOffsetMax(), OffsetDateTimeMin().Offset())
+	assert.Equal(t, LocalDateTimeMax(), OffsetDateTimeMax().LocalDateTime())
+	assert.Equal(t, ZoneOffsetMin(), OffsetDateTimeMax().Offset())
+	assert.True(t, OffsetDateTimeMin().IsBefore(LocalDateTimeMin().AtOffset(ZoneOffsetMin())))
+	assert.True(t, OffsetDateTimeMax().IsAfter(LocalDateTimeMax().AtOffset(ZoneOffsetMax())))
+}
+
 func TestParseOffsetDateTime(t *testing.T) {
 	t.Run("with positive offset", func(t *testing.T) {
 		odt, err := OffsetDateTimeParse("2024-03-15T14:30:45.123456789+01:00")
@@ -447,6 +458,47 @@ func TestOffsetDateTime_Value(t *testing.T) {
 	})
 }
 
+func TestOffsetDateTime_ScanInfinityAndEra(t *testing.T) {
+	for _, tt := range []struct {
+		src      any
+		expected OffsetDateTime
+	}{
+		{"infinity", OffsetDateTimeMax()},
+		{[]byte("-infinity"), OffsetDateTimeMin()},
+		{"0044-03-15 10:30:00+08 BC", MustOffsetDateTimeOf(-43, March, 15, 10, 30, 0, 0, MustZoneOffsetOfHours(8))},
+		{"2024-03-15 10:30:00-05", MustOffsetDateTimeOf(2024, March, 15, 10, 30, 0, 0, MustZoneOffsetOfHours(-5))},
+	} {
+		var odt OffsetDateTime
+		require.NoError(t, odt.Scan(tt.src), "%v", tt.src)
+		assert.Equal(t, tt.expected, odt)
+	}
+}
+
+func TestOffsetDateTime_ScanMySQL(t *testing.T) {
+	for _, src := range []any{"0000-00-00 00:00:00", []byte("0000-00-00 00:00:00.000000"), "0000-00-00", time.Time{}} {
+		var odt = MustOffsetDateTimeParse("2024-03-15T10:30:00+08:00")
+		require.NoError(t, odt.Scan(src), "%v", src)
+		assert.True(t, odt.IsZero(), "%v", src)
+	}
+	var odt OffsetDateTime
+	assert.Error(t, odt.Scan("0000-00-00 00:00:01"))
+}
+
+func TestOffsetDateTime_ValueInfinityAndEra(t *testing.T) {
+	var check = func(v driver.Valuer, expected string) {
+		t.Helper()
+		val, err := v.Value()
+		require.NoError(t, err)
+		assert.Equal(t, expected, val)
+	}
+	var bc = MustOffsetDateTimeOf(-43, March, 15, 10, 30, 0, 0, MustZoneOffsetOfHours(8))
+	check(bc, "-0043-03-15T10:30:00+08:00")
+
+	check(PostgresValueOf(OffsetDateTimeMax()), "infinity")
+	check(PostgresValueOf(OffsetDateTimeMin()), "-infinity")
+	check(PostgresValueOf(bc), "0044-03-15T10:30:00+08:00 BC")
+}
+
 func TestOffsetDateTime_ValuePostgres(t *testing.T) {
 	var pg = GetPG(t)
 
@@ -526,6 +578,20 @@ func TestOffsetDateTime_ValueMySQL(t *testing.T) {
 		assert.True(t, expectedTrue)
 	})
 
+	t.Run("zero_date", func(t *testing.T) {
+		for _, params := range []string{"", "parseTime=true"} {
+			conn, e := GetMySQLWithParams(t, params).Conn(context.Background())
+			require.NoError(t, e)
+			_, e = conn.ExecContext(context.Background(), "SET SESSION sql_mode = ''")
+			require.NoError(t, e)
+			var actual = MustOffsetDateTimeParse("2000-12-29T12:00:00+01:00")
+			e = conn.QueryRowContext(context.Background(), "SELECT CAST('0000-00-00 00:00:00' AS DATETIME)").Scan(&actual)
+			assert.NoError(t, e, params)
+			assert.True(t, actual.IsZero(), params)
+			_ = conn.Close()
+		}
+	})
+
 	t.Run("round_trip_instant", func(t *testing.T) {
 		// Test that we can store and retrieve the instant correctly
 		expected := MustOffsetDateTimeParse("2000-12-29T12:00:00+05:30")
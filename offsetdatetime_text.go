@@ -87,15 +87,18 @@ func (odt *OffsetDateTime) UnmarshalJSON(data []byte) error {
 }
 
 // Scan implements sql.Scanner.
+// The PostgreSQL "infinity" and "-infinity" are converted to OffsetDateTimeMax and OffsetDateTimeMin,
+// and values with the BC era such as "0044-03-15 10:00:00 BC" to non-positive years.
+// The MySQL zero date-time "0000-00-00 00:00:00" is converted to the zero value.
 func (odt *OffsetDateTime) Scan(src any) error {
 	switch v := src.(type) {
 	case nil:
 		*odt = OffsetDateTime{}
 		return nil
 	case string:
-		return odt.UnmarshalText([]byte(v))
+		return odt.scanText([]byte(v))
 	case []byte:
-		return odt.UnmarshalText(v)
+		return odt.scanText(v)
 	case time.Time:
 		*odt = OffsetDateTimeOfGoTime(v)
 		return nil
@@ -105,9 +108,31 @@ func (odt *OffsetDateTime) Scan(src any) error {
 }
 
 // Value implements driver.Valuer.
+// Wrap it with PostgresValueOf for the PostgreSQL infinity and BC forms.
 func (odt OffsetDateTime) Value() (driver.Value, error) {
 	if odt.IsZero() {
 		return nil, nil
 	}
 	return odt.String(), nil
 }
+
+func (odt *OffsetDateTime) scanText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if isMySQLZeroDate(text) {
+		*odt = OffsetDateTime{}
+		return nil
+	}
+	switch postgresInfinity(text) {
+	case 1:
+		*odt = OffsetDateTimeMax()
+		return nil
+	case -1:
+		*odt = OffsetDateTimeMin()
+		return nil
+	}
+	iso, e := postgresEraToISO(text)
+	if e != nil {
+		return
+	}
+	return odt.UnmarshalText(iso)
+}
@@ -0,0 +1,247 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OffsetTime represents a time with a time-zone offset from UTC, such as 14:30:45.123456789+01:00.
+// It's suitable for a recurring time of day at a fixed offset, such as the opening hours of a shop,
+// and corresponds to PostgreSQL's timetz type.
+//
+// OffsetTime is comparable and can be used as a map key.
+// The zero value represents an unset time and IsZero returns true for it.
+//
+// OffsetTime implements sql.Scanner and driver.Valuer for database operations,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: HH:mm:ss[.nnnnnnnnn]±HH:mm (e.g., "14:30:45.123456789+01:00").
+//
+// This is similar to Java's OffsetTime.
+type OffsetTime struct {
+	time   LocalTime
+	offset ZoneOffset
+}
+
+// LocalTime returns the local time part without the offset.
+func (ot OffsetTime) LocalTime() LocalTime {
+	return ot.time
+}
+
+// Offset returns the zone offset.
+func (ot OffsetTime) Offset() ZoneOffset {
+	return ot.offset
+}
+
+// Hour returns the hour component (0-23).
+func (ot OffsetTime) Hour() int {
+	return ot.time.Hour()
+}
+
+// Minute returns the minute component (0-59).
+func (ot OffsetTime) Minute() int {
+	return ot.time.Minute()
+}
+
+// Second returns the second component (0-59).
+func (ot OffsetTime) Second() int {
+	return ot.time.Second()
+}
+
+// Millisecond returns the millisecond component (0-999).
+func (ot OffsetTime) Millisecond() int {
+	return ot.time.Millisecond()
+}
+
+// Nanosecond returns the nanosecond component (0-999999999).
+func (ot OffsetTime) Nanosecond() int {
+	return ot.time.Nano()
+}
+
+// IsZero returns true if this is the zero value.
+func (ot OffsetTime) IsZero() bool {
+	return ot.time.IsZero() && ot.offset.IsZero()
+}
+
+// IsSupportedField returns true if the field is supported by OffsetTime.
+// OffsetTime supports all fields from LocalTime plus FieldOffsetSeconds.
+func (ot OffsetTime) IsSupportedField(field Field) bool {
+	return ot.time.IsSupportedField(field) || ot.offset.IsSupportedField(field)
+}
+
+// GetField returns the value of the specified field as a TemporalValue.
+// The returned value may be unsupported if the field is not supported by OffsetTime.
+//
+// If the time is zero (IsZero() returns true), an unsupported TemporalValue is returned.
+//
+// OffsetTime supports all fields from LocalTime plus:
+//   - FieldOffsetSeconds: the offset in seconds
+func (ot OffsetTime) GetField(field Field) TemporalValue {
+	if ot.IsZero() {
+		return TemporalValue{v: 0, unsupported: true}
+	}
+	if ot.offset.IsSupportedField(field) {
+		return ot.offset.GetField(field)
+	}
+	return ot.time.GetField(field)
+}
+
+// GoTime converts this time to a time.Time at the Unix epoch date (1970-01-01) with the offset.
+// Returns time.Time{} (zero) for zero value.
+func (ot OffsetTime) GoTime() time.Time {
+	if ot.IsZero() {
+		return time.Time{}
+	}
+	loc := time.FixedZone("", ot.offset.TotalSeconds())
+	return time.Date(1970, time.January, 1, ot.Hour(), ot.Minute(), ot.Second(), ot.Nanosecond(), loc)
+}
+
+// AtDate combines this time with a date to create an OffsetDateTime.
+// Returns zero value if either is zero.
+func (ot OffsetTime) AtDate(date LocalDate) OffsetDateTime {
+	if ot.IsZero() || date.IsZero() {
+		return OffsetDateTime{}
+	}
+	return ot.time.AtDate(date).AtOffset(ot.offset)
+}
+
+// epochNano returns the nano-of-day converted to UTC, which orders the times by the instant on the same date.
+func (ot OffsetTime) epochNano() int64 {
+	return ot.time.NanoOfDay() - int64(ot.offset.TotalSeconds())*1000_000_000
+}
+
+// Compare compares this offset time with another.
+// The comparison is based on the instant as if both were on the same date, then on the local time,
+// so 10:00+01:00 is before 10:00Z, and 10:00+01:00 is after 09:00Z although both are the same instant.
+// Returns -1 if this is before other, 0 if equal, 1 if after.
+func (ot OffsetTime) Compare(other OffsetTime) int {
+	return doCompare(ot, other, compareZero, comparing(OffsetTime.epochNano), comparing1(OffsetTime.LocalTime))
+}
+
+// IsBefore returns true if the instant of this time is before that of the other, as if both were on the same date.
+func (ot OffsetTime) IsBefore(other OffsetTime) bool {
+	return doCompare(ot, other, compareZero, comparing(OffsetTime.epochNano)) < 0
+}
+
+// IsAfter returns true if the instant of this time is after that of the other, as if both were on the same date.
+func (ot OffsetTime) IsAfter(other OffsetTime) bool {
+	return doCompare(ot, other, compareZero, comparing(OffsetTime.epochNano)) > 0
+}
+
+// UntilUnit returns the amount of time until another time in terms of the unit, such as the number of complete hours.
+// The end is converted to the offset of this time first, then the local times are compared as in LocalTime.UntilUnit.
+// Returns an error if the unit is unsupported, or zero if either time is zero.
+func (ot OffsetTime) UntilUnit(endExclusive OffsetTime, unit Unit) (int64, error) {
+	if ot.IsZero() || endExclusive.IsZero() {
+		return 0, nil
+	}
+	if !unit.IsTimeBased() {
+		return 0, unsupportedUnit(unit)
+	}
+	return (endExclusive.epochNano() - ot.epochNano()) / unit.nanos(), nil
+}
+
+func (ot OffsetTime) Chain() (chain OffsetTimeChain) {
+	chain.value = ot
+	return
+}
+
+func (ot OffsetTime) chainWithError(e error) (chain OffsetTimeChain) {
+	chain.value = ot
+	chain.eError = e
+	return
+}
+
+// AtOffset combines this time with an offset to create an OffsetTime.
+// Returns zero value for zero value.
+func (t LocalTime) AtOffset(offset ZoneOffset) OffsetTime {
+	if t.IsZero() {
+		return OffsetTime{}
+	}
+	return OffsetTime{time: t, offset: offset}
+}
+
+// ToOffsetTime returns the time part of this date-time with the offset.
+func (odt OffsetDateTime) ToOffsetTime() OffsetTime {
+	return odt.datetime.LocalTime().AtOffset(odt.offset)
+}
+
+// OffsetTimeOf creates a new OffsetTime from individual components.
+// Returns an error if any component is invalid.
+func OffsetTimeOf(hour, minute, second, nanosecond int, offset ZoneOffset) (OffsetTime, error) {
+	t, err := LocalTimeOf(hour, minute, second, nanosecond)
+	if err != nil {
+		return OffsetTime{}, err
+	}
+	return OffsetTime{time: t, offset: offset}, nil
+}
+
+// MustOffsetTimeOf creates a new OffsetTime from individual components.
+// Panics if any component is invalid.
+func MustOffsetTimeOf(hour, minute, second, nanosecond int, offset ZoneOffset) OffsetTime {
+	return mustValue(OffsetTimeOf(hour, minute, second, nanosecond, offset))
+}
+
+// OffsetTimeNow returns the current time with offset in the system's local time zone.
+func OffsetTimeNow() OffsetTime {
+	return OffsetTimeOfGoTime(time.Now())
+}
+
+// OffsetTimeNowUTC returns the current time with offset in UTC.
+func OffsetTimeNowUTC() OffsetTime {
+	return OffsetTimeOfGoTime(time.Now().UTC())
+}
+
+// OffsetTimeNowClock returns the current time of the clock with the offset of the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func OffsetTimeNowClock(clock Clock) OffsetTime {
+	return zonedDateTimeNowClock(clock).ToOffsetDateTime().ToOffsetTime()
+}
+
+// OffsetTimeOfGoTime creates an OffsetTime from the time and the offset of a time.Time.
+// Returns zero value if t.IsZero().
+func OffsetTimeOfGoTime(t time.Time) OffsetTime {
+	return OffsetDateTimeOfGoTime(t).ToOffsetTime()
+}
+
+// OffsetTimeParse parses a time string with offset.
+// The format is HH:mm[:ss[.nnnnnnnnn]]±HH[:mm[:ss]] or with 'Z' for UTC.
+//
+// Examples:
+//
+//	ot, err := OffsetTimeParse("09:00+08:00")
+//	ot, err := OffsetTimeParse("14:30:45.123Z")
+//	ot, err := OffsetTimeParse("14:30:45-07")
+func OffsetTimeParse(s string) (OffsetTime, error) {
+	var ot OffsetTime
+	err := ot.UnmarshalText([]byte(s))
+	return ot, err
+}
+
+// MustOffsetTimeParse parses a time string with offset.
+// Panics if the string is invalid.
+func MustOffsetTimeParse(s string) OffsetTime {
+	return mustValue(OffsetTimeParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*OffsetTime)(nil)
+	_ fmt.Stringer             = (*OffsetTime)(nil)
+	_ encoding.TextMarshaler   = (*OffsetTime)(nil)
+	_ encoding.TextUnmarshaler = (*OffsetTime)(nil)
+	_ json.Marshaler           = (*OffsetTime)(nil)
+	_ json.Unmarshaler         = (*OffsetTime)(nil)
+	_ driver.Valuer            = (*OffsetTime)(nil)
+	_ sql.Scanner              = (*OffsetTime)(nil)
+)
+
+// Compile-time check that OffsetTime is comparable
+func _assertOffsetTimeIsComparable[T comparable](t T) {}
+
+var _ = _assertOffsetTimeIsComparable[OffsetTime]
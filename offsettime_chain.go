@@ -0,0 +1,138 @@
+package goda
+
+type OffsetTimeChain struct {
+	Chain[OffsetTime]
+}
+
+// WithField returns a copy of this time with the field replaced, see LocalTimeChain.WithField.
+// FieldOffsetSeconds replaces the offset, keeping the local time.
+func (o OffsetTimeChain) WithField(field Field, value TemporalValue) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnWithField)
+	newValue := value.Int64()
+	field.checkSetE(newValue, &o.eError)
+	if !o.ok() {
+		return o
+	}
+	if field == FieldOffsetSeconds {
+		o.value.offset.totalSeconds = int32(newValue)
+		return o
+	}
+	o.value.time = o.value.time.chainWithError(o.eError).WithField(field, value).mergeError(&o.eError)
+	return o
+}
+
+// WithOffsetSameLocal returns a copy with the offset replaced, keeping the local time.
+func (o OffsetTimeChain) WithOffsetSameLocal(offset ZoneOffset) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnWithOffsetSameLocal)
+	if !o.ok() {
+		return o
+	}
+	o.value.offset = offset
+	return o
+}
+
+// WithOffsetSameInstant returns a copy with the offset replaced, adjusting the local time to keep the instant,
+// such as 10:30+02:00 with offset +00:00 is 08:30Z.
+func (o OffsetTimeChain) WithOffsetSameInstant(offset ZoneOffset) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnWithOffsetSameInstant)
+	if !o.ok() {
+		return o
+	}
+	var diff = int64(offset.TotalSeconds() - o.value.offset.TotalSeconds())
+	o.value.time = o.value.time.chainWithError(o.eError).PlusSeconds(diff).mergeError(&o.eError)
+	o.value.offset = offset
+	return o
+}
+func (o OffsetTimeChain) PlusHours(hours int64) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnPlusHours)
+	o.value.time = o.value.time.chainWithError(o.eError).PlusHours(hours).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) MinusHours(hours int64) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnMinusHours)
+	o.value.time = o.value.time.chainWithError(o.eError).MinusHours(hours).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) PlusMinutes(minutes int64) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnPlusMinutes)
+	o.value.time = o.value.time.chainWithError(o.eError).PlusMinutes(minutes).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) MinusMinutes(minutes int64) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnMinusMinutes)
+	o.value.time = o.value.time.chainWithError(o.eError).MinusMinutes(minutes).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) PlusSeconds(seconds int64) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnPlusSeconds)
+	o.value.time = o.value.time.chainWithError(o.eError).PlusSeconds(seconds).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) MinusSeconds(seconds int64) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnMinusSeconds)
+	o.value.time = o.value.time.chainWithError(o.eError).MinusSeconds(seconds).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) PlusNanos(nanos int64) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnPlusNanos)
+	o.value.time = o.value.time.chainWithError(o.eError).PlusNanos(nanos).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) MinusNanos(nanos int64) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnMinusNanos)
+	o.value.time = o.value.time.chainWithError(o.eError).MinusNanos(nanos).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) Plus(amount TemporalAmount) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnPlus)
+	o.value.time = o.value.time.chainWithError(o.eError).Plus(amount).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) Minus(amount TemporalAmount) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnMinus)
+	o.value.time = o.value.time.chainWithError(o.eError).Minus(amount).mergeError(&o.eError)
+	return o
+}
+
+// PlusUnit returns a copy of this time with the amount of the unit added, see LocalTimeChain.PlusUnit.
+// The offset is not changed.
+func (o OffsetTimeChain) PlusUnit(amount int64, unit Unit) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnPlusUnit)
+	o.value.time = o.value.time.chainWithError(o.eError).PlusUnit(amount, unit).mergeError(&o.eError)
+	return o
+}
+
+// MinusUnit returns a copy of this time with the amount of the unit subtracted, see PlusUnit.
+func (o OffsetTimeChain) MinusUnit(amount int64, unit Unit) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnMinusUnit)
+	o.value.time = o.value.time.chainWithError(o.eError).MinusUnit(amount, unit).mergeError(&o.eError)
+	return o
+}
+
+// TruncatedTo returns a copy of this time truncated to the unit, see LocalTimeChain.TruncatedTo.
+// The offset is not changed.
+func (o OffsetTimeChain) TruncatedTo(unit Unit) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnTruncatedTo)
+	o.value.time = o.value.time.chainWithError(o.eError).TruncatedTo(unit).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) WithHour(hour int) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnWithHour)
+	o.value.time = o.value.time.chainWithError(o.eError).WithHour(hour).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) WithMinute(minute int) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnWithMinute)
+	o.value.time = o.value.time.chainWithError(o.eError).WithMinute(minute).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) WithSecond(second int) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnWithSecond)
+	o.value.time = o.value.time.chainWithError(o.eError).WithSecond(second).mergeError(&o.eError)
+	return o
+}
+func (o OffsetTimeChain) WithNano(nanoOfSecond int) OffsetTimeChain {
+	defer o.leaveFunction(tyOffsetTime, fnWithNano)
+	o.value.time = o.value.time.chainWithError(o.eError).WithNano(nanoOfSecond).mergeError(&o.eError)
+	return o
+}
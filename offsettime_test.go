@@ -0,0 +1,211 @@
+package goda
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOffsetTimeOf(t *testing.T) {
+	var offset = MustZoneOffsetOfHours(8)
+	ot, err := OffsetTimeOf(9, 30, 15, 123000000, offset)
+	require.NoError(t, err)
+	assert.Equal(t, 9, ot.Hour())
+	assert.Equal(t, 30, ot.Minute())
+	assert.Equal(t, 15, ot.Second())
+	assert.Equal(t, 123, ot.Millisecond())
+	assert.Equal(t, 123000000, ot.Nanosecond())
+	assert.Equal(t, offset, ot.Offset())
+	assert.Equal(t, MustLocalTimeOf(9, 30, 15, 123000000), ot.LocalTime())
+	assert.Equal(t, "09:30:15.123+08:00", ot.String())
+
+	_, err = OffsetTimeOf(24, 0, 0, 0, offset)
+	assert.Error(t, err)
+	assert.Panics(t, func() { MustOffsetTimeOf(0, 60, 0, 0, offset) })
+
+	// midnight in UTC isn't the zero value
+	assert.False(t, MustOffsetTimeOf(0, 0, 0, 0, ZoneOffsetUTC()).IsZero())
+	assert.True(t, OffsetTime{}.IsZero())
+	assert.True(t, LocalTime{}.AtOffset(offset).IsZero())
+	assert.Equal(t, ot, ot.LocalTime().AtOffset(offset))
+}
+
+func TestOffsetTimeOfGoTime(t *testing.T) {
+	var goTime = time.Date(2024, 3, 15, 14, 30, 45, 5, time.FixedZone("", -7*3600))
+	ot := OffsetTimeOfGoTime(goTime)
+	assert.Equal(t, "14:30:45.000000005-07:00", ot.String())
+	assert.True(t, OffsetTimeOfGoTime(time.Time{}).IsZero())
+
+	var back = ot.GoTime()
+	assert.Equal(t, 1970, back.Year())
+	assert.Equal(t, 14, back.Hour())
+	_, offset := back.Zone()
+	assert.Equal(t, -7*3600, offset)
+	assert.True(t, OffsetTime{}.GoTime().IsZero())
+
+	assert.False(t, OffsetTimeNow().IsZero())
+	assert.Equal(t, ZoneOffsetUTC(), OffsetTimeNowUTC().Offset())
+}
+
+func TestOffsetTime_Fields(t *testing.T) {
+	var ot = MustOffsetTimeParse("09:00+08:00")
+	assert.True(t, ot.IsSupportedField(FieldOffsetSeconds))
+	assert.True(t, ot.IsSupportedField(FieldHourOfDay))
+	assert.False(t, ot.IsSupportedField(FieldDayOfMonth))
+	assert.False(t, ot.IsSupportedField(FieldInstantSeconds))
+	assert.Equal(t, int64(8*3600), ot.GetField(FieldOffsetSeconds).Int64())
+	assert.Equal(t, int64(9), ot.GetField(FieldHourOfDay).Int64())
+	assert.Equal(t, int64(9*60), ot.GetField(FieldMinuteOfDay).Int64())
+	assert.True(t, ot.GetField(FieldDayOfMonth).Unsupported())
+	assert.True(t, OffsetTime{}.GetField(FieldHourOfDay).Unsupported())
+}
+
+func TestOffsetTime_Compare(t *testing.T) {
+	var a = MustOffsetTimeParse("10:00+01:00") // 09:00Z
+	var b = MustOffsetTimeParse("09:00Z")
+	var c = MustOffsetTimeParse("10:00Z")
+	assert.False(t, a.IsBefore(b))
+	assert.False(t, a.IsAfter(b))
+	// the same instant is ordered by the local time
+	assert.Equal(t, 1, a.Compare(b))
+	assert.Equal(t, -1, b.Compare(a))
+	assert.Equal(t, -1, a.Compare(c))
+	assert.True(t, a.IsBefore(c))
+	assert.True(t, c.IsAfter(a))
+	assert.Equal(t, 0, a.Compare(a))
+	assert.Equal(t, -1, OffsetTime{}.Compare(a))
+	assert.Equal(t, 1, a.Compare(OffsetTime{}))
+}
+
+func TestOffsetTime_AtDate(t *testing.T) {
+	var ot = MustOffsetTimeParse("09:00+08:00")
+	var odt = ot.AtDate(MustLocalDateOf(2024, March, 15))
+	assert.Equal(t, "2024-03-15T09:00:00+08:00", odt.String())
+	assert.Equal(t, ot, odt.ToOffsetTime())
+	assert.True(t, ot.AtDate(LocalDate{}).IsZero())
+	assert.True(t, OffsetTime{}.AtDate(MustLocalDateOf(2024, March, 15)).IsZero())
+}
+
+func TestOffsetTime_UntilUnit(t *testing.T) {
+	var start = MustOffsetTimeParse("09:00+08:00")
+	var end = MustOffsetTimeParse("03:30Z") // 11:30+08:00
+	n, err := start.UntilUnit(end, UnitMinutes)
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), n)
+	n, err = UnitHours.Between(end, start)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), n)
+	_, err = start.UntilUnit(end, UnitDays)
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestOffsetTimeChain(t *testing.T) {
+	var ot = MustOffsetTimeParse("23:30+02:00")
+	assert.Equal(t, "01:00:00+02:00", ot.Chain().PlusMinutes(90).MustGet().String())
+	assert.Equal(t, "22:00:00+02:00", ot.Chain().MinusUnit(90, UnitMinutes).MustGet().String())
+	assert.Equal(t, "23:00:00+02:00", ot.Chain().TruncatedTo(UnitHours).MustGet().String())
+	assert.Equal(t, "23:30:00+02:00", ot.Chain().Plus(MustDurationOfSeconds(86400, 0)).MustGet().String())
+	assert.Equal(t, "08:30:00+02:00", ot.Chain().WithHour(8).MustGet().String())
+	assert.Equal(t, "23:30:00-05:00", ot.Chain().WithOffsetSameLocal(MustZoneOffsetOfHours(-5)).MustGet().String())
+	assert.Equal(t, "21:30:00Z", ot.Chain().WithOffsetSameInstant(ZoneOffsetUTC()).MustGet().String())
+	assert.Equal(t, "04:30:00+07:00", ot.Chain().WithOffsetSameInstant(MustZoneOffsetOfHours(7)).MustGet().String())
+	assert.Equal(t, "23:30:00+03:00", ot.Chain().WithField(FieldOffsetSeconds, TemporalValueOf(3*3600)).MustGet().String())
+	assert.Equal(t, "23:15:00+02:00", ot.Chain().WithField(FieldMinuteOfHour, TemporalValueOf(15)).MustGet().String())
+
+	_, err := ot.Chain().WithField(FieldDayOfMonth, TemporalValueOf(1)).GetResult()
+	assert.ErrorIs(t, err, ErrUnsupported)
+	_, err = ot.Chain().WithField(FieldOffsetSeconds, TemporalValueOf(19*3600)).GetResult()
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	_, err = ot.Chain().Plus(PeriodOfDays(1)).GetResult()
+	assert.ErrorIs(t, err, ErrUnsupported)
+	assert.True(t, OffsetTime{}.Chain().PlusHours(1).MustGet().IsZero())
+}
+
+func TestOffsetTime_Text(t *testing.T) {
+	for _, it := range []struct {
+		text     string
+		expected string
+	}{
+		{"09:00+08:00", "09:00:00+08:00"},
+		{"09:00:00+08", "09:00:00+08:00"},
+		{"14:30:45.123456-07", "14:30:45.123456-07:00"},
+		{"14:30:45.5+05:30", "14:30:45.500+05:30"},
+		{"00:00:00Z", "00:00:00Z"},
+		{"00:00:00+00", "00:00:00Z"},
+		{"23:59:59.999999999-12:00", "23:59:59.999999999-12:00"},
+	} {
+		ot, err := OffsetTimeParse(it.text)
+		require.NoError(t, err, it.text)
+		assert.Equal(t, it.expected, ot.String(), it.text)
+	}
+	for _, text := range []string{"09:00", "+08:00", "9:00+08:00", "25:00+08:00", "09:00+19:00", "09:00+08:00x"} {
+		_, err := OffsetTimeParse(text)
+		assert.Error(t, err, text)
+	}
+	ot, err := OffsetTimeParse("")
+	require.NoError(t, err)
+	assert.True(t, ot.IsZero())
+	assert.Equal(t, "", ot.String())
+}
+
+func TestOffsetTime_JSON(t *testing.T) {
+	type shop struct {
+		Opens  OffsetTime `json:"opens"`
+		Closes OffsetTime `json:"closes"`
+	}
+	data, err := json.Marshal(shop{Opens: MustOffsetTimeParse("09:00+08:00")})
+	require.NoError(t, err)
+	assert.Equal(t, `{"opens":"09:00:00+08:00","closes":""}`, string(data))
+
+	var s shop
+	require.NoError(t, json.Unmarshal([]byte(`{"opens":"09:00:00+08:00","closes":null}`), &s))
+	assert.Equal(t, MustOffsetTimeParse("09:00+08:00"), s.Opens)
+	assert.True(t, s.Closes.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`{"opens":"09:00"}`), &s))
+}
+
+func TestOffsetTime_Scan(t *testing.T) {
+	var ot OffsetTime
+	require.NoError(t, ot.Scan("09:00:00+08"))
+	assert.Equal(t, MustOffsetTimeParse("09:00+08:00"), ot)
+	require.NoError(t, ot.Scan([]byte("14:30:45.123456-07")))
+	assert.Equal(t, "14:30:45.123456-07:00", ot.String())
+	require.NoError(t, ot.Scan(time.Date(0, 1, 1, 9, 0, 0, 0, time.FixedZone("", 3600))))
+	assert.Equal(t, "09:00:00+01:00", ot.String())
+	require.NoError(t, ot.Scan(nil))
+	assert.True(t, ot.IsZero())
+	assert.Error(t, ot.Scan(1))
+
+	v, err := MustOffsetTimeParse("09:00+08:00").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "09:00:00+08:00", v)
+	v, err = OffsetTime{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestOffsetTime_ValuePostgres(t *testing.T) {
+	var pg = GetPG(t)
+
+	t.Run("timetz", func(t *testing.T) {
+		expected := MustOffsetTimeParse("09:00:00.123456+08:00")
+		var actual OffsetTime
+		var expectedTrue bool
+		err := pg.QueryRow("SELECT $1::timetz, $1::timetz = '09:00:00.123456+08'::timetz", expected).Scan(&actual, &expectedTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+		assert.True(t, expectedTrue)
+	})
+
+	t.Run("null_value", func(t *testing.T) {
+		var actual OffsetTime
+		var expectedTrue bool
+		var e = pg.QueryRow("SELECT NULL::timetz, $1::timetz is null", actual).Scan(&actual, &expectedTrue)
+		assert.NoError(t, e)
+		assert.True(t, actual.IsZero())
+		assert.True(t, expectedTrue)
+	})
+}
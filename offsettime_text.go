@@ -0,0 +1,109 @@
+package goda
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+)
+
+// String returns the ISO 8601 string representation (HH:mm:ss[.nnnnnnnnn]±HH:mm).
+func (ot OffsetTime) String() string {
+	return stringImpl(ot)
+}
+
+// AppendText implements encoding.TextAppender.
+func (ot OffsetTime) AppendText(b []byte) ([]byte, error) {
+	if ot.IsZero() {
+		return b, nil
+	}
+	b, _ = ot.time.AppendText(b)
+	b, _ = ot.offset.AppendText(b)
+	return b, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (ot OffsetTime) MarshalText() ([]byte, error) {
+	return marshalTextImpl(ot)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Accepts ISO 8601 format: HH:mm[:ss[.nnnnnnnnn]]±HH[:mm[:ss]] or Z for UTC,
+// which includes the output of PostgreSQL's timetz, such as "09:00:00+08".
+func (ot *OffsetTime) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*ot = OffsetTime{}
+		return nil
+	}
+
+	// Find the offset part (starts with +, -, or Z)
+	offsetIdx := -1
+	for i := len(text) - 1; i >= 0; i-- {
+		ch := text[i]
+		if ch == '+' || ch == '-' || ch == 'Z' || ch == 'z' {
+			offsetIdx = i
+			break
+		}
+	}
+
+	if offsetIdx < 0 {
+		return errors.New("invalid offset time format: missing offset")
+	}
+
+	var t LocalTime
+	if err := t.UnmarshalText(text[:offsetIdx]); err != nil {
+		return err
+	}
+	if t.IsZero() {
+		return errors.New("invalid offset time format: missing time")
+	}
+
+	var offset ZoneOffset
+	if err := offset.UnmarshalText(text[offsetIdx:]); err != nil {
+		return err
+	}
+
+	*ot = OffsetTime{time: t, offset: offset}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (ot OffsetTime) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(ot)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (ot *OffsetTime) UnmarshalJSON(data []byte) error {
+	if len(data) == 4 && string(data) == "null" {
+		*ot = OffsetTime{}
+		return nil
+	}
+	return unmarshalJsonImpl(ot, data)
+}
+
+// Scan implements sql.Scanner.
+// It accepts the text of PostgreSQL's timetz, such as "09:00:00+08", and time.Time values.
+func (ot *OffsetTime) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*ot = OffsetTime{}
+		return nil
+	case string:
+		return ot.UnmarshalText([]byte(v))
+	case []byte:
+		return ot.UnmarshalText(v)
+	case time.Time:
+		*ot = OffsetTimeOfGoTime(v)
+		return nil
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+// Value implements driver.Valuer.
+func (ot OffsetTime) Value() (driver.Value, error) {
+	if ot.IsZero() {
+		return nil, nil
+	}
+	return ot.String(), nil
+}
@@ -0,0 +1,164 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Period represents a date-based amount of time, such as 1 year 2 months 3 days.
+// It is the date-based counterpart of Duration.
+//
+// The years, months and days are stored separately and are not normalized automatically,
+// so P14M and P1Y2M are different periods. Use PeriodChain.Normalized to fold months into years.
+// Each part may be negative independently.
+//
+// When a Period is added to a date, the years and months are added first as a total number of months,
+// clamping the day-of-month to the end of the month as PlusMonths does, then the days are added.
+//
+// Period is comparable and can be used as a map key.
+// The zero value represents an unset period and IsZero returns true for it.
+// Note: a period of zero length (P0D, see PeriodZero) is a valid period and is different from the zero value.
+//
+// Period implements sql.Scanner and driver.Valuer for database operations,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: ISO 8601 PnYnMnD (e.g., "P1Y2M3D"), matching Java's Period#toString.
+// When parsing, a weeks part (e.g., "P2W") and a leading sign are also accepted.
+// When scanning from a database, PostgreSQL interval output (e.g., "1 year 2 mons 3 days") is also accepted.
+type Period struct {
+	years  int64
+	months int64
+	days   int64
+	valid  bool
+}
+
+// Years returns the amount of years of this period.
+func (p Period) Years() int64 {
+	return p.years
+}
+
+// Months returns the amount of months of this period.
+func (p Period) Months() int64 {
+	return p.months
+}
+
+// Days returns the amount of days of this period.
+func (p Period) Days() int64 {
+	return p.days
+}
+
+// IsZero returns true if this is the zero value of Period.
+// Use PeriodZero for comparison to test for a zero-length period.
+func (p Period) IsZero() bool {
+	return !p.valid
+}
+
+// IsNegative returns true if any of the years, months or days is negative.
+func (p Period) IsNegative() bool {
+	return p.years < 0 || p.months < 0 || p.days < 0
+}
+
+// ToTotalMonths returns the total number of months, years × 12 + months.
+// If the result cannot be represented as int64, it is clamped to math.MinInt64 or math.MaxInt64.
+func (p Period) ToTotalMonths() int64 {
+	r, overflow := p.totalMonths()
+	if overflow {
+		if p.years < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return r
+}
+
+func (p Period) totalMonths() (r int64, overflow bool) {
+	r, overflow = mulExact(p.years, 12)
+	if !overflow {
+		r, overflow = addExactly(r, p.months)
+	}
+	return
+}
+
+func (p Period) Chain() (chain PeriodChain) {
+	chain.value = p
+	return
+}
+
+func (p Period) chainWithError(e error) (chain PeriodChain) {
+	chain = p.Chain()
+	chain.eError = e
+	return
+}
+
+// PeriodOf creates a Period from the amount of years, months and days.
+func PeriodOf(years, months, days int64) Period {
+	return Period{years: years, months: months, days: days, valid: true}
+}
+
+// PeriodOfYears creates a Period of the specified number of years.
+func PeriodOfYears(years int64) Period {
+	return PeriodOf(years, 0, 0)
+}
+
+// PeriodOfMonths creates a Period of the specified number of months.
+func PeriodOfMonths(months int64) Period {
+	return PeriodOf(0, months, 0)
+}
+
+// PeriodOfDays creates a Period of the specified number of days.
+func PeriodOfDays(days int64) Period {
+	return PeriodOf(0, 0, days)
+}
+
+// PeriodZero returns a period of zero length (P0D).
+func PeriodZero() Period {
+	return Period{valid: true}
+}
+
+// PeriodBetween returns the period between two dates, see LocalDate.Until.
+func PeriodBetween(startInclusive, endExclusive LocalDate) Period {
+	return startInclusive.Until(endExclusive)
+}
+
+// PeriodParse parses a period string in ISO 8601 PnYnMnWnD format, such as "P1Y2M3D".
+// The format follows Java's Period#parse:
+//   - An optional leading sign negates the whole period, e.g. "-P1Y2M".
+//   - Each part may have its own sign, e.g. "P1Y-2M".
+//   - Weeks are converted to days.
+//   - Letters are case-insensitive.
+//
+// Returns an error if the string is invalid or the result overflows.
+func PeriodParse(s string) (Period, error) {
+	var p Period
+	err := p.UnmarshalText([]byte(s))
+	return p, err
+}
+
+// MustPeriodParse parses a period string in ISO 8601 PnYnMnWnD format.
+// Panics if the string is invalid. Use PeriodParse for error handling.
+func MustPeriodParse(s string) Period {
+	return mustValue(PeriodParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*Period)(nil)
+	_ fmt.Stringer             = (*Period)(nil)
+	_ encoding.TextMarshaler   = (*Period)(nil)
+	_ encoding.TextUnmarshaler = (*Period)(nil)
+	_ json.Marshaler           = (*Period)(nil)
+	_ json.Unmarshaler         = (*Period)(nil)
+	_ driver.Valuer            = (*Period)(nil)
+	_ sql.Scanner              = (*Period)(nil)
+	_ TemporalAmount           = (*Period)(nil)
+)
+
+// Compile-time check that Period is comparable
+func _assertPeriodIsComparable[T comparable](t T) {}
+
+var _ = _assertPeriodIsComparable[Period]
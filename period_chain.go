@@ -0,0 +1,187 @@
+package goda
+
+import "math"
+
+type PeriodChain struct {
+	Chain[Period]
+}
+
+func (p PeriodChain) PlusYears(years int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnPlusYears)
+	return p.plus(years, 0, 0)
+}
+
+func (p PeriodChain) MinusYears(years int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnMinusYears)
+	if years == math.MinInt64 {
+		return p.PlusYears(math.MaxInt64).PlusYears(1)
+	}
+	return p.PlusYears(-years)
+}
+
+func (p PeriodChain) PlusMonths(months int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnPlusMonths)
+	return p.plus(0, months, 0)
+}
+
+func (p PeriodChain) MinusMonths(months int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnMinusMonths)
+	if months == math.MinInt64 {
+		return p.PlusMonths(math.MaxInt64).PlusMonths(1)
+	}
+	return p.PlusMonths(-months)
+}
+
+func (p PeriodChain) PlusDays(days int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnPlusDays)
+	return p.plus(0, 0, days)
+}
+
+func (p PeriodChain) MinusDays(days int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnMinusDays)
+	if days == math.MinInt64 {
+		return p.PlusDays(math.MaxInt64).PlusDays(1)
+	}
+	return p.PlusDays(-days)
+}
+
+// PlusUnit returns a copy of this period with the amount of the unit added, such as 2 UnitWeeks.
+// UnitDays and UnitWeeks are added to the days, the month-based units from UnitMonths to UnitMillennia
+// are added to the months or the years. Other units are unsupported and return an error wrapping ErrUnsupported.
+func (p PeriodChain) PlusUnit(amount int64, unit Unit) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnPlusUnit)
+	if !p.ok() {
+		return p
+	}
+	switch unit {
+	case UnitDays:
+		return p.PlusDays(amount)
+	case UnitWeeks:
+		days, overflow := mulExact(amount, 7)
+		if overflow {
+			p.eError = overflowError()
+			return p
+		}
+		return p.PlusDays(days)
+	case UnitMonths:
+		return p.PlusMonths(amount)
+	case UnitYears, UnitDecades, UnitCenturies, UnitMillennia:
+		years, overflow := mulExact(amount, unit.months()/12)
+		if overflow {
+			p.eError = overflowError()
+			return p
+		}
+		return p.PlusYears(years)
+	}
+	p.eError = unsupportedUnit(unit)
+	return p
+}
+
+// MinusUnit returns a copy of this period with the amount of the unit subtracted, see PlusUnit.
+func (p PeriodChain) MinusUnit(amount int64, unit Unit) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnMinusUnit)
+	if amount == math.MinInt64 {
+		return p.PlusUnit(math.MaxInt64, unit).PlusUnit(1, unit)
+	}
+	return p.PlusUnit(-amount, unit)
+}
+
+// Plus returns a copy of this period with the specified period added, part by part.
+// A zero value period is treated as zero length.
+func (p PeriodChain) Plus(period Period) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnPlus)
+	return p.plus(period.years, period.months, period.days)
+}
+
+// Minus returns a copy of this period with the specified period subtracted, part by part.
+// A zero value period is treated as zero length.
+func (p PeriodChain) Minus(period Period) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnMinus)
+	return p.MinusYears(period.years).MinusMonths(period.months).MinusDays(period.days)
+}
+
+func (p PeriodChain) plus(years, months, days int64) PeriodChain {
+	if !p.ok() {
+		return p
+	}
+	var o1, o2, o3 bool
+	p.value.years, o1 = addExactly(p.value.years, years)
+	p.value.months, o2 = addExactly(p.value.months, months)
+	p.value.days, o3 = addExactly(p.value.days, days)
+	if o1 || o2 || o3 {
+		p.eError = overflowError()
+	}
+	return p
+}
+
+// MultipliedBy returns a copy of this period with each part multiplied by the scalar.
+// Returns an error if the result overflows.
+func (p PeriodChain) MultipliedBy(scalar int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnMultipliedBy)
+	if !p.ok() {
+		return p
+	}
+	var o1, o2, o3 bool
+	p.value.years, o1 = mulExact(p.value.years, scalar)
+	p.value.months, o2 = mulExact(p.value.months, scalar)
+	p.value.days, o3 = mulExact(p.value.days, scalar)
+	if o1 || o2 || o3 {
+		p.eError = overflowError()
+	}
+	return p
+}
+
+// Negated returns a copy of this period with each part negated.
+// Returns an error if a part is math.MinInt64.
+func (p PeriodChain) Negated() PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnNegated)
+	return p.MultipliedBy(-1)
+}
+
+// Normalized returns a copy of this period with the years and months normalized,
+// so that the months are within the range -11 to 11 and have the same sign as the years.
+// For example, P1Y15M is normalized to P2Y3M, and P1Y-25M to P-1Y-1M.
+// The days are not changed, as the number of days in a month varies.
+//
+// Returns an error if the total number of months overflows.
+func (p PeriodChain) Normalized() PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnNormalized)
+	if !p.ok() {
+		return p
+	}
+	totalMonths, overflow := p.value.totalMonths()
+	if overflow {
+		p.eError = overflowError()
+		return p
+	}
+	p.value.years = totalMonths / 12
+	p.value.months = totalMonths % 12
+	return p
+}
+
+// WithYears returns a copy of this period with the specified amount of years.
+func (p PeriodChain) WithYears(years int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnWithYears)
+	if p.ok() {
+		p.value.years = years
+	}
+	return p
+}
+
+// WithMonths returns a copy of this period with the specified amount of months.
+func (p PeriodChain) WithMonths(months int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnWithMonths)
+	if p.ok() {
+		p.value.months = months
+	}
+	return p
+}
+
+// WithDays returns a copy of this period with the specified amount of days.
+func (p PeriodChain) WithDays(days int64) PeriodChain {
+	defer p.leaveFunction(tyPeriod, fnWithDays)
+	if p.ok() {
+		p.value.days = days
+	}
+	return p
+}
@@ -0,0 +1,237 @@
+package goda
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodOf(t *testing.T) {
+	p := PeriodOf(1, 2, 3)
+	assert.Equal(t, int64(1), p.Years())
+	assert.Equal(t, int64(2), p.Months())
+	assert.Equal(t, int64(3), p.Days())
+	assert.Equal(t, int64(14), p.ToTotalMonths())
+	assert.False(t, p.IsNegative())
+	assert.True(t, PeriodOf(1, -1, 0).IsNegative())
+
+	assert.Equal(t, PeriodOf(2, 0, 0), PeriodOfYears(2))
+	assert.Equal(t, PeriodOf(0, 2, 0), PeriodOfMonths(2))
+	assert.Equal(t, PeriodOf(0, 0, 2), PeriodOfDays(2))
+	assert.False(t, PeriodZero().IsZero())
+	assert.True(t, Period{}.IsZero())
+
+	assert.Equal(t, int64(math.MaxInt64), PeriodOf(math.MaxInt64, 0, 0).ToTotalMonths())
+	assert.Equal(t, int64(math.MinInt64), PeriodOf(math.MinInt64, 0, 0).ToTotalMonths())
+}
+
+func TestLocalDate_Until(t *testing.T) {
+	for _, it := range [][3]string{
+		{"2024-01-15", "2024-01-15", "P0D"},
+		{"2024-01-15", "2025-03-20", "P1Y2M5D"},
+		{"2024-01-31", "2024-03-01", "P1M1D"},
+		{"2024-01-31", "2024-02-29", "P29D"},
+		{"2024-02-29", "2025-02-28", "P11M30D"},
+		{"2024-03-20", "2024-01-15", "P-2M-5D"},
+		{"2025-03-01", "2024-01-31", "P-1Y-1M-1D"},
+		{"2024-03-01", "2024-02-29", "P-1D"},
+	} {
+		start := MustLocalDateParse(it[0])
+		end := MustLocalDateParse(it[1])
+		p := start.Until(end)
+		assert.Equal(t, it[2], p.String(), "%s until %s", it[0], it[1])
+		assert.Equal(t, p, PeriodBetween(start, end))
+		// adding the period to the start gives the end for non-negative periods
+		if !p.IsNegative() {
+			assert.Equal(t, end, start.Chain().Plus(p).MustGet(), "%s + %s", it[0], p)
+		}
+	}
+	assert.True(t, LocalDate{}.Until(MustLocalDateParse("2024-01-15")).IsZero())
+	assert.True(t, MustLocalDateParse("2024-01-15").Until(LocalDate{}).IsZero())
+}
+
+func TestPeriodChain(t *testing.T) {
+	p := PeriodOf(1, 2, 3)
+
+	assert.Equal(t, "P2Y2M3D", p.Chain().PlusYears(1).MustGet().String())
+	assert.Equal(t, "P1Y1M3D", p.Chain().MinusMonths(1).MustGet().String())
+	assert.Equal(t, "P1Y2M", p.Chain().MinusDays(3).MustGet().String())
+	assert.Equal(t, "P2Y4M6D", p.Chain().Plus(p).MustGet().String())
+	assert.Equal(t, "P0D", p.Chain().Minus(p).MustGet().String())
+	assert.Equal(t, "P3Y6M9D", p.Chain().MultipliedBy(3).MustGet().String())
+	assert.Equal(t, "P-1Y-2M-3D", p.Chain().Negated().MustGet().String())
+	assert.Equal(t, "P5Y2M3D", p.Chain().WithYears(5).MustGet().String())
+	assert.Equal(t, "P1Y3D", p.Chain().WithMonths(0).MustGet().String())
+	assert.Equal(t, "P1Y2M9D", p.Chain().WithDays(9).MustGet().String())
+	assert.Equal(t, p, p.Chain().Plus(Period{}).MustGet())
+
+	assert.Equal(t, "P2Y3M", PeriodOf(1, 15, 0).Chain().Normalized().MustGet().String())
+	assert.Equal(t, "P-1Y-1M", PeriodOf(1, -25, 0).Chain().Normalized().MustGet().String())
+	assert.Equal(t, "P1Y-2D", PeriodOf(0, 12, -2).Chain().Normalized().MustGet().String())
+
+	_, err := PeriodOf(math.MaxInt64, 0, 0).Chain().PlusYears(1).GetResult()
+	assert.ErrorIs(t, err, ErrArithmeticOverflow)
+	_, err = PeriodOf(0, 0, math.MinInt64).Chain().Negated().GetResult()
+	assert.ErrorIs(t, err, ErrArithmeticOverflow)
+	_, err = PeriodOf(math.MaxInt64, 0, 0).Chain().Normalized().GetResult()
+	assert.ErrorIs(t, err, ErrArithmeticOverflow)
+	v, err := PeriodZero().Chain().MinusYears(math.MinInt64 + 1).GetResult()
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), v.Years())
+
+	assert.True(t, Period{}.Chain().PlusDays(1).MustGet().IsZero())
+}
+
+func TestPeriod_PlusOnChains(t *testing.T) {
+	p := PeriodOf(1, 1, 1)
+
+	t.Run("LocalDate", func(t *testing.T) {
+		d := MustLocalDateParse("2024-01-31")
+		// total months first, then clamp, then days
+		assert.Equal(t, "2025-03-01", d.Chain().Plus(p).MustGet().String())
+		assert.Equal(t, "2024-03-01", d.Chain().Plus(PeriodOf(0, 1, 1)).MustGet().String())
+		assert.Equal(t, "2025-03-29", MustLocalDateParse("2024-02-29").Chain().Plus(PeriodOf(1, 1, 0)).MustGet().String())
+		assert.Equal(t, "2022-12-30", d.Chain().Minus(p).MustGet().String())
+		assert.Equal(t, d, d.Chain().Plus(DurationZero()).MustGet())
+		assert.Equal(t, d, d.Chain().Plus(nil).MustGet())
+
+		_, err := d.Chain().Plus(DurationOfMillis(1)).GetResult()
+		assert.ErrorIs(t, err, ErrUnsupported)
+		_, err = d.Chain().Plus(PeriodOfYears(math.MaxInt64)).GetResult()
+		assert.ErrorIs(t, err, ErrArithmeticOverflow)
+		_, err = LocalDateMax().Chain().Plus(PeriodOfDays(1)).GetResult()
+		assert.ErrorIs(t, err, ErrArithmeticOverflow)
+	})
+
+	t.Run("LocalDateTime", func(t *testing.T) {
+		ldt := MustLocalDateTimeParse("2024-01-31T10:00")
+		assert.Equal(t, "2025-03-01T10:00:00", ldt.Chain().Plus(p).MustGet().String())
+		assert.Equal(t, "2022-12-30T10:00:00", ldt.Chain().Minus(p).MustGet().String())
+		assert.Equal(t, "2025-03-01T11:00:00", ldt.Chain().Plus(p).Plus(MustDurationParse("PT1H")).MustGet().String())
+	})
+
+	t.Run("OffsetDateTime", func(t *testing.T) {
+		odt := MustOffsetDateTimeParse("2024-01-31T10:00+08:00")
+		assert.Equal(t, "2025-03-01T10:00:00+08:00", odt.Chain().Plus(p).MustGet().String())
+		assert.Equal(t, "2022-12-30T10:00:00+08:00", odt.Chain().Minus(p).MustGet().String())
+	})
+
+	t.Run("ZonedDateTime", func(t *testing.T) {
+		zdt := MustZonedDateTimeParse("2024-03-30T09:00:00+01:00[Europe/Paris]")
+		assert.Equal(t, "2024-03-31T09:00:00+02:00[Europe/Paris]", zdt.Chain().Plus(PeriodOfDays(1)).MustGet().String())
+		assert.Equal(t, "2024-03-31T10:00:00+02:00[Europe/Paris]", zdt.Chain().Plus(MustDurationParse("PT24H")).MustGet().String())
+		assert.Equal(t, zdt, zdt.Chain().Plus(PeriodOfDays(1)).Minus(PeriodOfDays(1)).MustGet())
+	})
+
+	t.Run("LocalTime", func(t *testing.T) {
+		lt := MustLocalTimeParse("10:00")
+		assert.Equal(t, lt, lt.Chain().Plus(PeriodZero()).MustGet())
+		_, err := lt.Chain().Plus(PeriodOfDays(1)).GetResult()
+		assert.ErrorIs(t, err, ErrUnsupported)
+		_, err = lt.Chain().Minus(PeriodOfDays(1)).GetResult()
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+
+	t.Run("Instant", func(t *testing.T) {
+		i := MustInstantParse("2024-03-15T10:00:00Z")
+		assert.Equal(t, "2024-03-17T10:00:00Z", i.Chain().Plus(PeriodOfDays(2)).MustGet().String())
+		assert.Equal(t, "2024-03-13T10:00:00Z", i.Chain().Minus(PeriodOfDays(2)).MustGet().String())
+		_, err := i.Chain().Plus(PeriodOfMonths(1)).GetResult()
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+}
+
+func TestPeriod_Text(t *testing.T) {
+	t.Run("format", func(t *testing.T) {
+		for _, it := range [][2]string{
+			{"P0D", "P0D"},
+			{"P1Y2M3D", "P1Y2M3D"},
+			{"P-1Y2M", "P-1Y2M"},
+			{"-P1Y2M", "P-1Y-2M"},
+			{"+P1Y", "P1Y"},
+			{"P2W", "P14D"},
+			{"P1W1D", "P8D"},
+			{"p1y2m3d", "P1Y2M3D"},
+			{"P14M", "P14M"},
+			{"P0Y0M0D", "P0D"},
+		} {
+			p, err := PeriodParse(it[0])
+			require.NoError(t, err, it[0])
+			assert.Equal(t, it[1], p.String(), it[0])
+		}
+		assert.Equal(t, "", Period{}.String())
+	})
+
+	t.Run("parse errors", func(t *testing.T) {
+		for _, s := range []string{
+			"P", "1Y", "P1", "PY", "P1D1Y", "P1Y1Y", "P1H", "PT1H", "P1.5Y", "P1Y2", "P9999999999999999999Y", "P1317624576693539402W",
+		} {
+			_, err := PeriodParse(s)
+			assert.Error(t, err, s)
+		}
+		p, err := PeriodParse("")
+		require.NoError(t, err)
+		assert.True(t, p.IsZero())
+		assert.Panics(t, func() { MustPeriodParse("invalid") })
+	})
+
+	t.Run("json", func(t *testing.T) {
+		type S struct {
+			P Period `json:"p"`
+		}
+		data, err := json.Marshal(S{P: PeriodOf(1, 2, 3)})
+		require.NoError(t, err)
+		assert.Equal(t, `{"p":"P1Y2M3D"}`, string(data))
+
+		var s S
+		require.NoError(t, json.Unmarshal(data, &s))
+		assert.Equal(t, PeriodOf(1, 2, 3), s.P)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"p":null}`), &s))
+		assert.True(t, s.P.IsZero())
+	})
+}
+
+func TestPeriod_Scan(t *testing.T) {
+	for _, it := range [][2]string{
+		{"P1Y2M3D", "P1Y2M3D"},
+		{"1 year 2 mons 3 days", "P1Y2M3D"},
+		{"-1 years -2 mons +3 days", "P-1Y-2M3D"},
+		{"1 mon", "P1M"},
+		{"00:00:00", "P0D"},
+		{"3 days 00:00:00", "P3D"},
+	} {
+		var p Period
+		require.NoError(t, p.Scan(it[0]), it[0])
+		assert.Equal(t, it[1], p.String(), it[0])
+		require.NoError(t, p.Scan([]byte(it[0])), it[0])
+		assert.Equal(t, it[1], p.String(), it[0])
+	}
+
+	var p Period
+	for _, s := range []string{"1 day 01:00:00", "1 week", "", "P1Y1H"} {
+		assert.Error(t, p.Scan(s), s)
+	}
+	require.NoError(t, p.Scan(nil))
+	assert.True(t, p.IsZero())
+	assert.Error(t, p.Scan(1))
+
+	v, err := PeriodOf(1, 2, 3).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "P1Y2M3D", v)
+	v, err = Period{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestPeriod_ValuePostgres(t *testing.T) {
+	var pg = GetPG(t)
+	expected := PeriodOf(1, 2, -3)
+	var actual Period
+	err := pg.QueryRow("SELECT $1::interval", expected).Scan(&actual)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
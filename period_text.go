@@ -0,0 +1,188 @@
+package goda
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// String returns the ISO 8601 string representation (PnYnMnD), such as "P1Y2M3D".
+func (p Period) String() string {
+	return stringImpl(p)
+}
+
+// AppendText implements encoding.TextAppender.
+// The output matches Java's Period#toString: zero parts are omitted,
+// and a zero-length period is formatted as "P0D".
+func (p Period) AppendText(b []byte) ([]byte, error) {
+	if p.IsZero() {
+		return b, nil
+	}
+	if p.years|p.months|p.days == 0 {
+		return append(b, "P0D"...), nil
+	}
+	b = append(b, 'P')
+	if p.years != 0 {
+		b = strconv.AppendInt(b, p.years, 10)
+		b = append(b, 'Y')
+	}
+	if p.months != 0 {
+		b = strconv.AppendInt(b, p.months, 10)
+		b = append(b, 'M')
+	}
+	if p.days != 0 {
+		b = strconv.AppendInt(b, p.days, 10)
+		b = append(b, 'D')
+	}
+	return b, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Period) MarshalText() ([]byte, error) {
+	return marshalTextImpl(p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Accepts ISO 8601 format: [-+]PnYnMnWnD, see PeriodParse for details.
+// Empty input is treated as zero value.
+func (p *Period) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*p = Period{}
+		return nil
+	}
+	var negate = false
+	var s = text
+	if s[0] == '+' || s[0] == '-' {
+		negate = s[0] == '-'
+		s = s[1:]
+	}
+	if len(s) == 0 || (s[0] != 'P' && s[0] != 'p') {
+		return errors.New("expect 'P'")
+	}
+	s = s[1:]
+	if len(s) == 0 {
+		return errors.New("expect at least one part")
+	}
+	var r = PeriodZero().Chain()
+	// the designators must appear in this order
+	const designators = "ymwd"
+	var next = 0
+	for len(s) > 0 {
+		// [-+]digits designator
+		var i = 0
+		if s[i] == '+' || s[i] == '-' {
+			i++
+		}
+		var digitsStart = i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == digitsStart {
+			return errors.New("expect digits")
+		}
+		amount, err := strconv.ParseInt(string(s[:i]), 10, 64)
+		if err != nil {
+			return err
+		}
+		if i == len(s) {
+			return errors.New("expect designator")
+		}
+		var d = strings.IndexByte(designators[next:], s[i]|0x20)
+		if d < 0 {
+			return errors.New("unexpected designator " + strconv.Quote(string(s[i])))
+		}
+		d += next
+		switch designators[d] {
+		case 'y':
+			r = r.PlusYears(amount)
+		case 'm':
+			r = r.PlusMonths(amount)
+		case 'w':
+			var days, overflow = mulExact(amount, 7)
+			if overflow {
+				return overflowError()
+			}
+			r = r.PlusDays(days)
+		case 'd':
+			r = r.PlusDays(amount)
+		}
+		next = d + 1
+		s = s[i+1:]
+	}
+	if negate {
+		r = r.Negated()
+	}
+	*p, e = r.GetResult()
+	return
+}
+
+// unmarshalPostgresInterval parses PostgreSQL interval output, see parsePostgresInterval.
+// Intervals with a non-zero time part cannot be represented as a Period and are rejected.
+func (p *Period) unmarshalPostgresInterval(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	var iv postgresInterval
+	if iv, e = parsePostgresInterval(text); e != nil {
+		return
+	}
+	if iv.seconds != 0 || iv.nanos != 0 {
+		return errors.New("interval with a time part cannot be converted to a Period")
+	}
+	*p = PeriodOf(iv.years, iv.months, iv.days)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p Period) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if len(data) == 4 && string(data) == "null" {
+		*p = Period{}
+		return nil
+	}
+	return unmarshalJsonImpl(p, data)
+}
+
+// Scan implements sql.Scanner.
+// It supports scanning from nil, string, and []byte.
+// Text in ISO 8601 format and PostgreSQL interval output in the default "postgres" style are accepted,
+// intervals with a non-zero time part are rejected.
+// Nil values are converted to the zero value of Period.
+func (p *Period) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*p = Period{}
+		return nil
+	case string:
+		return p.scanText([]byte(v))
+	case []byte:
+		return p.scanText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+func (p *Period) scanText(text []byte) error {
+	var s = text
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	if len(s) > 0 && (s[0] == 'P' || s[0] == 'p') {
+		return p.UnmarshalText(text)
+	}
+	return p.unmarshalPostgresInterval(text)
+}
+
+// Value implements driver.Valuer.
+// It returns nil for zero values, otherwise returns the period in ISO 8601 format,
+// which PostgreSQL accepts as interval input.
+func (p Period) Value() (driver.Value, error) {
+	if p.IsZero() {
+		return nil, nil
+	}
+	return p.String(), nil
+}
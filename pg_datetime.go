@@ -0,0 +1,112 @@
+package goda
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"errors"
+)
+
+// PostgresValue wraps a LocalDate, LocalDateTime or OffsetDateTime, so Value produces the PostgreSQL forms
+// of the values that ISO 8601 can't express in the same way:
+// the min and max values, such as LocalDateMin and LocalDateMax, become "-infinity" and "infinity",
+// and the years before 1 AD are written with the BC era, such as "0044-03-15 BC" for the year -43.
+//
+// The Value methods of the types themselves keep the ISO 8601 form other databases such as MySQL expect,
+// while their Scan methods accept the PostgreSQL forms as well.
+// With the native pgx interface, the pgxgoda package handles infinity and BC dates per connection instead.
+//
+// The zero value wraps the zero value of T, which is NULL in SQL.
+type PostgresValue[T LocalDate | LocalDateTime | OffsetDateTime] struct {
+	V T
+}
+
+// PostgresValueOf wraps the value, such as for a query argument.
+func PostgresValueOf[T LocalDate | LocalDateTime | OffsetDateTime](v T) PostgresValue[T] {
+	return PostgresValue[T]{V: v}
+}
+
+// Value implements driver.Valuer.
+// It returns nil for zero values, "infinity" and "-infinity" for the max and min values,
+// otherwise the ISO 8601 text of the value with the BC era if the year isn't positive.
+func (p PostgresValue[T]) Value() (driver.Value, error) {
+	switch v := any(p.V).(type) {
+	case LocalDate:
+		return postgresValue(v, v.IsZero(), v == LocalDateMax(), v == LocalDateMin(), v.Year()), nil
+	case LocalDateTime:
+		return postgresValue(v, v.IsZero(), v == LocalDateTimeMax(), v == LocalDateTimeMin(), v.Year()), nil
+	case OffsetDateTime:
+		return postgresValue(v, v.IsZero(), v == OffsetDateTimeMax(), v == OffsetDateTimeMin(), v.Year()), nil
+	default:
+		panic("unreachable")
+	}
+}
+
+// Scan implements sql.Scanner, same as the Scan method of the wrapped type.
+func (p *PostgresValue[T]) Scan(src any) error {
+	return any(&p.V).(sql.Scanner).Scan(src)
+}
+
+// postgresInfinity returns 1 for the PostgreSQL "infinity", -1 for "-infinity", otherwise 0.
+func postgresInfinity(text []byte) int {
+	switch string(text) {
+	case "infinity":
+		return 1
+	case "-infinity":
+		return -1
+	default:
+		return 0
+	}
+}
+
+// postgresEraToISO turns PostgreSQL text with the BC era, such as "0044-03-15 10:00:00 BC",
+// into the ISO 8601 form with a non-positive year, such as "-0043-03-15 10:00:00".
+// Text without the era is returned as is.
+func postgresEraToISO(text []byte) ([]byte, error) {
+	body, ok := bytes.CutSuffix(text, []byte(" BC"))
+	if !ok {
+		return text, nil
+	}
+	var idx = bytes.IndexByte(body, '-')
+	if idx <= 0 {
+		return nil, errors.New("'-' required")
+	}
+	y, e := parseInt64(body[:idx])
+	if e != nil {
+		return nil, e
+	}
+	if y < 1 {
+		return nil, errors.New("year of era must be positive")
+	}
+	r, _ := Year(1 - y).AppendText(nil)
+	return append(r, body[idx:]...), nil
+}
+
+// postgresValue returns the PostgreSQL text of a value whose date is in the year,
+// with the BC era if the year isn't positive.
+func postgresValue(v encoding.TextAppender, zero, max, min bool, year Year) driver.Value {
+	switch {
+	case zero:
+		return nil
+	case max:
+		return "infinity"
+	case min:
+		return "-infinity"
+	}
+	b, _ := v.AppendText(nil)
+	if year > 0 {
+		return string(b)
+	}
+	yearText, _ := year.AppendText(nil)
+	r, _ := Year(1 - year).AppendText(nil)
+	r = append(r, b[len(yearText):]...)
+	return string(append(r, " BC"...))
+}
+
+// Compile-time interface checks
+var (
+	_ driver.Valuer = PostgresValue[LocalDate]{}
+	_ sql.Scanner   = (*PostgresValue[LocalDateTime])(nil)
+	_ driver.Valuer = PostgresValue[OffsetDateTime]{}
+)
@@ -0,0 +1,112 @@
+package goda
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+)
+
+// postgresInterval holds the parts of a PostgreSQL interval value.
+// Years, months and days are kept separately since PostgreSQL does not normalize them into each other.
+type postgresInterval struct {
+	years, months, days int64
+	seconds, nanos      int64
+}
+
+// parsePostgresInterval parses PostgreSQL interval output in the default "postgres" IntervalStyle,
+// such as "1 year 2 mons 3 days 04:05:06.5", "-1 days +02:00:00" or "-00:00:00.5".
+func parsePostgresInterval(text []byte) (r postgresInterval, e error) {
+	var fields = bytes.Fields(text)
+	if len(fields) == 0 {
+		return r, errors.New("empty interval")
+	}
+	for i := 0; i < len(fields); i++ {
+		var f = fields[i]
+		if bytes.IndexByte(f, ':') >= 0 {
+			var seconds, nanos int64
+			if seconds, nanos, e = parsePostgresIntervalTime(f); e != nil {
+				return
+			}
+			r.seconds += seconds
+			r.nanos += nanos
+			continue
+		}
+		amount, err := strconv.ParseInt(string(f), 10, 64)
+		if err != nil {
+			return r, err
+		}
+		i++
+		if i == len(fields) {
+			return r, errors.New("expect interval unit")
+		}
+		var overflow bool
+		switch string(fields[i]) {
+		case "year", "years":
+			r.years, overflow = addExactly(r.years, amount)
+		case "mon", "mons":
+			r.months, overflow = addExactly(r.months, amount)
+		case "day", "days":
+			r.days, overflow = addExactly(r.days, amount)
+		default:
+			return r, errors.New("unexpected interval unit " + strconv.Quote(string(fields[i])))
+		}
+		if overflow {
+			return r, overflowError()
+		}
+	}
+	return
+}
+
+// parsePostgresIntervalTime parses [-+]H:MM[:SS[.fraction]], the hours may exceed 24.
+func parsePostgresIntervalTime(f []byte) (seconds, nanos int64, e error) {
+	var sign int64 = 1
+	if f[0] == '+' || f[0] == '-' {
+		if f[0] == '-' {
+			sign = -1
+		}
+		f = f[1:]
+	}
+	var parts = bytes.Split(f, []byte{':'})
+	if len(parts) > 3 {
+		return 0, 0, errors.New("invalid interval time")
+	}
+	if len(parts) == 3 {
+		var sec = parts[2]
+		if idx := bytes.IndexByte(sec, '.'); idx >= 0 {
+			if len(sec)-idx-1 > 9 {
+				return 0, 0, errors.New("fraction too long")
+			}
+			for _, c := range sec[idx+1:] {
+				if c < '0' || c > '9' {
+					return 0, 0, errors.New("invalid fraction")
+				}
+			}
+			nanos = parseFraction(sec[idx+1:])
+			parts[2] = sec[:idx]
+		}
+	}
+	for i, p := range parts {
+		v, err := strconv.ParseUint(string(p), 10, 63)
+		if err != nil {
+			return 0, 0, err
+		}
+		if i > 0 && (len(p) != 2 || v > 59) {
+			return 0, 0, errors.New("invalid interval time")
+		}
+		var unit int64 = 3600
+		if i == 1 {
+			unit = 60
+		} else if i == 2 {
+			unit = 1
+		}
+		var overflow bool
+		var s int64
+		if s, overflow = mulExact(int64(v), unit); !overflow {
+			seconds, overflow = addExactly(seconds, s)
+		}
+		if overflow {
+			return 0, 0, overflowError()
+		}
+	}
+	return seconds * sign, nanos * sign, nil
+}
@@ -0,0 +1,103 @@
+// Package pgxgoda registers the goda types on a pgx type map, so they're encoded and scanned
+// by the pgx codecs in the binary format instead of going through their text form.
+//
+// Call Register on the type map of each connection, such as in the AfterConnect hook of a pgxpool:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxgoda.Register(conn.TypeMap())
+//		return nil
+//	}
+//
+// The types are mapped to the PostgreSQL types as follows, arrays of them are supported as slices:
+//   - goda.LocalDate: date
+//   - goda.LocalTime: time
+//   - goda.LocalDateTime: timestamp
+//   - goda.OffsetDateTime: timestamptz
+//   - goda.ZoneOffset: text such as "+08:00", or an integer of the total seconds
+//   - goda.YearMonth: date of the first day of the month, or text such as "2024-03"
+//
+// The zero value of each type is NULL, except goda.ZoneOffset whose zero value is UTC and is encoded as "Z" or 0,
+// NULL still scans into the zero ZoneOffset. The infinity and -infinity of date, timestamp and timestamptz are
+// the max and min values, such as goda.LocalDateMax and goda.LocalDateMin, in both directions,
+// and BC dates are the non-positive years, so goda.PostgresValue is not needed on connections registered here.
+// PostgreSQL stores microseconds, the nanoseconds are truncated when encoding.
+package pgxgoda
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Register registers the goda types on the type map. Registering more than once has no effect.
+func Register(m *pgtype.Map) {
+	for _, it := range []struct {
+		oid      uint32
+		arrayOID uint32
+	}{
+		{pgtype.DateOID, pgtype.DateArrayOID},
+		{pgtype.TimeOID, pgtype.TimeArrayOID},
+		{pgtype.TimestampOID, pgtype.TimestampArrayOID},
+		{pgtype.TimestamptzOID, pgtype.TimestamptzArrayOID},
+		{pgtype.TextOID, pgtype.TextArrayOID},
+		{pgtype.VarcharOID, pgtype.VarcharArrayOID},
+		{pgtype.Int2OID, pgtype.Int2ArrayOID},
+		{pgtype.Int4OID, pgtype.Int4ArrayOID},
+		{pgtype.Int8OID, pgtype.Int8ArrayOID},
+	} {
+		t, ok := m.TypeForOID(it.oid)
+		if !ok {
+			continue
+		}
+		if _, ok = t.Codec.(codec); ok {
+			continue
+		}
+		var wrapped = &pgtype.Type{Name: t.Name, OID: t.OID, Codec: codec{t.Codec}}
+		m.RegisterType(wrapped)
+		if arrayType, ok := m.TypeForOID(it.arrayOID); ok {
+			// the array codec plans the elements by its element type, which must be the wrapped one
+			m.RegisterType(&pgtype.Type{Name: arrayType.Name, OID: arrayType.OID, Codec: &pgtype.ArrayCodec{ElementType: wrapped}})
+		}
+	}
+	for _, it := range defaultPgTypes {
+		m.RegisterDefaultPgType(it.value, it.name)
+	}
+}
+
+// codec wraps a pgtype codec to encode and scan the goda types through the wrappers.
+// The wrappers must be planned by the codec, as pgx prefers sql.Scanner to the wrap functions of the map.
+type codec struct {
+	pgtype.Codec
+}
+
+func (c codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if wrapped := wrapValue(value); wrapped != nil {
+		if next := c.Codec.PlanEncode(m, oid, format, wrapped); next != nil {
+			return &wrapEncodePlan{next: next}
+		}
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+func (c codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if wrapped := wrapTarget(target); wrapped != nil {
+		if next := c.Codec.PlanScan(m, oid, format, wrapped); next != nil {
+			return &wrapScanPlan{next: next}
+		}
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+type wrapEncodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (p *wrapEncodePlan) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	return p.next.Encode(wrapValue(value), buf)
+}
+
+type wrapScanPlan struct {
+	next pgtype.ScanPlan
+}
+
+func (p *wrapScanPlan) Scan(src []byte, target any) error {
+	return p.next.Scan(src, wrapTarget(target))
+}
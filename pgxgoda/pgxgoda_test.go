@@ -0,0 +1,232 @@
+package pgxgoda
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/iseki0/goda"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMap() *pgtype.Map {
+	var m = pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func roundTrip[T any](t *testing.T, m *pgtype.Map, oid uint32, format int16, v T) T {
+	t.Helper()
+	buf, e := m.Encode(oid, format, v, nil)
+	require.NoError(t, e)
+	var r T
+	require.NoError(t, m.Scan(oid, format, buf, &r))
+	return r
+}
+
+func TestRegister(t *testing.T) {
+	var m = newMap()
+	Register(m)
+	dt, ok := m.TypeForOID(pgtype.DateOID)
+	require.True(t, ok)
+	_, ok = dt.Codec.(codec)
+	assert.True(t, ok)
+	_, ok = dt.Codec.(codec).Codec.(codec)
+	assert.False(t, ok, "registered twice")
+
+	for _, it := range []struct {
+		value any
+		oid   uint32
+	}{
+		{goda.LocalDate{}, pgtype.DateOID},
+		{goda.LocalTime{}, pgtype.TimeOID},
+		{goda.LocalDateTime{}, pgtype.TimestampOID},
+		{goda.OffsetDateTime{}, pgtype.TimestamptzOID},
+		{goda.ZoneOffset{}, pgtype.TextOID},
+		{goda.YearMonth{}, pgtype.DateOID},
+		{[]goda.LocalDate{}, pgtype.DateArrayOID},
+		{[]goda.OffsetDateTime{}, pgtype.TimestamptzArrayOID},
+	} {
+		typ, ok := m.TypeForValue(it.value)
+		require.True(t, ok, "%T", it.value)
+		assert.Equal(t, it.oid, typ.OID, "%T", it.value)
+	}
+}
+
+func TestLocalDate(t *testing.T) {
+	var m = newMap()
+	buf, e := m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, goda.MustLocalDateOf(2000, goda.January, 1), nil)
+	require.NoError(t, e)
+	assert.Equal(t, []byte{0, 0, 0, 0}, buf)
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		for _, d := range []goda.LocalDate{
+			goda.MustLocalDateOf(2024, goda.March, 15),
+			goda.MustLocalDateOf(1, goda.January, 1),
+			goda.MustLocalDateOf(-400, goda.February, 29),
+			goda.LocalDateMax(),
+			goda.LocalDateMin(),
+		} {
+			assert.Equal(t, d, roundTrip(t, m, pgtype.DateOID, format, d), "format %d", format)
+		}
+	}
+
+	buf, e = m.Encode(pgtype.DateOID, pgtype.TextFormatCode, goda.LocalDateMax(), nil)
+	require.NoError(t, e)
+	assert.Equal(t, "infinity", string(buf))
+	buf, e = m.Encode(pgtype.DateOID, pgtype.TextFormatCode, goda.LocalDateMin(), nil)
+	require.NoError(t, e)
+	assert.Equal(t, "-infinity", string(buf))
+
+	_, e = m.Encode(pgtype.DateOID, pgtype.BinaryFormatCode, goda.MustLocalDateOf(-5000, goda.January, 1), nil)
+	assert.Error(t, e)
+}
+
+func TestLocalTime(t *testing.T) {
+	var m = newMap()
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		var lt = goda.MustLocalTimeOf(14, 30, 45, 123456000)
+		assert.Equal(t, lt, roundTrip(t, m, pgtype.TimeOID, format, lt))
+		assert.Equal(t, lt, roundTrip(t, m, pgtype.TimeOID, format, goda.MustLocalTimeOf(14, 30, 45, 123456789)))
+	}
+	buf, e := m.Encode(pgtype.TimeOID, pgtype.BinaryFormatCode, goda.MustLocalTimeOf(0, 0, 1, 0), nil)
+	require.NoError(t, e)
+	assert.Equal(t, int64(1000000), int64(binary.BigEndian.Uint64(buf)))
+}
+
+func TestLocalDateTime(t *testing.T) {
+	var m = newMap()
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		for _, dt := range []goda.LocalDateTime{
+			goda.MustLocalDateTimeOf(2024, goda.March, 15, 14, 30, 45, 123456000),
+			goda.MustLocalDateTimeOf(1, goda.January, 1, 0, 0, 0, 0),
+			goda.LocalDateTimeMax(),
+			goda.LocalDateTimeMin(),
+		} {
+			assert.Equal(t, dt, roundTrip(t, m, pgtype.TimestampOID, format, dt), "format %d", format)
+		}
+	}
+	_, e := m.Encode(pgtype.TimestampOID, pgtype.BinaryFormatCode, goda.MustLocalDateTimeOf(300000, goda.January, 1, 0, 0, 0, 0), nil)
+	assert.Error(t, e)
+}
+
+func TestOffsetDateTime(t *testing.T) {
+	var m = newMap()
+	var odt = goda.MustLocalDateTimeOf(2024, goda.March, 15, 14, 30, 45, 123456000).AtOffset(goda.MustZoneOffsetOfHours(8))
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		r := roundTrip(t, m, pgtype.TimestamptzOID, format, odt)
+		assert.Equal(t, odt.ToInstant(), r.ToInstant(), "format %d", format)
+		assert.Equal(t, goda.OffsetDateTimeMax(), roundTrip(t, m, pgtype.TimestamptzOID, format, goda.OffsetDateTimeMax()))
+		assert.Equal(t, goda.OffsetDateTimeMin(), roundTrip(t, m, pgtype.TimestamptzOID, format, goda.OffsetDateTimeMin()))
+	}
+}
+
+func TestZoneOffset(t *testing.T) {
+	var m = newMap()
+	var zo = goda.MustZoneOffsetOf(5, 30, 0)
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		assert.Equal(t, zo, roundTrip(t, m, pgtype.TextOID, format, zo))
+		assert.Equal(t, zo, roundTrip(t, m, pgtype.Int4OID, format, zo))
+		assert.Equal(t, goda.ZoneOffsetUTC(), roundTrip(t, m, pgtype.TextOID, format, goda.ZoneOffsetUTC()))
+	}
+	buf, e := m.Encode(pgtype.TextOID, pgtype.TextFormatCode, zo, nil)
+	require.NoError(t, e)
+	assert.Equal(t, "+05:30", string(buf))
+	buf, e = m.Encode(pgtype.Int4OID, pgtype.TextFormatCode, zo, nil)
+	require.NoError(t, e)
+	assert.Equal(t, "19800", string(buf))
+}
+
+func TestYearMonth(t *testing.T) {
+	var m = newMap()
+	var ym = goda.MustYearMonthOf(2024, goda.March)
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		assert.Equal(t, ym, roundTrip(t, m, pgtype.DateOID, format, ym))
+		assert.Equal(t, ym, roundTrip(t, m, pgtype.TextOID, format, ym))
+	}
+	buf, e := m.Encode(pgtype.DateOID, pgtype.TextFormatCode, ym, nil)
+	require.NoError(t, e)
+	assert.Equal(t, "2024-03-01", string(buf))
+
+	var r goda.YearMonth
+	require.NoError(t, m.Scan(pgtype.DateOID, pgtype.TextFormatCode, []byte("2024-03-15"), &r))
+	assert.Equal(t, ym, r)
+	assert.Error(t, m.Scan(pgtype.DateOID, pgtype.TextFormatCode, []byte("infinity"), &r))
+}
+
+func TestNull(t *testing.T) {
+	var m = newMap()
+	for _, it := range []struct {
+		oid   uint32
+		value any
+	}{
+		{pgtype.DateOID, goda.LocalDate{}},
+		{pgtype.TimeOID, goda.LocalTime{}},
+		{pgtype.TimestampOID, goda.LocalDateTime{}},
+		{pgtype.TimestamptzOID, goda.OffsetDateTime{}},
+		{pgtype.DateOID, goda.YearMonth{}},
+	} {
+		buf, e := m.Encode(it.oid, pgtype.BinaryFormatCode, it.value, nil)
+		require.NoError(t, e)
+		assert.Nil(t, buf, "%T", it.value)
+	}
+
+	var d = goda.MustLocalDateOf(2024, goda.March, 15)
+	require.NoError(t, m.Scan(pgtype.DateOID, pgtype.BinaryFormatCode, nil, &d))
+	assert.True(t, d.IsZero())
+	var odt = goda.OffsetDateTimeMax()
+	require.NoError(t, m.Scan(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, nil, &odt))
+	assert.True(t, odt.IsZero())
+	var zo = goda.ZoneOffsetUTC()
+	require.NoError(t, m.Scan(pgtype.TextOID, pgtype.TextFormatCode, nil, &zo))
+	assert.True(t, zo.IsZero())
+}
+
+func TestArray(t *testing.T) {
+	var m = newMap()
+	var dates = []goda.LocalDate{
+		goda.MustLocalDateOf(2024, goda.March, 15),
+		goda.LocalDateMax(),
+		{},
+	}
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		assert.Equal(t, dates, roundTrip(t, m, pgtype.DateArrayOID, format, dates), "format %d", format)
+	}
+	buf, e := m.Encode(pgtype.DateArrayOID, pgtype.TextFormatCode, dates, nil)
+	require.NoError(t, e)
+	assert.Equal(t, "{2024-03-15,infinity,NULL}", string(buf))
+
+	var times = []goda.LocalDateTime{goda.MustLocalDateTimeOf(2024, goda.March, 15, 14, 30, 0, 0)}
+	assert.Equal(t, times, roundTrip(t, m, pgtype.TimestampArrayOID, pgtype.BinaryFormatCode, times))
+	var offsets = []goda.ZoneOffset{goda.MustZoneOffsetOfHours(-5), goda.ZoneOffsetUTC()}
+	assert.Equal(t, offsets, roundTrip(t, m, pgtype.TextArrayOID, pgtype.BinaryFormatCode, offsets))
+	var yms = []goda.YearMonth{goda.MustYearMonthOf(2024, goda.March)}
+	assert.Equal(t, yms, roundTrip(t, m, pgtype.DateArrayOID, pgtype.BinaryFormatCode, yms))
+}
+
+func TestPostgres(t *testing.T) {
+	conn, e := pgx.Connect(context.Background(), "")
+	if e != nil {
+		t.Skip("postgres is not available:", e)
+	}
+	defer conn.Close(context.Background())
+	Register(conn.TypeMap())
+
+	var d = goda.MustLocalDateOf(2024, goda.March, 15)
+	var dt = goda.MustLocalDateTimeOf(2024, goda.March, 15, 14, 30, 45, 123456000)
+	var odt = dt.AtOffset(goda.MustZoneOffsetOfHours(8))
+	var rd, rMax goda.LocalDate
+	var rdt goda.LocalDateTime
+	var rodt goda.OffsetDateTime
+	var rDates []goda.LocalDate
+	require.NoError(t, conn.QueryRow(context.Background(), "SELECT $1::date, 'infinity'::date, $2::timestamp, $3::timestamptz, $4::date[]",
+		d, dt, odt, []goda.LocalDate{d, goda.LocalDateMin()}).Scan(&rd, &rMax, &rdt, &rodt, &rDates))
+	assert.Equal(t, d, rd)
+	assert.Equal(t, goda.LocalDateMax(), rMax)
+	assert.Equal(t, dt, rdt)
+	assert.Equal(t, odt.ToInstant(), rodt.ToInstant())
+	assert.Equal(t, []goda.LocalDate{d, goda.LocalDateMin()}, rDates)
+}
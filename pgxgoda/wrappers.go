@@ -0,0 +1,311 @@
+package pgxgoda
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/iseki0/goda"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var defaultPgTypes = []struct {
+	value any
+	name  string
+}{
+	{goda.LocalDate{}, "date"},
+	{goda.LocalTime{}, "time"},
+	{goda.LocalDateTime{}, "timestamp"},
+	{goda.OffsetDateTime{}, "timestamptz"},
+	{goda.ZoneOffset{}, "text"},
+	{goda.YearMonth{}, "date"},
+	{[]goda.LocalDate{}, "_date"},
+	{[]goda.LocalTime{}, "_time"},
+	{[]goda.LocalDateTime{}, "_timestamp"},
+	{[]goda.OffsetDateTime{}, "_timestamptz"},
+	{[]goda.ZoneOffset{}, "_text"},
+	{[]goda.YearMonth{}, "_date"},
+}
+
+// wrapValue returns the wrapper of a goda value, or nil for other values.
+func wrapValue(value any) any {
+	switch v := value.(type) {
+	case goda.LocalDate:
+		return localDate(v)
+	case goda.LocalTime:
+		return localTime(v)
+	case goda.LocalDateTime:
+		return localDateTime(v)
+	case goda.OffsetDateTime:
+		return offsetDateTime(v)
+	case goda.ZoneOffset:
+		return zoneOffset(v)
+	case goda.YearMonth:
+		return yearMonth(v)
+	}
+	return nil
+}
+
+// wrapTarget returns the wrapper of a pointer to a goda value, or nil for other targets.
+func wrapTarget(target any) any {
+	switch t := target.(type) {
+	case *goda.LocalDate:
+		return (*localDate)(t)
+	case *goda.LocalTime:
+		return (*localTime)(t)
+	case *goda.LocalDateTime:
+		return (*localDateTime)(t)
+	case *goda.OffsetDateTime:
+		return (*offsetDateTime)(t)
+	case *goda.ZoneOffset:
+		return (*zoneOffset)(t)
+	case *goda.YearMonth:
+		return (*yearMonth)(t)
+	}
+	return nil
+}
+
+// The years of PostgreSQL date and timestamp, 4714 BC is the year -4713.
+const (
+	dateYearMin      = -4713
+	dateYearMax      = 5874897
+	timestampYearMax = 294276
+)
+
+func checkYear(year goda.Year, max int64, typeName string) error {
+	if year < dateYearMin || year.Int64() > max {
+		return fmt.Errorf("year %v is out of range for PostgreSQL %s", year, typeName)
+	}
+	return nil
+}
+
+func localDateOfGoTime(t time.Time) (goda.LocalDate, error) {
+	return goda.LocalDateOf(goda.Year(t.Year()), goda.Month(t.Month()), t.Day())
+}
+
+func localDateTimeOfGoTime(t time.Time) (goda.LocalDateTime, error) {
+	return goda.LocalDateTimeOf(goda.Year(t.Year()), goda.Month(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+}
+
+type localDate goda.LocalDate
+
+func (w *localDate) ScanDate(v pgtype.Date) (e error) {
+	var d goda.LocalDate
+	switch {
+	case !v.Valid:
+	case v.InfinityModifier == pgtype.Infinity:
+		d = goda.LocalDateMax()
+	case v.InfinityModifier == pgtype.NegativeInfinity:
+		d = goda.LocalDateMin()
+	default:
+		if d, e = localDateOfGoTime(v.Time); e != nil {
+			return
+		}
+	}
+	*w = localDate(d)
+	return nil
+}
+
+func (w localDate) DateValue() (pgtype.Date, error) {
+	var d = goda.LocalDate(w)
+	switch {
+	case d.IsZero():
+		return pgtype.Date{}, nil
+	case d == goda.LocalDateMax():
+		return pgtype.Date{InfinityModifier: pgtype.Infinity, Valid: true}, nil
+	case d == goda.LocalDateMin():
+		return pgtype.Date{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, nil
+	}
+	if e := checkYear(d.Year(), dateYearMax, "date"); e != nil {
+		return pgtype.Date{}, e
+	}
+	return pgtype.Date{Time: d.GoTime(), Valid: true}, nil
+}
+
+type localTime goda.LocalTime
+
+func (w *localTime) ScanTime(v pgtype.Time) (e error) {
+	var t goda.LocalTime
+	if v.Valid {
+		if t, e = goda.LocalTimeOfNanoOfDay(v.Microseconds * 1000); e != nil {
+			return
+		}
+	}
+	*w = localTime(t)
+	return nil
+}
+
+func (w localTime) TimeValue() (pgtype.Time, error) {
+	var t = goda.LocalTime(w)
+	if t.IsZero() {
+		return pgtype.Time{}, nil
+	}
+	return pgtype.Time{Microseconds: t.NanoOfDay() / 1000, Valid: true}, nil
+}
+
+type localDateTime goda.LocalDateTime
+
+func (w *localDateTime) ScanTimestamp(v pgtype.Timestamp) (e error) {
+	var dt goda.LocalDateTime
+	switch {
+	case !v.Valid:
+	case v.InfinityModifier == pgtype.Infinity:
+		dt = goda.LocalDateTimeMax()
+	case v.InfinityModifier == pgtype.NegativeInfinity:
+		dt = goda.LocalDateTimeMin()
+	default:
+		if dt, e = localDateTimeOfGoTime(v.Time); e != nil {
+			return
+		}
+	}
+	*w = localDateTime(dt)
+	return nil
+}
+
+func (w localDateTime) TimestampValue() (pgtype.Timestamp, error) {
+	var dt = goda.LocalDateTime(w)
+	switch {
+	case dt.IsZero():
+		return pgtype.Timestamp{}, nil
+	case dt == goda.LocalDateTimeMax():
+		return pgtype.Timestamp{InfinityModifier: pgtype.Infinity, Valid: true}, nil
+	case dt == goda.LocalDateTimeMin():
+		return pgtype.Timestamp{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, nil
+	}
+	if e := checkYear(dt.Year(), timestampYearMax, "timestamp"); e != nil {
+		return pgtype.Timestamp{}, e
+	}
+	return pgtype.Timestamp{Time: dt.GoTime(), Valid: true}, nil
+}
+
+type offsetDateTime goda.OffsetDateTime
+
+func (w *offsetDateTime) ScanTimestamptz(v pgtype.Timestamptz) (e error) {
+	var odt goda.OffsetDateTime
+	switch {
+	case !v.Valid:
+	case v.InfinityModifier == pgtype.Infinity:
+		odt = goda.OffsetDateTimeMax()
+	case v.InfinityModifier == pgtype.NegativeInfinity:
+		odt = goda.OffsetDateTimeMin()
+	default:
+		var dt goda.LocalDateTime
+		if dt, e = localDateTimeOfGoTime(v.Time); e != nil {
+			return
+		}
+		_, offset := v.Time.Zone()
+		var zo goda.ZoneOffset
+		if zo, e = goda.ZoneOffsetOfSeconds(offset); e != nil {
+			return
+		}
+		odt = dt.AtOffset(zo)
+	}
+	*w = offsetDateTime(odt)
+	return nil
+}
+
+func (w offsetDateTime) TimestamptzValue() (pgtype.Timestamptz, error) {
+	var odt = goda.OffsetDateTime(w)
+	switch {
+	case odt.IsZero():
+		return pgtype.Timestamptz{}, nil
+	case odt == goda.OffsetDateTimeMax():
+		return pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}, nil
+	case odt == goda.OffsetDateTimeMin():
+		return pgtype.Timestamptz{InfinityModifier: pgtype.NegativeInfinity, Valid: true}, nil
+	}
+	if e := checkYear(odt.Year(), timestampYearMax, "timestamptz"); e != nil {
+		return pgtype.Timestamptz{}, e
+	}
+	return pgtype.Timestamptz{Time: odt.GoTime(), Valid: true}, nil
+}
+
+type zoneOffset goda.ZoneOffset
+
+func (w *zoneOffset) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		*w = zoneOffset{}
+		return nil
+	}
+	return (*goda.ZoneOffset)(w).UnmarshalText([]byte(v.String))
+}
+
+func (w zoneOffset) TextValue() (pgtype.Text, error) {
+	return pgtype.Text{String: goda.ZoneOffset(w).String(), Valid: true}, nil
+}
+
+func (w *zoneOffset) ScanInt64(v pgtype.Int8) (e error) {
+	var zo goda.ZoneOffset
+	if v.Valid {
+		if v.Int64 != int64(int(v.Int64)) {
+			return fmt.Errorf("offset seconds %d is out of range", v.Int64)
+		}
+		if zo, e = goda.ZoneOffsetOfSeconds(int(v.Int64)); e != nil {
+			return
+		}
+	}
+	*w = zoneOffset(zo)
+	return nil
+}
+
+func (w zoneOffset) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(goda.ZoneOffset(w).TotalSeconds()), Valid: true}, nil
+}
+
+type yearMonth goda.YearMonth
+
+func (w *yearMonth) ScanDate(v pgtype.Date) (e error) {
+	var ym goda.YearMonth
+	if v.Valid {
+		if v.InfinityModifier != pgtype.Finite {
+			return fmt.Errorf("cannot scan %v into goda.YearMonth", v.InfinityModifier)
+		}
+		if ym, e = goda.YearMonthOf(goda.Year(v.Time.Year()), goda.Month(v.Time.Month())); e != nil {
+			return
+		}
+	}
+	*w = yearMonth(ym)
+	return nil
+}
+
+func (w yearMonth) DateValue() (pgtype.Date, error) {
+	var ym = goda.YearMonth(w)
+	if ym.IsZero() {
+		return pgtype.Date{}, nil
+	}
+	if e := checkYear(ym.Year(), dateYearMax, "date"); e != nil {
+		return pgtype.Date{}, e
+	}
+	return pgtype.Date{Time: time.Date(int(ym.Year()), time.Month(ym.Month()), 1, 0, 0, 0, 0, time.UTC), Valid: true}, nil
+}
+
+func (w *yearMonth) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		*w = yearMonth{}
+		return nil
+	}
+	return (*goda.YearMonth)(w).UnmarshalText([]byte(v.String))
+}
+
+func (w yearMonth) TextValue() (pgtype.Text, error) {
+	var ym = goda.YearMonth(w)
+	return pgtype.Text{String: ym.String(), Valid: !ym.IsZero()}, nil
+}
+
+var (
+	_ pgtype.DateScanner        = (*localDate)(nil)
+	_ pgtype.DateValuer         = localDate{}
+	_ pgtype.TimeScanner        = (*localTime)(nil)
+	_ pgtype.TimeValuer         = localTime{}
+	_ pgtype.TimestampScanner   = (*localDateTime)(nil)
+	_ pgtype.TimestampValuer    = localDateTime{}
+	_ pgtype.TimestamptzScanner = (*offsetDateTime)(nil)
+	_ pgtype.TimestamptzValuer  = offsetDateTime{}
+	_ pgtype.TextScanner        = (*zoneOffset)(nil)
+	_ pgtype.TextValuer         = zoneOffset{}
+	_ pgtype.Int64Scanner       = (*zoneOffset)(nil)
+	_ pgtype.Int64Valuer        = zoneOffset{}
+	_ pgtype.DateScanner        = (*yearMonth)(nil)
+	_ pgtype.DateValuer         = yearMonth{}
+	_ pgtype.TextScanner        = (*yearMonth)(nil)
+	_ pgtype.TextValuer         = yearMonth{}
+)
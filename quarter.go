@@ -0,0 +1,66 @@
+package goda
+
+import (
+	"strconv"
+)
+
+// Quarter represents a quarter-of-year in the ISO-8601 calendar system, such as Q1 for January to March.
+type Quarter int
+
+// Quarter constants.
+const (
+	Q1 Quarter = iota + 1 // January to March (quarter 1)
+	Q2                    // April to June (quarter 2)
+	Q3                    // July to September (quarter 3)
+	Q4                    // October to December (quarter 4)
+)
+
+// IsZero returns true if this is the zero value (not a valid quarter).
+func (q Quarter) IsZero() bool {
+	return q == 0
+}
+
+// FirstMonth returns the first month of this quarter, such as April for Q2.
+// Returns 0 for zero value.
+func (q Quarter) FirstMonth() Month {
+	if q.IsZero() {
+		return 0
+	}
+	return Month(q-1)*3 + 1
+}
+
+// Length returns the number of days in this quarter for the specified year type.
+// Q1 has 90 days, or 91 in a leap year, Q2 has 91 days, Q3 and Q4 have 92 days.
+func (q Quarter) Length(isLeap bool) int {
+	switch q {
+	case Q1:
+		if isLeap {
+			return 91
+		}
+		return 90
+	case Q2:
+		return 91
+	case Q3, Q4:
+		return 92
+	default:
+		panic("invalid quarter: " + strconv.Itoa(int(q)))
+	}
+}
+
+// String returns the name of the quarter (e.g., "Q1", "Q4").
+// Returns empty string for zero value.
+func (q Quarter) String() string {
+	if q.IsZero() {
+		return ""
+	}
+	return "Q" + strconv.Itoa(int(q))
+}
+
+// Quarter returns the quarter of this month, such as Q2 for May.
+// Returns 0 for zero value.
+func (m Month) Quarter() Quarter {
+	if m.IsZero() {
+		return 0
+	}
+	return Quarter(m-1)/3 + 1
+}
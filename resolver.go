@@ -0,0 +1,595 @@
+package goda
+
+import (
+	"strconv"
+)
+
+// ResolverStyle controls how the fields are resolved into dates and times,
+// such as the fields parsed by DateTimeFormatter.
+//
+// This is similar to Java's ResolverStyle.
+type ResolverStyle int
+
+const (
+	// ResolverStyleStrict resolves the fields strictly, all values must be in range and form a valid date and time,
+	// such as "2024-02-30" is rejected.
+	// The year-of-era is only resolved with the era or the year, use FieldYear ("uuuu") instead of
+	// FieldYearOfEra ("yyyy") without FieldEra ("G").
+	ResolverStyleStrict ResolverStyle = iota + 1
+	// ResolverStyleSmart resolves the fields in a sensible manner, which is the default of DateTimeFormatter.
+	// A day-of-month beyond the end of the month is clamped, such as "2024-02-30" to 2024-02-29,
+	// and "24:00" is resolved to the midnight of the next day.
+	// Other values must be in range.
+	ResolverStyleSmart
+	// ResolverStyleLenient resolves the fields leniently, values out of range roll over,
+	// such as "2024-13-01" to 2025-01-01 and "25:00" to 01:00 of the next day.
+	ResolverStyleLenient
+)
+
+// Valid returns true if the style is one of the defined styles.
+func (s ResolverStyle) Valid() bool {
+	return s >= ResolverStyleStrict && s <= ResolverStyleLenient
+}
+
+// String returns the name of the style, such as "Smart".
+func (s ResolverStyle) String() string {
+	switch s {
+	case ResolverStyleStrict:
+		return "Strict"
+	case ResolverStyleSmart:
+		return "Smart"
+	case ResolverStyleLenient:
+		return "Lenient"
+	default:
+		return "UnknownResolverStyle(" + strconv.Itoa(int(s)) + ")"
+	}
+}
+
+// ResolveFields resolves the field values into the best-fitting temporal, using the same rules as DateTimeFormatter.
+//
+// The date is resolved from FieldEpochDay, FieldYear with FieldMonthOfYear and FieldDayOfMonth, or FieldYear with FieldDayOfYear.
+// FieldProlepticMonth and FieldYearOfEra with FieldEra are resolved into FieldYear and FieldMonthOfYear first.
+// The time is resolved from FieldHourOfDay, or FieldClockHourOfAmPm or FieldHourOfAmPm with FieldAmPmOfDay,
+// along with the minute, second and fraction fields, which default to zero.
+// FieldInstantSeconds is resolved into the date and time if FieldOffsetSeconds is present.
+// Redundant fields are cross-checked, such as FieldDayOfWeek against the resolved date,
+// other fields not needed by the result are ignored.
+//
+// The result is, in order of preference, an OffsetDateTime, a LocalDateTime, a LocalDate, a YearMonth,
+// a LocalTime, an Instant or a ZoneOffset, depending on the fields available.
+//
+// Returns an *Error if the fields are out of range, in conflict or not enough for any type,
+// Error.Field reports the field at fault if any.
+func ResolveFields(fields map[Field]TemporalValue, style ResolverStyle) (TemporalAccessor, error) {
+	var values = make(map[Field]int64, len(fields))
+	for field, value := range fields {
+		switch {
+		case !field.Valid():
+			return nil, invalidFieldError(field)
+		case value.Unsupported():
+			return nil, unsupportedField(field)
+		case value.Overflow():
+			return nil, &Error{reason: errReasonArithmeticOverflow, field: field}
+		}
+		values[field] = value.Int64()
+	}
+	r, e := resolveFields(values, ZoneId{}, style)
+	if e != nil {
+		return nil, e
+	}
+	switch {
+	case !r.date.IsZero() && !r.time.IsZero() && r.hasOffset:
+		return r.date.AtTime(r.time).AtOffset(r.offset), nil
+	case !r.date.IsZero() && !r.time.IsZero():
+		return r.date.AtTime(r.time), nil
+	case !r.date.IsZero():
+		return r.date, nil
+	case !r.yearMonth.IsZero():
+		return r.yearMonth, nil
+	case !r.time.IsZero():
+		return r.time, nil
+	case !r.instant.IsZero():
+		return r.instant, nil
+	case r.hasOffset:
+		return r.offset, nil
+	default:
+		return nil, newError("unable to resolve the fields into any temporal")
+	}
+}
+
+// dateTimeParsed holds the values resolved from the fields,
+// the zero value of each member means it's not available.
+type dateTimeParsed struct {
+	yearMonth YearMonth
+	date      LocalDate
+	time      LocalTime
+	offset    ZoneOffset
+	hasOffset bool
+	zone      ZoneId
+	instant   Instant
+}
+
+// fieldResolver combines the fields into dates and times following Java's rules of Parsed.resolve.
+// Fields are removed from the map once resolved, derived fields are added to it.
+type fieldResolver struct {
+	style  ResolverStyle
+	fields map[Field]int64
+	zone   ZoneId
+	date   LocalDate
+	time   LocalTime
+	// excessDays is the days beyond the time, such as 1 for "24:00", which is added to the date
+	excessDays int64
+}
+
+// resolveFields resolves the fields, the zone is the zone id parsed along with the fields if any.
+// The map is modified.
+func resolveFields(fields map[Field]int64, zone ZoneId, style ResolverStyle) (r dateTimeParsed, e error) {
+	if !style.Valid() {
+		return r, newError("invalid resolver style: %d", style)
+	}
+	var fr = fieldResolver{style: style, fields: fields, zone: zone}
+	for _, step := range []func() error{
+		fr.resolveInstantFields,
+		fr.resolveDateFields,
+		fr.resolveTimeFields,
+		fr.crossCheck,
+		fr.resolveExcessDays,
+	} {
+		if e = step(); e != nil {
+			return
+		}
+	}
+	return fr.result()
+}
+
+func (r *fieldResolver) take(field Field) (int64, bool) {
+	v, ok := r.fields[field]
+	if ok {
+		delete(r.fields, field)
+	}
+	return v, ok
+}
+
+// check checks the range of the value unless the style is lenient.
+func (r *fieldResolver) check(field Field, value int64) error {
+	if r.style == ResolverStyleLenient {
+		return nil
+	}
+	return field.check(value)
+}
+
+// updateField stores the value derived while resolving the source field,
+// it fails if the field holds a different value.
+func (r *fieldResolver) updateField(source, field Field, value int64) error {
+	if old, ok := r.fields[field]; ok && old != value {
+		return newFieldError(field, "conflict found: %s %d differs from %s %d while resolving %s", field, old, field, value, source)
+	}
+	r.fields[field] = value
+	return nil
+}
+
+func (r *fieldResolver) updateDate(date LocalDate) error {
+	if !r.date.IsZero() && r.date != date {
+		return newFieldError(FieldEpochDay, "conflict found: fields resolved to two different dates: %s %s", r.date, date)
+	}
+	r.date = date
+	return nil
+}
+
+func (r *fieldResolver) updateTime(time LocalTime, excessDays int64) error {
+	if r.time.IsZero() {
+		r.time, r.excessDays = time, excessDays
+		return nil
+	}
+	if r.time != time {
+		return newFieldError(FieldNanoOfDay, "conflict found: fields resolved to two different times: %s %s", r.time, time)
+	}
+	if r.excessDays != 0 && excessDays != 0 && r.excessDays != excessDays {
+		return newFieldError(FieldNanoOfDay, "conflict found: fields resolved to two different excess days: %d %d", r.excessDays, excessDays)
+	}
+	if excessDays != 0 {
+		r.excessDays = excessDays
+	}
+	return nil
+}
+
+// resolveInstantFields resolves FieldInstantSeconds into the date and the second-of-day using the zone or the offset.
+func (r *fieldResolver) resolveInstantFields() (e error) {
+	secs, ok := r.fields[FieldInstantSeconds]
+	if !ok {
+		return
+	}
+	var offset ZoneOffset
+	if !r.zone.IsZero() {
+		offset = r.zone.offsetOfEpochSecond(secs)
+	} else if v, ok := r.fields[FieldOffsetSeconds]; ok {
+		if e = FieldOffsetSeconds.check(v); e != nil {
+			return
+		}
+		offset = MustZoneOffsetOfSeconds(int(v))
+	} else {
+		return
+	}
+	ldt, e := LocalDateTimeOfEpochSecond(secs, 0, offset)
+	if e != nil {
+		return
+	}
+	if e = r.updateDate(ldt.LocalDate()); e != nil {
+		return
+	}
+	if e = r.updateField(FieldInstantSeconds, FieldSecondOfDay, ldt.LocalTime().GetField(FieldSecondOfDay).Int64()); e != nil {
+		return
+	}
+	return r.updateField(FieldInstantSeconds, FieldOffsetSeconds, int64(offset.TotalSeconds()))
+}
+
+func (r *fieldResolver) resolveDateFields() (e error) {
+	if v, ok := r.take(FieldEpochDay); ok {
+		var d LocalDate
+		if d, e = LocalDateOfEpochDays(v); e != nil {
+			return
+		}
+		if e = FieldYear.check(int64(d.Year())); e != nil {
+			return fieldOutOfRangeError(FieldEpochDay, v)
+		}
+		return r.updateDate(d)
+	}
+	if v, ok := r.take(FieldProlepticMonth); ok {
+		if e = r.check(FieldProlepticMonth, v); e != nil {
+			return
+		}
+		if e = r.updateField(FieldProlepticMonth, FieldMonthOfYear, floorMod(v, 12)+1); e != nil {
+			return
+		}
+		if e = r.updateField(FieldProlepticMonth, FieldYear, floorDiv(v, 12)); e != nil {
+			return
+		}
+	}
+	if e = r.resolveYearOfEra(); e != nil {
+		return
+	}
+	if _, ok := r.fields[FieldYear]; !ok {
+		return
+	}
+	if _, ok := r.fields[FieldMonthOfYear]; ok {
+		if _, ok := r.fields[FieldDayOfMonth]; ok {
+			return r.resolveYearMonthDay()
+		}
+	}
+	if _, ok := r.fields[FieldDayOfYear]; ok {
+		return r.resolveYearDay()
+	}
+	return
+}
+
+// resolveYearOfEra resolves FieldYearOfEra into FieldYear,
+// the era is assumed to be CE if absent unless the style is strict.
+func (r *fieldResolver) resolveYearOfEra() (e error) {
+	yoe, ok := r.take(FieldYearOfEra)
+	if !ok {
+		if era, ok := r.fields[FieldEra]; ok {
+			return FieldEra.check(era)
+		}
+		return
+	}
+	if e = r.check(FieldYearOfEra, yoe); e != nil {
+		return
+	}
+	var bce, overflow = 0 - yoe, false
+	if bce, overflow = addExactly(bce, 1); overflow {
+		return overflowError()
+	}
+	era, ok := r.take(FieldEra)
+	if !ok {
+		year, ok := r.fields[FieldYear]
+		if !ok && r.style == ResolverStyleStrict {
+			// the era is not invented in strict mode, keep the field for the cross-check
+			r.fields[FieldYearOfEra] = yoe
+			return
+		}
+		if !ok || year > 0 {
+			return r.updateField(FieldYearOfEra, FieldYear, yoe)
+		}
+		return r.updateField(FieldYearOfEra, FieldYear, bce)
+	}
+	switch era {
+	case 1:
+		return r.updateField(FieldYearOfEra, FieldYear, yoe)
+	case 0:
+		return r.updateField(FieldYearOfEra, FieldYear, bce)
+	default:
+		return fieldOutOfRangeError(FieldEra, era)
+	}
+}
+
+func (r *fieldResolver) resolveYearMonthDay() (e error) {
+	year, _ := r.take(FieldYear)
+	month, _ := r.take(FieldMonthOfYear)
+	dom, _ := r.take(FieldDayOfMonth)
+	if e = FieldYear.check(year); e != nil {
+		return
+	}
+	if r.style == ResolverStyleLenient {
+		var d LocalDate
+		d, e = MustLocalDateOf(Year(year), January, 1).Chain().
+			PlusMonths(month).MinusMonths(1).
+			PlusDays(dom).MinusDays(1).
+			GetResult()
+		if e != nil {
+			return
+		}
+		return r.updateDate(d)
+	}
+	FieldMonthOfYear.checkSetE(month, &e)
+	FieldDayOfMonth.checkSetE(dom, &e)
+	if e != nil {
+		return
+	}
+	var length = int64(Month(month).Length(Year(year).IsLeapYear()))
+	if dom > length {
+		if r.style == ResolverStyleStrict {
+			return newFieldError(FieldDayOfMonth, "invalid date %s %d of year %d", Month(month), dom, year)
+		}
+		dom = length
+	}
+	return r.updateDate(MustLocalDateOf(Year(year), Month(month), int(dom)))
+}
+
+func (r *fieldResolver) resolveYearDay() (e error) {
+	year, _ := r.take(FieldYear)
+	doy, _ := r.take(FieldDayOfYear)
+	if e = FieldYear.check(year); e != nil {
+		return
+	}
+	if r.style == ResolverStyleLenient {
+		var d LocalDate
+		d, e = mustValue(LocalDateOfYearDay(Year(year), 1)).Chain().PlusDays(doy).MinusDays(1).GetResult()
+		if e != nil {
+			return
+		}
+		return r.updateDate(d)
+	}
+	if e = FieldDayOfYear.check(doy); e != nil {
+		return
+	}
+	if doy > int64(Year(year).Length()) {
+		return newFieldError(FieldDayOfYear, "invalid date day-of-year %d of year %d", doy, year)
+	}
+	return r.updateDate(mustValue(LocalDateOfYearDay(Year(year), int(doy))))
+}
+
+type fieldPart struct {
+	field            Field
+	divisor, modulus int64
+}
+
+// timeFieldParts lists the fields derived from the time fields of a day, in the order of resolution.
+var timeFieldParts = []struct {
+	field Field
+	parts []fieldPart
+}{
+	{FieldNanoOfDay, []fieldPart{{FieldHourOfDay, 3600_000_000_000, 0}, {FieldMinuteOfHour, 60_000_000_000, 60}, {FieldSecondOfMinute, 1_000_000_000, 60}, {FieldNanoOfSecond, 1, 1_000_000_000}}},
+	{FieldMicroOfDay, []fieldPart{{FieldSecondOfDay, 1_000_000, 0}, {FieldMicroOfSecond, 1, 1_000_000}}},
+	{FieldMilliOfDay, []fieldPart{{FieldSecondOfDay, 1000, 0}, {FieldMilliOfSecond, 1, 1000}}},
+	{FieldSecondOfDay, []fieldPart{{FieldHourOfDay, 3600, 0}, {FieldMinuteOfHour, 60, 60}, {FieldSecondOfMinute, 1, 60}}},
+	{FieldMinuteOfDay, []fieldPart{{FieldHourOfDay, 60, 0}, {FieldMinuteOfHour, 1, 60}}},
+}
+
+func (r *fieldResolver) resolveTimeFields() (e error) {
+	var lenient = r.style == ResolverStyleLenient
+	// clock hours, zero is accepted by the smart style as the midnight
+	for _, it := range [...]struct{ field, target Field }{
+		{FieldClockHourOfDay, FieldHourOfDay},
+		{FieldClockHourOfAmPm, FieldHourOfAmPm},
+	} {
+		v, ok := r.take(it.field)
+		if !ok {
+			continue
+		}
+		if r.style == ResolverStyleStrict || r.style == ResolverStyleSmart && v != 0 {
+			if e = it.field.check(v); e != nil {
+				return
+			}
+		}
+		if v == it.field.fieldRange().Max {
+			v = 0
+		}
+		if e = r.updateField(it.field, it.target, v); e != nil {
+			return
+		}
+	}
+	ap, hasAp := r.fields[FieldAmPmOfDay]
+	hap, hasHap := r.fields[FieldHourOfAmPm]
+	if hasAp && hasHap {
+		delete(r.fields, FieldAmPmOfDay)
+		delete(r.fields, FieldHourOfAmPm)
+		if e = r.check(FieldAmPmOfDay, ap); e != nil {
+			return
+		}
+		if e = r.check(FieldHourOfAmPm, hap); e != nil {
+			return
+		}
+		hod, overflow := mulExact(ap, 12)
+		if !overflow {
+			hod, overflow = addExactly(hod, hap)
+		}
+		if overflow {
+			return overflowError()
+		}
+		if e = r.updateField(FieldAmPmOfDay, FieldHourOfDay, hod); e != nil {
+			return
+		}
+	}
+	for _, it := range timeFieldParts {
+		v, ok := r.take(it.field)
+		if !ok {
+			continue
+		}
+		if e = r.check(it.field, v); e != nil {
+			return
+		}
+		for _, part := range it.parts {
+			var value = v / part.divisor
+			if part.modulus > 0 {
+				value %= part.modulus
+			}
+			if e = r.updateField(it.field, part.field, value); e != nil {
+				return
+			}
+		}
+	}
+	// merge the milli-of-second and micro-of-second into the nano-of-second
+	for _, it := range [...]struct{ field, finer Field }{
+		{FieldMilliOfSecond, FieldMicroOfSecond},
+		{FieldMicroOfSecond, FieldNanoOfSecond},
+	} {
+		v, ok := r.take(it.field)
+		if !ok {
+			continue
+		}
+		if e = r.check(it.field, v); e != nil {
+			return
+		}
+		value, overflow := mulExact(v, 1000)
+		if overflow {
+			return overflowError()
+		}
+		if finer, ok := r.fields[it.finer]; ok {
+			value += finer % 1000
+		}
+		if e = r.updateField(it.field, it.finer, value); e != nil {
+			return
+		}
+	}
+	if e = r.resolveTime(); e != nil {
+		return
+	}
+	if !lenient {
+		for field := FieldNanoOfSecond; field <= FieldOffsetSeconds; field++ {
+			if v, ok := r.fields[field]; ok && field.IsTimeBased() {
+				if e = field.check(v); e != nil {
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// resolveTime resolves the hour-of-day, minute-of-hour, second-of-minute and nano-of-second into the time.
+// The minute, second and nano default to zero, as long as no smaller unit is present without a larger one.
+func (r *fieldResolver) resolveTime() (e error) {
+	hod, ok := r.fields[FieldHourOfDay]
+	if !ok {
+		return
+	}
+	moh, hasMoh := r.fields[FieldMinuteOfHour]
+	som, hasSom := r.fields[FieldSecondOfMinute]
+	nos, hasNos := r.fields[FieldNanoOfSecond]
+	if !hasMoh && (hasSom || hasNos) || hasMoh && !hasSom && hasNos {
+		return
+	}
+	for _, field := range [...]Field{FieldHourOfDay, FieldMinuteOfHour, FieldSecondOfMinute, FieldNanoOfSecond} {
+		delete(r.fields, field)
+	}
+	if r.style == ResolverStyleLenient {
+		var total = nos
+		for _, it := range [...][2]int64{{hod, 3600_000_000_000}, {moh, 60_000_000_000}, {som, 1_000_000_000}} {
+			nanos, overflow := mulExact(it[0], it[1])
+			if !overflow {
+				total, overflow = addExactly(total, nanos)
+			}
+			if overflow {
+				return overflowError()
+			}
+		}
+		const nanosPerDay = 86400_000_000_000
+		return r.updateTime(MustLocalTimeOfNanoOfDay(floorMod(total, nanosPerDay)), floorDiv(total, nanosPerDay))
+	}
+	FieldMinuteOfHour.checkSetE(moh, &e)
+	FieldNanoOfSecond.checkSetE(nos, &e)
+	if e != nil {
+		return
+	}
+	if r.style == ResolverStyleSmart && hod == 24 && moh == 0 && som == 0 && nos == 0 {
+		return r.updateTime(MustLocalTimeOf(0, 0, 0, 0), 1)
+	}
+	FieldHourOfDay.checkSetE(hod, &e)
+	FieldSecondOfMinute.checkSetE(som, &e)
+	if e != nil {
+		return
+	}
+	return r.updateTime(MustLocalTimeOf(int(hod), int(moh), int(som), int(nos)), 0)
+}
+
+// crossCheck checks the remaining fields against the resolved date and time, the matching fields are removed.
+func (r *fieldResolver) crossCheck() error {
+	for field := FieldNanoOfSecond; field.Valid(); field++ {
+		v, ok := r.fields[field]
+		if !ok {
+			continue
+		}
+		var resolved TemporalAccessor
+		switch {
+		case !r.date.IsZero() && r.date.IsSupportedField(field):
+			resolved = r.date
+		case !r.time.IsZero() && r.time.IsSupportedField(field):
+			resolved = r.time
+		default:
+			continue
+		}
+		if actual := resolved.GetField(field).Int64(); actual != v {
+			return newFieldError(field, "conflict found: field %s %d differs from %s %d derived from %s", field, v, field, actual, resolved)
+		}
+		delete(r.fields, field)
+	}
+	return nil
+}
+
+// resolveExcessDays adds the excess days of the time to the date.
+func (r *fieldResolver) resolveExcessDays() (e error) {
+	if r.date.IsZero() || r.time.IsZero() || r.excessDays == 0 {
+		return
+	}
+	if r.date, e = r.date.Chain().PlusDays(r.excessDays).GetResult(); e != nil {
+		return
+	}
+	r.excessDays = 0
+	return
+}
+
+func (r *fieldResolver) result() (p dateTimeParsed, e error) {
+	p.date, p.time, p.zone = r.date, r.time, r.zone
+	if !r.date.IsZero() {
+		p.yearMonth = r.date.YearMonth()
+	} else if year, ok := r.fields[FieldYear]; ok {
+		if month, ok := r.fields[FieldMonthOfYear]; ok {
+			if e = FieldYear.check(year); e != nil {
+				return
+			}
+			if r.style == ResolverStyleLenient {
+				p.yearMonth, e = MustYearMonthOf(Year(year), January).Chain().PlusMonths(month).MinusMonths(1).GetResult()
+			} else {
+				FieldMonthOfYear.checkSetE(month, &e)
+				if e == nil {
+					p.yearMonth = MustYearMonthOf(Year(year), Month(month))
+				}
+			}
+			if e != nil {
+				return
+			}
+		}
+	}
+	if v, ok := r.fields[FieldOffsetSeconds]; ok {
+		if e = FieldOffsetSeconds.check(v); e != nil {
+			return
+		}
+		p.offset, p.hasOffset = MustZoneOffsetOfSeconds(int(v)), true
+	}
+	if secs, ok := r.fields[FieldInstantSeconds]; ok && r.date.IsZero() {
+		if p.instant, e = InstantOfEpochSecond(secs, r.fields[FieldNanoOfSecond]); e != nil {
+			return
+		}
+	}
+	return
+}
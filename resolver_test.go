@@ -0,0 +1,177 @@
+package goda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolverStyle(t *testing.T) {
+	assert.Equal(t, "Strict", ResolverStyleStrict.String())
+	assert.Equal(t, "Smart", ResolverStyleSmart.String())
+	assert.Equal(t, "Lenient", ResolverStyleLenient.String())
+	assert.Equal(t, "UnknownResolverStyle(0)", ResolverStyle(0).String())
+	assert.False(t, ResolverStyle(4).Valid())
+
+	var f = MustDateTimeFormatterOfPattern("yyyy-MM-dd")
+	assert.Equal(t, ResolverStyleSmart, f.ResolverStyle())
+	assert.Equal(t, ResolverStyleLenient, f.WithResolverStyle(ResolverStyleLenient).ResolverStyle())
+	assert.Equal(t, ResolverStyleSmart, f.ResolverStyle())
+}
+
+func TestDateTimeFormatter_ResolverStyle(t *testing.T) {
+	for _, it := range []struct {
+		pattern  string
+		text     string
+		style    ResolverStyle
+		expected string
+	}{
+		{"uuuu-MM-dd", "2024-02-30", ResolverStyleStrict, ""},
+		{"uuuu-MM-dd", "2024-02-30", ResolverStyleSmart, "2024-02-29"},
+		{"uuuu-MM-dd", "2023-02-30", ResolverStyleSmart, "2023-02-28"},
+		{"uuuu-MM-dd", "2024-04-31", ResolverStyleSmart, "2024-04-30"},
+		{"uuuu-MM-dd", "2024-02-32", ResolverStyleSmart, ""},
+		{"uuuu-MM-dd", "2024-02-30", ResolverStyleLenient, "2024-03-01"},
+		{"uuuu-MM-dd", "2024-13-01", ResolverStyleStrict, ""},
+		{"uuuu-MM-dd", "2024-13-01", ResolverStyleSmart, ""},
+		{"uuuu-MM-dd", "2024-13-01", ResolverStyleLenient, "2025-01-01"},
+		{"uuuu-MM-dd", "2024-00-00", ResolverStyleLenient, "2023-11-30"},
+		{"uuuu-DDD", "2023-366", ResolverStyleSmart, ""},
+		{"uuuu-DDD", "2023-366", ResolverStyleLenient, "2024-01-01"},
+		{"yyyy-MM-dd", "2024-03-15", ResolverStyleStrict, ""},
+		{"yyyy-MM-dd G", "2024-03-15 AD", ResolverStyleStrict, "2024-03-15"},
+		{"yyyy-MM-dd G", "2024-03-15 BC", ResolverStyleStrict, "-2023-03-15"},
+		{"yyyy-MM-dd uuuu", "2024-03-15 2024", ResolverStyleStrict, "2024-03-15"},
+		{"yyyy-MM-dd", "2024-03-15", ResolverStyleSmart, "2024-03-15"},
+	} {
+		var f = MustDateTimeFormatterOfPattern(it.pattern).WithResolverStyle(it.style)
+		d, err := f.ParseLocalDate(it.text)
+		if it.expected == "" {
+			assert.Error(t, err, "%s %s %s", it.pattern, it.text, it.style)
+			continue
+		}
+		require.NoError(t, err, "%s %s %s", it.pattern, it.text, it.style)
+		assert.Equal(t, it.expected, d.String(), "%s %s %s", it.pattern, it.text, it.style)
+	}
+
+	for _, it := range []struct {
+		pattern  string
+		text     string
+		style    ResolverStyle
+		expected string
+	}{
+		{"uuuu-MM-dd HH:mm", "2024-03-15 24:00", ResolverStyleStrict, ""},
+		{"uuuu-MM-dd HH:mm", "2024-03-15 24:00", ResolverStyleSmart, "2024-03-16T00:00:00"},
+		{"uuuu-MM-dd HH:mm", "2024-03-15 24:01", ResolverStyleSmart, ""},
+		{"uuuu-MM-dd HH:mm", "2024-03-15 24:00", ResolverStyleLenient, "2024-03-16T00:00:00"},
+		{"uuuu-MM-dd HH:mm", "2024-03-15 25:70", ResolverStyleLenient, "2024-03-16T02:10:00"},
+		{"uuuu-MM-dd HH:mm", "2024-12-31 24:00", ResolverStyleSmart, "2025-01-01T00:00:00"},
+		{"uuuu-MM-dd hh:mm a", "2024-03-15 00:30 AM", ResolverStyleStrict, ""},
+		{"uuuu-MM-dd hh:mm a", "2024-03-15 00:30 AM", ResolverStyleSmart, "2024-03-15T00:30:00"},
+		{"uuuu-MM-dd hh:mm a", "2024-03-15 12:30 PM", ResolverStyleStrict, "2024-03-15T12:30:00"},
+		{"uuuu-MM-dd hh:mm a", "2024-03-15 13:30 PM", ResolverStyleLenient, "2024-03-16T01:30:00"},
+		{"uuuu-MM-dd kk:mm", "2024-03-15 24:30", ResolverStyleStrict, "2024-03-15T00:30:00"},
+	} {
+		var f = MustDateTimeFormatterOfPattern(it.pattern).WithResolverStyle(it.style)
+		ldt, err := f.ParseLocalDateTime(it.text)
+		if it.expected == "" {
+			assert.Error(t, err, "%s %s %s", it.pattern, it.text, it.style)
+			continue
+		}
+		require.NoError(t, err, "%s %s %s", it.pattern, it.text, it.style)
+		assert.Equal(t, it.expected, ldt.String(), "%s %s %s", it.pattern, it.text, it.style)
+	}
+
+	// the excess day is dropped without a date
+	lt, err := MustDateTimeFormatterOfPattern("HH:mm").ParseLocalTime("24:00")
+	require.NoError(t, err)
+	assert.Equal(t, "00:00:00", lt.String())
+
+	ym, err := MustDateTimeFormatterOfPattern("uuuu-MM").WithResolverStyle(ResolverStyleLenient).ParseYearMonth("2024-14")
+	require.NoError(t, err)
+	assert.Equal(t, "2025-02", ym.String())
+
+	_, err = MustDateTimeFormatterOfPattern("uuuu-MM-dd").WithResolverStyle(ResolverStyle(9)).ParseLocalDate("2024-03-15")
+	assert.Error(t, err)
+}
+
+func TestResolveFields(t *testing.T) {
+	var v = TemporalValueOf[int64]
+	for _, it := range []struct {
+		fields   map[Field]TemporalValue
+		style    ResolverStyle
+		expected TemporalAccessor
+	}{
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldMonthOfYear: v(3), FieldDayOfMonth: v(15)}, ResolverStyleStrict, MustLocalDateOf(2024, March, 15)},
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldMonthOfYear: v(2), FieldDayOfMonth: v(30)}, ResolverStyleSmart, MustLocalDateOf(2024, February, 29)},
+		{map[Field]TemporalValue{FieldEpochDay: v(19797)}, ResolverStyleStrict, MustLocalDateOf(2024, March, 15)},
+		{map[Field]TemporalValue{FieldProlepticMonth: v(2024*12 + 2), FieldDayOfMonth: v(15)}, ResolverStyleStrict, MustLocalDateOf(2024, March, 15)},
+		{map[Field]TemporalValue{FieldProlepticMonth: v(2024*12 + 2)}, ResolverStyleStrict, MustYearMonthOf(2024, March)},
+		{map[Field]TemporalValue{FieldYearOfEra: v(2024), FieldEra: v(1), FieldDayOfYear: v(75)}, ResolverStyleStrict, MustLocalDateOf(2024, March, 15)},
+		{map[Field]TemporalValue{FieldYearOfEra: v(1), FieldEra: v(0), FieldMonthOfYear: v(1), FieldDayOfMonth: v(1)}, ResolverStyleStrict, MustLocalDateOf(0, January, 1)},
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldMonthOfYear: v(3), FieldDayOfMonth: v(15), FieldDayOfWeek: v(5)}, ResolverStyleStrict, MustLocalDateOf(2024, March, 15)},
+		{map[Field]TemporalValue{FieldClockHourOfAmPm: v(2), FieldAmPmOfDay: v(1), FieldMinuteOfHour: v(30)}, ResolverStyleStrict, MustLocalTimeOf(14, 30, 0, 0)},
+		{map[Field]TemporalValue{FieldHourOfDay: v(14), FieldAmPmOfDay: v(1)}, ResolverStyleStrict, MustLocalTimeOf(14, 0, 0, 0)},
+		{map[Field]TemporalValue{FieldSecondOfDay: v(52200), FieldMilliOfSecond: v(123)}, ResolverStyleStrict, MustLocalTimeOf(14, 30, 0, 123_000_000)},
+		{map[Field]TemporalValue{FieldNanoOfDay: v(52200_000_000_123)}, ResolverStyleStrict, MustLocalTimeOf(14, 30, 0, 123)},
+		{map[Field]TemporalValue{FieldMilliOfSecond: v(123), FieldMicroOfSecond: v(123456), FieldHourOfDay: v(1), FieldMinuteOfHour: v(0), FieldSecondOfMinute: v(0)}, ResolverStyleStrict, MustLocalTimeOf(1, 0, 0, 123_456_000)},
+		{map[Field]TemporalValue{FieldEpochDay: v(19797), FieldMinuteOfDay: v(870)}, ResolverStyleStrict, MustLocalDateTimeOf(2024, March, 15, 14, 30, 0, 0)},
+		{map[Field]TemporalValue{FieldEpochDay: v(19797), FieldHourOfDay: v(14), FieldOffsetSeconds: v(3600)}, ResolverStyleStrict, MustLocalDateTimeOf(2024, March, 15, 14, 0, 0, 0).AtOffset(MustZoneOffsetOfSeconds(3600))},
+		{map[Field]TemporalValue{FieldInstantSeconds: v(1710513000), FieldOffsetSeconds: v(3600)}, ResolverStyleStrict, MustLocalDateTimeOf(2024, March, 15, 15, 30, 0, 0).AtOffset(MustZoneOffsetOfSeconds(3600))},
+		{map[Field]TemporalValue{FieldInstantSeconds: v(1710513000), FieldNanoOfSecond: v(5)}, ResolverStyleStrict, mustValue(InstantOfEpochSecond(1710513000, 5))},
+		{map[Field]TemporalValue{FieldOffsetSeconds: v(-3600)}, ResolverStyleStrict, MustZoneOffsetOfSeconds(-3600)},
+		{map[Field]TemporalValue{FieldProlepticMonth: v(2024 * 12), FieldDayOfMonth: v(0), FieldHourOfDay: v(-1)}, ResolverStyleLenient, MustLocalDateTimeOf(2023, December, 30, 23, 0, 0, 0)},
+	} {
+		actual, err := ResolveFields(it.fields, it.style)
+		require.NoError(t, err, "%v", it.fields)
+		assert.Equal(t, it.expected, actual, "%v", it.fields)
+	}
+}
+
+func TestResolveFields_Error(t *testing.T) {
+	var v = TemporalValueOf[int64]
+	for _, it := range []struct {
+		fields map[Field]TemporalValue
+		style  ResolverStyle
+		field  Field
+	}{
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldMonthOfYear: v(2), FieldDayOfMonth: v(30)}, ResolverStyleStrict, FieldDayOfMonth},
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldMonthOfYear: v(13), FieldDayOfMonth: v(1)}, ResolverStyleSmart, FieldMonthOfYear},
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldMonthOfYear: v(3), FieldDayOfMonth: v(15), FieldDayOfWeek: v(1)}, ResolverStyleSmart, FieldDayOfWeek},
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldMonthOfYear: v(3), FieldDayOfMonth: v(15), FieldEpochDay: v(0)}, ResolverStyleSmart, FieldDayOfMonth},
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldYearOfEra: v(2025), FieldEra: v(1)}, ResolverStyleSmart, FieldYear},
+		{map[Field]TemporalValue{FieldYearOfEra: v(2024), FieldEra: v(2)}, ResolverStyleLenient, FieldEra},
+		{map[Field]TemporalValue{FieldProlepticMonth: v(2024*12 + 2), FieldMonthOfYear: v(4)}, ResolverStyleSmart, FieldMonthOfYear},
+		{map[Field]TemporalValue{FieldHourOfDay: v(14), FieldAmPmOfDay: v(0)}, ResolverStyleSmart, FieldAmPmOfDay},
+		{map[Field]TemporalValue{FieldHourOfDay: v(14), FieldClockHourOfAmPm: v(3), FieldAmPmOfDay: v(1)}, ResolverStyleSmart, FieldHourOfDay},
+		{map[Field]TemporalValue{FieldClockHourOfAmPm: v(13), FieldAmPmOfDay: v(1)}, ResolverStyleSmart, FieldClockHourOfAmPm},
+		{map[Field]TemporalValue{FieldHourOfDay: v(1), FieldMinuteOfHour: v(60)}, ResolverStyleSmart, FieldMinuteOfHour},
+		{map[Field]TemporalValue{FieldSecondOfDay: v(3600), FieldHourOfDay: v(2)}, ResolverStyleSmart, FieldHourOfDay},
+		{map[Field]TemporalValue{FieldMilliOfSecond: v(123), FieldMicroOfSecond: v(124000)}, ResolverStyleSmart, FieldMicroOfSecond},
+		{map[Field]TemporalValue{FieldMinuteOfHour: v(61)}, ResolverStyleSmart, FieldMinuteOfHour},
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldDayOfYear: v(367)}, ResolverStyleSmart, FieldDayOfYear},
+		{map[Field]TemporalValue{FieldYear: v(2023), FieldDayOfYear: v(366)}, ResolverStyleStrict, FieldDayOfYear},
+		{map[Field]TemporalValue{FieldYear: v(2024), FieldMonthOfYear: v(3), FieldDayOfMonth: {unsupported: true}}, ResolverStyleSmart, FieldDayOfMonth},
+	} {
+		_, err := ResolveFields(it.fields, it.style)
+		var target *Error
+		require.True(t, errors.As(err, &target), "%v %v", it.fields, err)
+		assert.Equal(t, it.field, target.Field(), "%v %v", it.fields, err)
+	}
+
+	for _, fields := range []map[Field]TemporalValue{
+		{},
+		{FieldMinuteOfHour: TemporalValueOf(30)},
+		{FieldYear: TemporalValueOf(2024)},
+		{FieldYearOfEra: TemporalValueOf(2024), FieldMonthOfYear: TemporalValueOf(3), FieldDayOfMonth: TemporalValueOf(15)},
+	} {
+		_, err := ResolveFields(fields, ResolverStyleStrict)
+		assert.Error(t, err, "%v", fields)
+	}
+	_, err := ResolveFields(map[Field]TemporalValue{Field(0): TemporalValueOf(1)}, ResolverStyleSmart)
+	assert.Error(t, err)
+	_, err = ResolveFields(map[Field]TemporalValue{FieldYear: TemporalValueOf(1)}, ResolverStyle(0))
+	assert.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package goda
+
+// TemporalAdjuster adjusts a date, such as to the last day of the month or the next Monday.
+// It is accepted by the With method of LocalDateChain, LocalDateTimeChain and OffsetDateTimeChain,
+// which keep the time and the offset.
+//
+// The standard adjusters are returned by functions such as LastDayOfMonth and Next,
+// TemporalAdjusterFunc turns a function into an adjuster.
+//
+// This is similar to Java's TemporalAdjuster and TemporalAdjusters.
+type TemporalAdjuster interface {
+	// AdjustDate returns the adjusted date, the date passed in is never zero.
+	AdjustDate(date LocalDate) (LocalDate, error)
+}
+
+// TemporalAdjusterFunc is a function implementing TemporalAdjuster.
+type TemporalAdjusterFunc func(date LocalDate) (LocalDate, error)
+
+// AdjustDate calls f(date).
+func (f TemporalAdjusterFunc) AdjustDate(date LocalDate) (LocalDate, error) {
+	return f(date)
+}
+
+// FirstDayOfMonth returns an adjuster to the first day of the month, such as 2024-03-15 to 2024-03-01.
+func FirstDayOfMonth() TemporalAdjuster {
+	return TemporalAdjusterFunc(func(date LocalDate) (LocalDate, error) {
+		return date.Chain().WithDayOfMonth(1).GetResult()
+	})
+}
+
+// LastDayOfMonth returns an adjuster to the last day of the month, such as 2024-02-15 to 2024-02-29.
+func LastDayOfMonth() TemporalAdjuster {
+	return TemporalAdjusterFunc(func(date LocalDate) (LocalDate, error) {
+		return date.Chain().WithDayOfMonth(date.LengthOfMonth()).GetResult()
+	})
+}
+
+// FirstDayOfNextMonth returns an adjuster to the first day of the next month, such as 2024-12-15 to 2025-01-01.
+func FirstDayOfNextMonth() TemporalAdjuster {
+	return TemporalAdjusterFunc(func(date LocalDate) (LocalDate, error) {
+		return date.Chain().WithDayOfMonth(1).PlusMonths(1).GetResult()
+	})
+}
+
+// FirstDayOfYear returns an adjuster to the first day of the year, such as 2024-03-15 to 2024-01-01.
+func FirstDayOfYear() TemporalAdjuster {
+	return TemporalAdjusterFunc(func(date LocalDate) (LocalDate, error) {
+		return date.Chain().WithDayOfYear(1).GetResult()
+	})
+}
+
+// LastDayOfYear returns an adjuster to the last day of the year, such as 2024-03-15 to 2024-12-31.
+func LastDayOfYear() TemporalAdjuster {
+	return TemporalAdjusterFunc(func(date LocalDate) (LocalDate, error) {
+		return date.Chain().WithDayOfYear(date.LengthOfYear()).GetResult()
+	})
+}
+
+// FirstDayOfNextYear returns an adjuster to the first day of the next year, such as 2024-03-15 to 2025-01-01.
+func FirstDayOfNextYear() TemporalAdjuster {
+	return TemporalAdjusterFunc(func(date LocalDate) (LocalDate, error) {
+		return date.Chain().WithDayOfYear(1).PlusYears(1).GetResult()
+	})
+}
+
+// FirstInMonth returns an adjuster to the first day-of-week in the month,
+// such as the first Monday of 2024-03 is 2024-03-04.
+func FirstInMonth(dayOfWeek DayOfWeek) TemporalAdjuster {
+	return DayOfWeekInMonth(1, dayOfWeek)
+}
+
+// LastInMonth returns an adjuster to the last day-of-week in the month,
+// such as the last Friday of 2024-03 is 2024-03-29.
+func LastInMonth(dayOfWeek DayOfWeek) TemporalAdjuster {
+	return DayOfWeekInMonth(-1, dayOfWeek)
+}
+
+// DayOfWeekInMonth returns an adjuster to the ordinal day-of-week in the month,
+// such as DayOfWeekInMonth(2, Tuesday) for the second Tuesday.
+//
+// A positive ordinal counts from the start of the month, a negative ordinal counts from the end,
+// such as -1 for the last. Ordinals beyond the month move into the next or previous months,
+// 0 is the last day-of-week of the previous month, as in Java.
+func DayOfWeekInMonth(ordinal int, dayOfWeek DayOfWeek) TemporalAdjuster {
+	return TemporalAdjusterFunc(func(date LocalDate) (r LocalDate, e error) {
+		if e = FieldDayOfWeek.check(int64(dayOfWeek)); e != nil {
+			return
+		}
+		if ordinal >= 0 {
+			var first = date.Chain().WithDayOfMonth(1).MustGet()
+			var diff = int64(dayOfWeek-first.DayOfWeek()+7) % 7
+			return first.Chain().PlusDays(diff).PlusWeeks(int64(ordinal) - 1).GetResult()
+		}
+		var last = date.Chain().WithDayOfMonth(date.LengthOfMonth()).MustGet()
+		var diff = int64(dayOfWeek - last.DayOfWeek())
+		if diff > 0 {
+			diff -= 7
+		}
+		return last.Chain().PlusDays(diff).PlusWeeks(int64(ordinal) + 1).GetResult()
+	})
+}
+
+// Next returns an adjuster to the next day-of-week after the date, such as the next Monday of 2024-03-18 (Monday) is 2024-03-25.
+func Next(dayOfWeek DayOfWeek) TemporalAdjuster {
+	return relativeDayOfWeek(dayOfWeek, 1, false)
+}
+
+// NextOrSame returns an adjuster to the next day-of-week, or the date itself if it's the same day-of-week.
+func NextOrSame(dayOfWeek DayOfWeek) TemporalAdjuster {
+	return relativeDayOfWeek(dayOfWeek, 1, true)
+}
+
+// Previous returns an adjuster to the previous day-of-week before the date, such as the previous Monday of 2024-03-18 (Monday) is 2024-03-11.
+func Previous(dayOfWeek DayOfWeek) TemporalAdjuster {
+	return relativeDayOfWeek(dayOfWeek, -1, false)
+}
+
+// PreviousOrSame returns an adjuster to the previous day-of-week, or the date itself if it's the same day-of-week.
+func PreviousOrSame(dayOfWeek DayOfWeek) TemporalAdjuster {
+	return relativeDayOfWeek(dayOfWeek, -1, true)
+}
+
+// relativeDayOfWeek moves the date forward (direction 1) or backward (direction -1) to the day-of-week.
+func relativeDayOfWeek(dayOfWeek DayOfWeek, direction int64, orSame bool) TemporalAdjuster {
+	return TemporalAdjusterFunc(func(date LocalDate) (r LocalDate, e error) {
+		if e = FieldDayOfWeek.check(int64(dayOfWeek)); e != nil {
+			return
+		}
+		var diff = floorMod(direction*int64(dayOfWeek-date.DayOfWeek()), 7)
+		if diff == 0 {
+			if orSame {
+				return date, nil
+			}
+			diff = 7
+		}
+		return date.Chain().PlusDays(direction * diff).GetResult()
+	})
+}
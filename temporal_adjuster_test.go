@@ -0,0 +1,86 @@
+package goda
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemporalAdjuster(t *testing.T) {
+	for _, it := range []struct {
+		date     string
+		adjuster TemporalAdjuster
+		expected string
+	}{
+		{"2024-03-15", FirstDayOfMonth(), "2024-03-01"},
+		{"2024-02-15", LastDayOfMonth(), "2024-02-29"},
+		{"2023-02-15", LastDayOfMonth(), "2023-02-28"},
+		{"2024-12-15", FirstDayOfNextMonth(), "2025-01-01"},
+		{"2024-01-31", FirstDayOfNextMonth(), "2024-02-01"},
+		{"2024-03-15", FirstDayOfYear(), "2024-01-01"},
+		{"2024-03-15", LastDayOfYear(), "2024-12-31"},
+		{"2023-03-15", LastDayOfYear(), "2023-12-31"},
+		{"2024-03-15", FirstDayOfNextYear(), "2025-01-01"},
+		{"2024-03-15", FirstInMonth(Monday), "2024-03-04"},
+		{"2024-03-15", FirstInMonth(Friday), "2024-03-01"},
+		{"2024-03-15", LastInMonth(Friday), "2024-03-29"},
+		{"2024-03-15", LastInMonth(Sunday), "2024-03-31"},
+		{"2024-03-15", DayOfWeekInMonth(2, Tuesday), "2024-03-12"},
+		{"2024-03-15", DayOfWeekInMonth(5, Friday), "2024-03-29"},
+		{"2024-03-15", DayOfWeekInMonth(6, Friday), "2024-04-05"},
+		{"2024-03-15", DayOfWeekInMonth(0, Friday), "2024-02-23"},
+		{"2024-03-15", DayOfWeekInMonth(-2, Friday), "2024-03-22"},
+		{"2024-03-18", Next(Monday), "2024-03-25"},
+		{"2024-03-18", Next(Tuesday), "2024-03-19"},
+		{"2024-03-18", Next(Sunday), "2024-03-24"},
+		{"2024-03-18", NextOrSame(Monday), "2024-03-18"},
+		{"2024-03-18", NextOrSame(Wednesday), "2024-03-20"},
+		{"2024-03-18", Previous(Monday), "2024-03-11"},
+		{"2024-03-18", Previous(Sunday), "2024-03-17"},
+		{"2024-03-18", Previous(Tuesday), "2024-03-12"},
+		{"2024-03-18", PreviousOrSame(Monday), "2024-03-18"},
+		{"2024-03-18", PreviousOrSame(Friday), "2024-03-15"},
+	} {
+		actual, err := MustLocalDateParse(it.date).Chain().With(it.adjuster).GetResult()
+		require.NoError(t, err, "%s %s", it.date, it.expected)
+		assert.Equal(t, it.expected, actual.String(), "%s %s", it.date, it.expected)
+	}
+}
+
+func TestTemporalAdjuster_Error(t *testing.T) {
+	var d = MustLocalDateParse("2024-03-15")
+	for _, adjuster := range []TemporalAdjuster{
+		nil,
+		Next(DayOfWeek(0)),
+		PreviousOrSame(DayOfWeek(8)),
+		FirstInMonth(DayOfWeek(0)),
+		TemporalAdjusterFunc(func(LocalDate) (LocalDate, error) { return LocalDate{}, errors.New("failed") }),
+	} {
+		_, err := d.Chain().With(adjuster).GetResult()
+		assert.Error(t, err)
+	}
+	_, err := LocalDateMax().Chain().With(Next(Monday)).GetResult()
+	assert.Error(t, err)
+	_, err = LocalDateMax().Chain().With(FirstDayOfNextMonth()).GetResult()
+	assert.Error(t, err)
+
+	// zero values stay zero
+	assert.True(t, LocalDate{}.Chain().With(LastDayOfMonth()).MustGet().IsZero())
+}
+
+func TestTemporalAdjuster_Chain(t *testing.T) {
+	// last business day of the month
+	var lastBusinessDay = TemporalAdjusterFunc(func(date LocalDate) (LocalDate, error) {
+		return date.Chain().With(LastDayOfMonth()).With(PreviousOrSame(Friday)).GetResult()
+	})
+	ldt := MustLocalDateTimeParse("2024-03-15T14:30:00").Chain().With(lastBusinessDay).MustGet()
+	assert.Equal(t, "2024-03-29T14:30:00", ldt.String())
+
+	odt := MustOffsetDateTimeParse("2024-06-15T14:30:00+08:00").Chain().With(lastBusinessDay).MustGet()
+	assert.Equal(t, "2024-06-28T14:30:00+08:00", odt.String())
+
+	_, err := MustOffsetDateTimeParse("2024-06-15T14:30:00+08:00").Chain().With(nil).GetResult()
+	assert.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package goda
+
+// TemporalAmount is an amount of time, such as "6 hours", "8 days" or "2 years and 3 months".
+// It is implemented by Duration (time-based) and Period (date-based),
+// and is accepted by the Plus and Minus methods of the chains.
+//
+// Adding an amount to a type that does not support all of its non-zero parts returns an error
+// wrapping ErrUnsupported, for example adding a Period with days to a LocalTime.
+// A nil amount or a zero value amount is treated as zero length.
+//
+// This is similar to Java's TemporalAmount interface, it cannot be implemented outside this package.
+type TemporalAmount interface {
+	// IsZero returns true if this amount is a zero/unset value.
+	IsZero() bool
+
+	// String returns the ISO 8601 representation of this amount.
+	String() string
+
+	temporalAmount()
+}
+
+func (d Duration) temporalAmount() {}
+
+func (p Period) temporalAmount() {}
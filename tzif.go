@@ -0,0 +1,213 @@
+package goda
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// tzifError creates an error about invalid TZif data.
+func tzifError(format string, a ...any) error {
+	return newError("invalid TZif data, "+format, a...)
+}
+
+// checkTZif validates TZif data of version 1 to 4, see RFC 8536.
+// For version 2 and later, the 64-bit data block and the footer are checked.
+// The data is loaded by time.LoadLocationFromTZData, which tolerates some malformed files silently.
+func checkTZif(data []byte) (e error) {
+	if len(data) < 44 || string(data[:4]) != "TZif" {
+		return tzifError("bad magic")
+	}
+	var version = data[4]
+	if version != 0 && (version < '2' || version > '4') {
+		return tzifError("unsupported version %q", version)
+	}
+	var h tzifHeader
+	var rest []byte
+	if h, rest, e = readTZifHeader(data); e != nil {
+		return
+	}
+	if version == 0 {
+		_, e = checkTZifBlock(h, rest, 4)
+		return
+	}
+	// skip the version 1 data block
+	if len(rest) < h.blockLength(4) {
+		return tzifError("truncated data")
+	}
+	if h, rest, e = readTZifHeader(rest[h.blockLength(4):]); e != nil {
+		return
+	}
+	if rest, e = checkTZifBlock(h, rest, 8); e != nil {
+		return
+	}
+	if len(rest) < 2 || rest[0] != '\n' {
+		return tzifError("missing footer")
+	}
+	end := strings.IndexByte(string(rest[1:]), '\n')
+	if end < 0 {
+		return tzifError("missing footer")
+	}
+	if footer := string(rest[1 : end+1]); footer != "" && !checkTZRule(footer) {
+		return tzifError("bad footer %q", footer)
+	}
+	return
+}
+
+type tzifHeader struct {
+	isutcnt, isstdcnt, leapcnt, timecnt, typecnt, charcnt int
+}
+
+func readTZifHeader(data []byte) (h tzifHeader, rest []byte, e error) {
+	if len(data) < 44 || string(data[:4]) != "TZif" {
+		return h, nil, tzifError("bad header")
+	}
+	var count = func(i int) int {
+		return int(binary.BigEndian.Uint32(data[20+i*4:]))
+	}
+	h = tzifHeader{count(0), count(1), count(2), count(3), count(4), count(5)}
+	if h.typecnt == 0 || h.charcnt == 0 ||
+		(h.isutcnt != 0 && h.isutcnt != h.typecnt) || (h.isstdcnt != 0 && h.isstdcnt != h.typecnt) {
+		return h, nil, tzifError("bad counts")
+	}
+	return h, data[44:], nil
+}
+
+// blockLength returns the length of the data block with the size of the times.
+func (h tzifHeader) blockLength(timeSize int) int {
+	return h.timecnt*timeSize + h.timecnt + h.typecnt*6 + h.charcnt + h.leapcnt*(timeSize+4) + h.isstdcnt + h.isutcnt
+}
+
+// checkTZifBlock checks the transitions and the local time types of the data block, and returns the rest of the data.
+func checkTZifBlock(h tzifHeader, data []byte, timeSize int) (rest []byte, e error) {
+	if len(data) < h.blockLength(timeSize) {
+		return nil, tzifError("truncated data")
+	}
+	var last int64
+	for i := range h.timecnt {
+		var transition int64
+		if timeSize == 4 {
+			transition = int64(int32(binary.BigEndian.Uint32(data[i*4:])))
+		} else {
+			transition = int64(binary.BigEndian.Uint64(data[i*8:]))
+		}
+		if i > 0 && transition <= last {
+			return nil, tzifError("transitions not in ascending order")
+		}
+		last = transition
+	}
+	var indices = data[h.timecnt*timeSize : h.timecnt*timeSize+h.timecnt]
+	for _, index := range indices {
+		if int(index) >= h.typecnt {
+			return nil, tzifError("bad local time type index %d", index)
+		}
+	}
+	var types = data[h.timecnt*timeSize+h.timecnt:]
+	for i := range h.typecnt {
+		// utoff, isdst and the index of the abbreviation
+		if b := types[i*6:]; b[4] > 1 || int(b[5]) >= h.charcnt {
+			return nil, tzifError("bad local time type %d", i)
+		}
+	}
+	return data[h.blockLength(timeSize):], nil
+}
+
+// checkTZRule checks a POSIX TZ string, such as "CET-1CEST,M3.5.0,M10.5.0/3", with the extensions of RFC 8536.
+// A rule without transition dates is accepted, Go uses the US rules for it.
+func checkTZRule(s string) bool {
+	var ok bool
+	if s, ok = skipTZRuleName(s); !ok {
+		return false
+	}
+	if s, ok = skipTZRuleOffset(s, 24); !ok {
+		return false
+	}
+	if s == "" {
+		return true
+	}
+	if s, ok = skipTZRuleName(s); !ok {
+		return false
+	}
+	if s != "" && s[0] != ',' {
+		if s, ok = skipTZRuleOffset(s, 24); !ok {
+			return false
+		}
+	}
+	if s == "" {
+		return true
+	}
+	if s[0] != ',' {
+		return false
+	}
+	if s, ok = skipTZRuleDate(s[1:]); !ok || s == "" || s[0] != ',' {
+		return false
+	}
+	s, ok = skipTZRuleDate(s[1:])
+	return ok && s == ""
+}
+
+// skipTZRuleName skips an abbreviation, either alphabetic or quoted by angle brackets such as "<+08>".
+func skipTZRuleName(s string) (rest string, ok bool) {
+	if strings.HasPrefix(s, "<") {
+		end := strings.IndexByte(s, '>')
+		if end < 0 {
+			return s, false
+		}
+		return s[end+1:], true
+	}
+	var i = 0
+	for i < len(s) && (s[i] >= 'a' && s[i] <= 'z' || s[i] >= 'A' && s[i] <= 'Z') {
+		i++
+	}
+	return s[i:], i >= 3
+}
+
+// skipTZRuleOffset skips [+|-]hh[:mm[:ss]], the hours are at most maxHours.
+func skipTZRuleOffset(s string, maxHours int) (rest string, ok bool) {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	if s, ok = skipTZRuleNumber(s, 0, maxHours); !ok {
+		return
+	}
+	for range 2 {
+		if !strings.HasPrefix(s, ":") {
+			break
+		}
+		if s, ok = skipTZRuleNumber(s[1:], 0, 59); !ok {
+			return
+		}
+	}
+	return s, true
+}
+
+// skipTZRuleNumber skips a decimal number of at most 3 digits in the range.
+func skipTZRuleNumber(s string, min, max int) (rest string, ok bool) {
+	var n, digits = 0, 0
+	for digits < len(s) && digits < 3 && s[digits] >= '0' && s[digits] <= '9' {
+		n = n*10 + int(s[digits]-'0')
+		digits++
+	}
+	return s[digits:], digits > 0 && n >= min && n <= max
+}
+
+// skipTZRuleDate skips Jn, n or Mm.w.d, followed by an optional /time from -167 to 167 hours.
+func skipTZRuleDate(s string) (rest string, ok bool) {
+	switch {
+	case strings.HasPrefix(s, "J"):
+		s, ok = skipTZRuleNumber(s[1:], 1, 365)
+	case strings.HasPrefix(s, "M"):
+		if s, ok = skipTZRuleNumber(s[1:], 1, 12); !ok || !strings.HasPrefix(s, ".") {
+			return s, false
+		}
+		if s, ok = skipTZRuleNumber(s[1:], 1, 5); !ok || !strings.HasPrefix(s, ".") {
+			return s, false
+		}
+		s, ok = skipTZRuleNumber(s[1:], 0, 6)
+	default:
+		s, ok = skipTZRuleNumber(s, 0, 365)
+	}
+	if ok && strings.HasPrefix(s, "/") {
+		s, ok = skipTZRuleOffset(s[1:], 167)
+	}
+	return s, ok
+}
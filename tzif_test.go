@@ -0,0 +1,75 @@
+package goda
+
+import (
+	"archive/zip"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testZoneinfoFS returns the zoneinfo of the host, or of Go, skips the test if neither is available.
+func testZoneinfoFS(t *testing.T) fs.FS {
+	t.Helper()
+	if _, e := os.Stat("/usr/share/zoneinfo/UTC"); e == nil {
+		return os.DirFS("/usr/share/zoneinfo")
+	}
+	if out, e := exec.Command("go", "env", "GOROOT").Output(); e == nil {
+		if r, e := zip.OpenReader(filepath.Join(strings.TrimSpace(string(out)), "lib", "time", "zoneinfo.zip")); e == nil {
+			t.Cleanup(func() { _ = r.Close() })
+			return r
+		}
+	}
+	t.Skip("no zoneinfo available")
+	return nil
+}
+
+func TestCheckTZif(t *testing.T) {
+	var fsys = testZoneinfoFS(t)
+	for _, id := range []string{
+		"UTC", "Europe/Paris", "Europe/London", "Europe/Dublin", "America/New_York", "America/Sao_Paulo",
+		"America/Santiago", "Asia/Shanghai", "Asia/Kolkata", "Asia/Tehran", "Australia/Lord_Howe",
+		"Pacific/Apia", "Africa/Casablanca", "Antarctica/Troll", "America/Godthab",
+	} {
+		data, e := fs.ReadFile(fsys, id)
+		require.NoError(t, e, id)
+		assert.NoError(t, checkTZif(data), id)
+	}
+}
+
+func TestCheckTZRule(t *testing.T) {
+	for _, s := range []string{
+		"CET-1CEST,M3.5.0,M10.5.0/3",
+		"<+0530>-5:30",
+		"EST5EDT",
+		"EST5EDT,0/0,J365/25",
+		"<-03>3<-02>,M3.5.0/-2,M10.5.0/-1",
+		"<+1030>-10:30<+11>-11,M10.1.0,M4.1.0",
+		"IST-1GMT0,M10.5.0,M3.5.0/1",
+	} {
+		assert.True(t, checkTZRule(s), s)
+	}
+	for _, s := range []string{"", "C-1", "CET", "CET-25", "<CET-1", "CET-1CEST,M13.1.0,M10.5.0", "CET-1CEST,M3.5.0", "CET-1CEST,M3.5.0,M10.5.0/168", "CET-1CEST;M3.5.0,M10.5.0"} {
+		assert.False(t, checkTZRule(s), s)
+	}
+}
+
+func TestCheckTZif_Invalid(t *testing.T) {
+	var fsys = testZoneinfoFS(t)
+	data, e := fs.ReadFile(fsys, "Europe/Paris")
+	require.NoError(t, e)
+	for name, it := range map[string][]byte{
+		"empty":     nil,
+		"magic":     append([]byte("TZit"), data[4:]...),
+		"version":   append([]byte("TZif9"), data[5:]...),
+		"truncated": data[:len(data)/2],
+		"footer":    data[:len(data)-1],
+	} {
+		assert.ErrorContains(t, checkTZif(it), "invalid TZif data", name)
+	}
+}
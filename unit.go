@@ -0,0 +1,224 @@
+package goda
+
+import (
+	"math"
+	"strconv"
+)
+
+// Unit represents a unit of date-time, such as days or hours, used to measure and add amounts of time.
+// This is similar to Java's ChronoUnit.
+//
+// The units from UnitNanos to UnitHalfDays are time-based and have an exact duration,
+// the units from UnitDays to UnitEras are date-based and their durations are estimated.
+// UnitForever is neither, it's an artificial unit representing the concept of forever.
+type Unit int
+
+// Unit constants from the shortest to the longest.
+const (
+	// UnitNanos represents the concept of a nanosecond.
+	UnitNanos Unit = iota + 1
+
+	// UnitMicros represents the concept of a microsecond.
+	UnitMicros
+
+	// UnitMillis represents the concept of a millisecond.
+	UnitMillis
+
+	// UnitSeconds represents the concept of a second.
+	UnitSeconds
+
+	// UnitMinutes represents the concept of a minute.
+	UnitMinutes
+
+	// UnitHours represents the concept of an hour.
+	UnitHours
+
+	// UnitHalfDays represents the concept of half a day, as used in AM/PM.
+	UnitHalfDays
+
+	// UnitDays represents the concept of a day, the estimated duration is 24 hours.
+	UnitDays
+
+	// UnitWeeks represents the concept of a week, the estimated duration is 7 days.
+	UnitWeeks
+
+	// UnitMonths represents the concept of a month, the estimated duration is one twelfth of 365.2425 days.
+	UnitMonths
+
+	// UnitYears represents the concept of a year, the estimated duration is 365.2425 days.
+	UnitYears
+
+	// UnitDecades represents the concept of a decade, 10 years.
+	UnitDecades
+
+	// UnitCenturies represents the concept of a century, 100 years.
+	UnitCenturies
+
+	// UnitMillennia represents the concept of a millennium, 1000 years.
+	UnitMillennia
+
+	// UnitEras represents the concept of an era, the estimated duration is 1,000,000,000 years.
+	UnitEras
+
+	// UnitForever represents the concept of forever, the duration is the maximum duration.
+	UnitForever
+)
+
+var unitDescriptors = [...]struct {
+	name     string
+	duration Duration
+}{
+	UnitNanos:     {"Nanos", Duration{nanos: 1, valid: true}},
+	UnitMicros:    {"Micros", Duration{nanos: 1000, valid: true}},
+	UnitMillis:    {"Millis", Duration{nanos: 1000_000, valid: true}},
+	UnitSeconds:   {"Seconds", Duration{seconds: 1, valid: true}},
+	UnitMinutes:   {"Minutes", Duration{seconds: 60, valid: true}},
+	UnitHours:     {"Hours", Duration{seconds: 3600, valid: true}},
+	UnitHalfDays:  {"HalfDays", Duration{seconds: 43200, valid: true}},
+	UnitDays:      {"Days", Duration{seconds: 86400, valid: true}},
+	UnitWeeks:     {"Weeks", Duration{seconds: 7 * 86400, valid: true}},
+	UnitMonths:    {"Months", Duration{seconds: 31556952 / 12, valid: true}},
+	UnitYears:     {"Years", Duration{seconds: 31556952, valid: true}},
+	UnitDecades:   {"Decades", Duration{seconds: 31556952 * 10, valid: true}},
+	UnitCenturies: {"Centuries", Duration{seconds: 31556952 * 100, valid: true}},
+	UnitMillennia: {"Millennia", Duration{seconds: 31556952 * 1000, valid: true}},
+	UnitEras:      {"Eras", Duration{seconds: 31556952 * 1000_000_000, valid: true}},
+	UnitForever:   {"Forever", Duration{seconds: math.MaxInt64, nanos: 999_999_999, valid: true}},
+}
+
+// Valid returns true if this is a valid unit.
+func (u Unit) Valid() bool {
+	return u >= UnitNanos && u <= UnitForever
+}
+
+// String returns the name of this unit, such as "Days".
+func (u Unit) String() string {
+	if u.Valid() {
+		return unitDescriptors[u].name
+	}
+	return "UnknownUnit(" + strconv.Itoa(int(u)) + ")"
+}
+
+// Duration returns the duration of this unit, which may be an estimate, see IsDurationEstimated.
+// Returns zero for an invalid unit.
+func (u Unit) Duration() Duration {
+	if u.Valid() {
+		return unitDescriptors[u].duration
+	}
+	return Duration{}
+}
+
+// IsDurationEstimated checks if the duration of this unit is an estimate.
+// All the units from UnitDays are estimated, as days vary in length with daylight saving time.
+func (u Unit) IsDurationEstimated() bool {
+	return u >= UnitDays && u <= UnitForever
+}
+
+// IsDateBased checks if this unit is a date unit, from UnitDays to UnitEras.
+func (u Unit) IsDateBased() bool {
+	return u >= UnitDays && u <= UnitEras
+}
+
+// IsTimeBased checks if this unit is a time unit, from UnitNanos to UnitHalfDays.
+func (u Unit) IsTimeBased() bool {
+	return u >= UnitNanos && u < UnitDays
+}
+
+// Between returns the amount of this unit between two temporals of the same type,
+// it's equivalent to calling UntilUnit on the start, such as start.UntilUnit(end, unit).
+// Returns an error if the types differ, or the type doesn't support this unit.
+func (u Unit) Between(startInclusive, endExclusive TemporalAccessor) (int64, error) {
+	switch start := startInclusive.(type) {
+	case LocalDate:
+		if end, ok := endExclusive.(LocalDate); ok {
+			return start.UntilUnit(end, u)
+		}
+	case LocalTime:
+		if end, ok := endExclusive.(LocalTime); ok {
+			return start.UntilUnit(end, u)
+		}
+	case LocalDateTime:
+		if end, ok := endExclusive.(LocalDateTime); ok {
+			return start.UntilUnit(end, u)
+		}
+	case OffsetDateTime:
+		if end, ok := endExclusive.(OffsetDateTime); ok {
+			return start.UntilUnit(end, u)
+		}
+	case OffsetTime:
+		if end, ok := endExclusive.(OffsetTime); ok {
+			return start.UntilUnit(end, u)
+		}
+	case ZonedDateTime:
+		if end, ok := endExclusive.(ZonedDateTime); ok {
+			return start.UntilUnit(end, u)
+		}
+	case Instant:
+		if end, ok := endExclusive.(Instant); ok {
+			return start.UntilUnit(end, u)
+		}
+	case YearMonth:
+		if end, ok := endExclusive.(YearMonth); ok {
+			return start.UntilUnit(end, u)
+		}
+	}
+	return 0, newError("cannot measure %s between %T and %T", u, startInclusive, endExclusive)
+}
+
+// nanos returns the nanoseconds of a time unit or UnitDays.
+func (u Unit) nanos() int64 {
+	var d = unitDescriptors[u].duration
+	return d.seconds*1000_000_000 + int64(d.nanos)
+}
+
+// months returns the months of a month-based unit, or zero for other units.
+func (u Unit) months() int64 {
+	switch u {
+	case UnitMonths:
+		return 1
+	case UnitYears:
+		return 12
+	case UnitDecades:
+		return 120
+	case UnitCenturies:
+		return 1200
+	case UnitMillennia:
+		return 12000
+	}
+	return 0
+}
+
+// unitsBetween returns the amount of the unit in the difference of seconds and nanos, truncated towards zero.
+// The unit must be a time unit or UnitDays.
+func unitsBetween(seconds, nanos int64, unit Unit) (int64, error) {
+	if seconds < 0 && nanos > 0 {
+		seconds++
+		nanos -= 1000_000_000
+	} else if seconds > 0 && nanos < 0 {
+		seconds--
+		nanos += 1000_000_000
+	}
+	var unitNanos = unit.nanos()
+	if unitNanos >= 1000_000_000 {
+		return seconds / (unitNanos / 1000_000_000), nil
+	}
+	r, overflow := mulExact(seconds, 1000_000_000/unitNanos)
+	if !overflow {
+		r, overflow = addExactly(r, nanos/unitNanos)
+	}
+	if overflow {
+		return 0, overflowError()
+	}
+	return r, nil
+}
+
+// secondsNanos splits the amount of a time unit or UnitDays into seconds and nanos.
+func (u Unit) secondsNanos(amount int64) (seconds, nanos int64, overflow bool) {
+	var unitNanos = u.nanos()
+	if unitNanos < 1000_000_000 {
+		var perSecond = 1000_000_000 / unitNanos
+		return amount / perSecond, amount % perSecond * unitNanos, false
+	}
+	seconds, overflow = mulExact(amount, unitNanos/1000_000_000)
+	return
+}
@@ -0,0 +1,256 @@
+package goda
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnit(t *testing.T) {
+	assert.Equal(t, "Nanos", UnitNanos.String())
+	assert.Equal(t, "HalfDays", UnitHalfDays.String())
+	assert.Equal(t, "Forever", UnitForever.String())
+	assert.Equal(t, "UnknownUnit(0)", Unit(0).String())
+	assert.False(t, Unit(0).Valid())
+	assert.False(t, Unit(17).Valid())
+
+	for u := UnitNanos; u <= UnitForever; u++ {
+		assert.True(t, u.Valid(), u)
+		assert.Equal(t, u < UnitDays, u.IsTimeBased(), u)
+		assert.Equal(t, u >= UnitDays && u != UnitForever, u.IsDateBased(), u)
+		assert.Equal(t, u >= UnitDays, u.IsDurationEstimated(), u)
+		if u > UnitNanos {
+			assert.Equal(t, 1, u.Duration().Compare(Unit(u-1).Duration()), u)
+		}
+	}
+	assert.False(t, Unit(0).IsTimeBased())
+	assert.False(t, Unit(0).IsDateBased())
+	assert.True(t, Unit(0).Duration().IsZero())
+
+	assert.Equal(t, "PT0.000001S", UnitMicros.Duration().String())
+	assert.Equal(t, "PT12H", UnitHalfDays.Duration().String())
+	assert.Equal(t, "PT24H", UnitDays.Duration().String())
+	assert.Equal(t, "PT8765H49M12S", UnitYears.Duration().String())
+	assert.Equal(t, "PT730H29M6S", UnitMonths.Duration().String())
+	assert.Equal(t, MustDurationOfSeconds(math.MaxInt64, 999_999_999), UnitForever.Duration())
+}
+
+func TestUnit_Error(t *testing.T) {
+	var d = MustLocalDateParse("2024-03-15")
+	_, err := d.UntilUnit(d, UnitHours)
+	assert.True(t, errors.Is(err, ErrUnsupported))
+	assert.Equal(t, "goda: unsupported unit Hours", err.Error())
+	_, err = d.Chain().PlusUnit(1, UnitForever).GetResult()
+	assert.True(t, errors.Is(err, ErrUnsupported))
+	_, err = MustLocalTimeParse("10:00").Chain().PlusUnit(1, UnitDays).GetResult()
+	assert.True(t, errors.Is(err, ErrUnsupported))
+	_, err = MustLocalTimeParse("10:00").Chain().TruncatedTo(UnitWeeks).GetResult()
+	assert.True(t, errors.Is(err, ErrUnsupported))
+	_, err = MustDurationParse("PT1S").Chain().PlusUnit(1, UnitMonths).GetResult()
+	assert.True(t, errors.Is(err, ErrUnsupported))
+	_, err = MustInstantParse("2024-03-15T00:00:00Z").UntilUnit(MustInstantParse("2024-03-15T00:00:00Z"), UnitWeeks)
+	assert.True(t, errors.Is(err, ErrUnsupported))
+	_, err = PeriodOfDays(1).Chain().PlusUnit(1, UnitEras).GetResult()
+	assert.True(t, errors.Is(err, ErrUnsupported))
+	_, err = MustYearMonthOf(2024, March).Chain().PlusUnit(1, UnitDays).GetResult()
+	assert.True(t, errors.Is(err, ErrUnsupported))
+
+	_, err = d.Chain().PlusUnit(math.MaxInt64, UnitCenturies).GetResult()
+	assert.True(t, errors.Is(err, ErrArithmeticOverflow))
+	_, err = MustInstantOfEpochSecond(0, 0).Chain().PlusUnit(math.MaxInt64, UnitDays).GetResult()
+	assert.True(t, errors.Is(err, ErrArithmeticOverflow))
+	_, err = MustLocalDateTimeParse("2024-01-01T00:00").UntilUnit(MustLocalDateTimeParse("+999999-01-01T00:00"), UnitNanos)
+	assert.True(t, errors.Is(err, ErrArithmeticOverflow))
+
+	_, err = UnitDays.Between(d, MustLocalDateTimeParse("2024-03-15T00:00"))
+	assert.Error(t, err)
+
+	// zero values
+	n, err := LocalDate{}.UntilUnit(d, UnitDays)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	assert.True(t, LocalDate{}.Chain().PlusUnit(1, UnitDays).MustGet().IsZero())
+	assert.True(t, LocalTime{}.Chain().TruncatedTo(UnitHours).MustGet().IsZero())
+}
+
+func TestLocalDate_UntilUnit(t *testing.T) {
+	for _, it := range []struct {
+		start, end string
+		unit       Unit
+		expected   int64
+	}{
+		{"2024-01-31", "2024-03-01", UnitDays, 30},
+		{"2024-03-01", "2024-01-31", UnitDays, -30},
+		{"2024-01-01", "2024-01-14", UnitWeeks, 1},
+		{"2024-01-01", "2024-01-15", UnitWeeks, 2},
+		{"2024-01-31", "2024-02-29", UnitMonths, 0},
+		{"2024-01-31", "2024-03-01", UnitMonths, 1},
+		{"2024-03-01", "2024-01-31", UnitMonths, -1},
+		{"2024-02-29", "2025-02-28", UnitYears, 0},
+		{"2024-02-29", "2025-03-01", UnitYears, 1},
+		{"2000-01-01", "2024-06-01", UnitDecades, 2},
+		{"1900-01-01", "2024-06-01", UnitCenturies, 1},
+		{"1000-01-01", "2024-06-01", UnitMillennia, 1},
+		{"-0001-01-01", "2024-06-01", UnitEras, 1},
+		{"2024-01-01", "2024-06-01", UnitEras, 0},
+	} {
+		actual, err := MustLocalDateParse(it.start).UntilUnit(MustLocalDateParse(it.end), it.unit)
+		require.NoError(t, err, "%s %s %s", it.start, it.end, it.unit)
+		assert.Equal(t, it.expected, actual, "%s %s %s", it.start, it.end, it.unit)
+		actual, err = it.unit.Between(MustLocalDateParse(it.start), MustLocalDateParse(it.end))
+		require.NoError(t, err)
+		assert.Equal(t, it.expected, actual)
+	}
+}
+
+func TestLocalTimeAndDateTime_UntilUnit(t *testing.T) {
+	n, err := MustLocalTimeParse("10:30").UntilUnit(MustLocalTimeParse("08:00"), UnitHours)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), n)
+	n, err = MustLocalTimeParse("10:00").UntilUnit(MustLocalTimeParse("10:00:00.0015"), UnitMillis)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	for _, it := range []struct {
+		start, end string
+		unit       Unit
+		expected   int64
+	}{
+		{"2024-01-01T12:00", "2024-01-02T11:00", UnitDays, 0},
+		{"2024-01-01T12:00", "2024-01-02T12:00", UnitDays, 1},
+		{"2024-01-02T11:00", "2024-01-01T12:00", UnitDays, 0},
+		{"2024-01-31T12:00", "2024-02-29T11:00", UnitMonths, 0},
+		{"2024-01-01T12:00", "2024-01-02T11:00", UnitHours, 23},
+		{"2024-01-02T11:00", "2024-01-01T12:00", UnitHours, -23},
+		{"2024-01-01T12:00", "2024-01-03T11:59:59.999", UnitHalfDays, 3},
+		{"2024-01-01T00:00", "2024-01-02T00:00:00.000001", UnitMicros, 86400_000_001},
+		{"2024-01-01T00:00", "2024-01-01T00:00:00.5", UnitSeconds, 0},
+	} {
+		actual, err := MustLocalDateTimeParse(it.start).UntilUnit(MustLocalDateTimeParse(it.end), it.unit)
+		require.NoError(t, err, "%s %s %s", it.start, it.end, it.unit)
+		assert.Equal(t, it.expected, actual, "%s %s %s", it.start, it.end, it.unit)
+	}
+}
+
+func TestOffsetAndZonedDateTime_UntilUnit(t *testing.T) {
+	n, err := MustOffsetDateTimeParse("2024-01-01T00:00+01:00").UntilUnit(MustOffsetDateTimeParse("2024-01-01T23:30Z"), UnitDays)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	n, err = MustOffsetDateTimeParse("2024-01-01T00:00+01:00").UntilUnit(MustOffsetDateTimeParse("2024-01-01T00:00Z"), UnitMinutes)
+	require.NoError(t, err)
+	assert.Equal(t, int64(60), n)
+
+	// a day with a gap of one hour is one day, but 23 hours
+	var start = MustZonedDateTimeParse("2024-03-30T12:00+01:00[Europe/Paris]")
+	var end = MustZonedDateTimeParse("2024-03-31T12:00+02:00[Europe/Paris]")
+	n, err = start.UntilUnit(end, UnitDays)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	n, err = start.UntilUnit(end, UnitHours)
+	require.NoError(t, err)
+	assert.Equal(t, int64(23), n)
+	n, err = UnitHours.Between(start, end)
+	require.NoError(t, err)
+	assert.Equal(t, int64(23), n)
+}
+
+func TestInstantAndYearMonth_UntilUnit(t *testing.T) {
+	var start = MustInstantParse("2024-01-01T00:00:00.5Z")
+	for _, it := range []struct {
+		end      string
+		unit     Unit
+		expected int64
+	}{
+		{"2024-01-01T00:00:01Z", UnitSeconds, 0},
+		{"2024-01-01T00:00:01.5Z", UnitSeconds, 1},
+		{"2023-12-31T23:59:59Z", UnitSeconds, -1},
+		{"2023-12-31T23:59:59Z", UnitMillis, -1500},
+		{"2024-01-02T00:00:00.4Z", UnitDays, 0},
+		{"2024-01-02T00:00:00.5Z", UnitDays, 1},
+		{"2024-01-01T00:00:00.500000123Z", UnitNanos, 123},
+	} {
+		actual, err := start.UntilUnit(MustInstantParse(it.end), it.unit)
+		require.NoError(t, err, "%s %s", it.end, it.unit)
+		assert.Equal(t, it.expected, actual, "%s %s", it.end, it.unit)
+	}
+
+	n, err := MustYearMonthOf(2024, March).UntilUnit(MustYearMonthOf(2026, February), UnitYears)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	n, err = MustYearMonthOf(2024, March).UntilUnit(MustYearMonthOf(2026, February), UnitMonths)
+	require.NoError(t, err)
+	assert.Equal(t, int64(23), n)
+}
+
+func TestChain_PlusUnit(t *testing.T) {
+	var d = MustLocalDateParse("2024-01-31")
+	assert.Equal(t, "2024-02-29", d.Chain().PlusUnit(1, UnitMonths).MustGet().String())
+	assert.Equal(t, "2024-02-14", d.Chain().PlusUnit(2, UnitWeeks).MustGet().String())
+	assert.Equal(t, "2124-01-31", d.Chain().PlusUnit(1, UnitCenturies).MustGet().String())
+	assert.Equal(t, "2014-01-31", d.Chain().MinusUnit(1, UnitDecades).MustGet().String())
+	assert.Equal(t, "-2023-01-31", d.Chain().MinusUnit(1, UnitEras).MustGet().String())
+
+	var tm = MustLocalTimeParse("23:30")
+	assert.Equal(t, "00:30:00", tm.Chain().PlusUnit(1, UnitHours).MustGet().String())
+	assert.Equal(t, "11:30:00", tm.Chain().PlusUnit(3, UnitHalfDays).MustGet().String())
+	assert.Equal(t, "23:29:59.999", tm.Chain().MinusUnit(1, UnitMillis).MustGet().String())
+	assert.Equal(t, "23:30:00", tm.Chain().MinusUnit(math.MinInt64, UnitNanos).PlusUnit(math.MinInt64, UnitNanos).MustGet().String())
+
+	var ldt = MustLocalDateTimeParse("2024-01-31T23:30")
+	assert.Equal(t, "2024-02-01T00:30:00", ldt.Chain().PlusUnit(1, UnitHours).MustGet().String())
+	assert.Equal(t, "2024-02-02T11:30:00", ldt.Chain().PlusUnit(3, UnitHalfDays).MustGet().String())
+	assert.Equal(t, "2024-02-01T23:30:00", ldt.Chain().PlusUnit(86400_000_000, UnitMicros).MustGet().String())
+	assert.Equal(t, "2024-01-30T23:30:00", ldt.Chain().MinusUnit(86400_000, UnitMillis).MustGet().String())
+	assert.Equal(t, "2024-02-29T23:30:00", ldt.Chain().PlusUnit(1, UnitMonths).MustGet().String())
+
+	var odt = MustOffsetDateTimeParse("2024-01-31T23:30+08:00")
+	assert.Equal(t, "2024-02-01T00:30:00+08:00", odt.Chain().PlusUnit(1, UnitHours).MustGet().String())
+	assert.Equal(t, "2025-01-31T23:30:00+08:00", odt.Chain().PlusUnit(1, UnitYears).MustGet().String())
+
+	var zdt = MustZonedDateTimeParse("2024-03-30T12:00+01:00[Europe/Paris]")
+	assert.Equal(t, "2024-03-31T12:00:00+02:00[Europe/Paris]", zdt.Chain().PlusUnit(1, UnitDays).MustGet().String())
+	assert.Equal(t, "2024-03-31T13:00:00+02:00[Europe/Paris]", zdt.Chain().PlusUnit(24, UnitHours).MustGet().String())
+	assert.Equal(t, "2024-03-30T12:00:00+01:00[Europe/Paris]", zdt.Chain().PlusUnit(24, UnitHours).MinusUnit(24, UnitHours).MustGet().String())
+
+	var i = MustInstantParse("2024-01-01T00:00:00Z")
+	assert.Equal(t, "2024-01-02T00:00:00Z", i.Chain().PlusUnit(1, UnitDays).MustGet().String())
+	assert.Equal(t, "2023-12-31T23:59:59.999999Z", i.Chain().MinusUnit(1, UnitMicros).MustGet().String())
+	assert.Equal(t, "2024-01-01T00:01:40Z", i.Chain().PlusUnit(100_000, UnitMillis).MustGet().String())
+
+	assert.Equal(t, "PT36H", MustDurationParse("PT12H").Chain().PlusUnit(1, UnitDays).MustGet().String())
+	assert.Equal(t, "PT11H59M59.999999999S", MustDurationParse("PT12H").Chain().MinusUnit(1, UnitNanos).MustGet().String())
+
+	assert.Equal(t, "P1Y2M17D", PeriodOf(1, 2, 3).Chain().PlusUnit(2, UnitWeeks).MustGet().String())
+	assert.Equal(t, "P11Y2M3D", PeriodOf(1, 2, 3).Chain().PlusUnit(1, UnitDecades).MustGet().String())
+
+	assert.Equal(t, "2026-03", MustYearMonthOf(2024, March).Chain().PlusUnit(24, UnitMonths).MustGet().String())
+	assert.Equal(t, "3024-03", MustYearMonthOf(2024, March).Chain().PlusUnit(1, UnitMillennia).MustGet().String())
+}
+
+func TestChain_TruncatedToUnit(t *testing.T) {
+	var tm = MustLocalTimeParse("14:30:45.123456789")
+	for _, it := range []struct {
+		unit     Unit
+		expected string
+	}{
+		{UnitNanos, "14:30:45.123456789"},
+		{UnitMicros, "14:30:45.123456"},
+		{UnitMillis, "14:30:45.123"},
+		{UnitSeconds, "14:30:45"},
+		{UnitMinutes, "14:30:00"},
+		{UnitHours, "14:00:00"},
+		{UnitHalfDays, "12:00:00"},
+		{UnitDays, "00:00:00"},
+	} {
+		actual, err := tm.Chain().TruncatedTo(it.unit).GetResult()
+		require.NoError(t, err, it.unit)
+		assert.Equal(t, it.expected, actual.String(), it.unit)
+	}
+	assert.Equal(t, "2024-03-15T00:00:00", MustLocalDateTimeParse("2024-03-15T14:30:45").Chain().TruncatedTo(UnitDays).MustGet().String())
+	assert.Equal(t, "2024-03-15T14:00:00+08:00", MustOffsetDateTimeParse("2024-03-15T14:30:45+08:00").Chain().TruncatedTo(UnitHours).MustGet().String())
+	_, err := MustOffsetDateTimeParse("2024-03-15T14:30:45+08:00").Chain().TruncatedTo(UnitMonths).GetResult()
+	assert.True(t, errors.Is(err, ErrUnsupported))
+}
@@ -151,13 +151,17 @@ func localDateTimeToGoTime(ldt LocalDateTime, loc *time.Location) time.Time {
 	)
 }
 
+// classifyZoneBoundsMode classifies a local date-time around a transition.
+// atTb and atTa are the local date-time interpreted with the offset of the period before (tb) and after (ta)
+// the transition, the local date-time is valid for an offset if it falls into the period of that offset.
+// Zero bounds returned by time.Time.ZoneBounds are treated as unbounded.
 func classifyZoneBoundsMode(
 	tbBoundsBegin, tbBoundsEnd time.Time,
 	taBoundsBegin, taBoundsEnd time.Time,
-	now time.Time,
+	atTb, atTa time.Time,
 ) int {
-	inTb := !now.Before(tbBoundsBegin) && now.Before(tbBoundsEnd)
-	inTa := !now.Before(taBoundsBegin) && now.Before(taBoundsEnd)
+	inTb := (tbBoundsBegin.IsZero() || !atTb.Before(tbBoundsBegin)) && (tbBoundsEnd.IsZero() || atTb.Before(tbBoundsEnd))
+	inTa := (taBoundsBegin.IsZero() || !atTa.Before(taBoundsBegin)) && (taBoundsEnd.IsZero() || atTa.Before(taBoundsEnd))
 
 	switch {
 	case inTb && inTa:
@@ -0,0 +1,300 @@
+package goda
+
+import (
+	"strconv"
+	"strings"
+)
+
+// WeekFields defines the weeks of a calendar by the first day of the week and the minimal number of days
+// in the first week of a month or a year. It provides the localized day-of-week, week-of-month, week-of-year,
+// week-of-week-based-year and week-based-year as Field values, which are supported by GetField and WithField
+// of LocalDate, LocalDateTime, OffsetDateTime and ZonedDateTime.
+//
+// The week 1 of a month or a year is the first week containing at least the minimal days of it, the days before
+// it are in the week 0. For the week-based-year, those days are in the last week of the previous year instead.
+// WeekFieldsISO starts weeks on Monday with 4 minimal days, the same as FieldWeekOfWeekBasedYear,
+// and WeekFieldsSundayStart starts weeks on Sunday with week 1 containing January 1, as in the US.
+//
+// WeekFields is comparable. The zero value is invalid and its fields are invalid.
+//
+// This is similar to Java's WeekFields.
+type WeekFields struct {
+	firstDayOfWeek DayOfWeek
+	minimalDays    int8
+}
+
+const (
+	weekFieldDayOfWeek = iota
+	weekFieldWeekOfMonth
+	weekFieldWeekOfYear
+	weekFieldWeekOfWeekBasedYear
+	weekFieldWeekBasedYear
+)
+
+// fieldWeekFieldsBase is the first Field of the WeekFields, each WeekFields has 8 Field values from it,
+// ordered by the first day of the week then by the minimal days.
+const fieldWeekFieldsBase Field = 1 << 10
+
+var weekFieldNames = [...][2]string{
+	weekFieldDayOfWeek:           {"DayOfWeek", "dayOfWeek"},
+	weekFieldWeekOfMonth:         {"WeekOfMonth", "weekOfMonth"},
+	weekFieldWeekOfYear:          {"WeekOfYear", "weekOfYear"},
+	weekFieldWeekOfWeekBasedYear: {"WeekOfWeekBasedYear", "weekOfWeekBasedYear"},
+	weekFieldWeekBasedYear:       {"WeekBasedYear", "weekBasedYear"},
+}
+
+var weekFieldRanges = [...]fieldRange{
+	weekFieldDayOfWeek:           makeRange(1, 7),
+	weekFieldWeekOfMonth:         makeRange(0, 6),
+	weekFieldWeekOfYear:          makeRange(0, 54),
+	weekFieldWeekOfWeekBasedYear: makeRange(1, 53),
+	weekFieldWeekBasedYear:       makeRange(YearMin, YearMax),
+}
+
+// FirstDayOfWeek returns the first day of the week, such as Sunday in the US.
+func (wf WeekFields) FirstDayOfWeek() DayOfWeek {
+	return wf.firstDayOfWeek
+}
+
+// MinimalDaysInFirstWeek returns the minimal number of days in the first week of a month or a year (1-7).
+func (wf WeekFields) MinimalDaysInFirstWeek() int {
+	return int(wf.minimalDays)
+}
+
+// IsZero returns true if this is the zero value.
+func (wf WeekFields) IsZero() bool {
+	return wf.firstDayOfWeek == 0
+}
+
+// DayOfWeek returns the field of the localized day-of-week (1-7), the first day of the week is 1.
+func (wf WeekFields) DayOfWeek() Field {
+	return wf.field(weekFieldDayOfWeek)
+}
+
+// WeekOfMonth returns the field of the week-of-month (0-6), the days before the week 1 are in the week 0.
+func (wf WeekFields) WeekOfMonth() Field {
+	return wf.field(weekFieldWeekOfMonth)
+}
+
+// WeekOfYear returns the field of the week-of-year (0-54), the days before the week 1 are in the week 0.
+func (wf WeekFields) WeekOfYear() Field {
+	return wf.field(weekFieldWeekOfYear)
+}
+
+// WeekOfWeekBasedYear returns the field of the week-of-week-based-year (1-53).
+// The days before the week 1 are in the last week of the previous week-based-year.
+func (wf WeekFields) WeekOfWeekBasedYear() Field {
+	return wf.field(weekFieldWeekOfWeekBasedYear)
+}
+
+// WeekBasedYear returns the field of the week-based-year, which differs from the year around January 1.
+// Setting it keeps the week-of-week-based-year and the day-of-week, the last week is used if the week is absent.
+func (wf WeekFields) WeekBasedYear() Field {
+	return wf.field(weekFieldWeekBasedYear)
+}
+
+// String returns the first day of the week and the minimal days, such as "WeekFields[Sunday,1]".
+func (wf WeekFields) String() string {
+	if wf.IsZero() {
+		return ""
+	}
+	return "WeekFields[" + wf.firstDayOfWeek.String() + "," + strconv.Itoa(int(wf.minimalDays)) + "]"
+}
+
+func (wf WeekFields) field(kind int) Field {
+	if wf.IsZero() {
+		return 0
+	}
+	return fieldWeekFieldsBase + Field(((int(wf.firstDayOfWeek)-1)*7+int(wf.minimalDays)-1)*8+kind)
+}
+
+// weekFields returns the WeekFields and the kind of the field if it's a field of WeekFields.
+func (f Field) weekFields() (wf WeekFields, kind int, ok bool) {
+	if f < fieldWeekFieldsBase || f >= fieldWeekFieldsBase+7*7*8 {
+		return
+	}
+	var n = int(f - fieldWeekFieldsBase)
+	if kind = n % 8; kind > weekFieldWeekBasedYear {
+		return
+	}
+	n /= 8
+	return WeekFields{firstDayOfWeek: DayOfWeek(n/7 + 1), minimalDays: int8(n%7 + 1)}, kind, true
+}
+
+func (wf WeekFields) descriptor(kind int) fieldDescriptor {
+	return fieldDescriptor{
+		name:       weekFieldNames[kind][0] + "[" + wf.String() + "]",
+		based:      dateBased,
+		fieldRange: weekFieldRanges[kind],
+	}
+}
+
+func (wf WeekFields) javaName(kind int) string {
+	return "WeekFields.of(DayOfWeek." + strings.ToUpper(wf.firstDayOfWeek.String()) + ", " + strconv.Itoa(int(wf.minimalDays)) + ")." +
+		weekFieldNames[kind][1] + "()"
+}
+
+// localizedDayOfWeek returns the day-of-week of the date counted from the first day of the week (1-7).
+func (wf WeekFields) localizedDayOfWeek(d LocalDate) int {
+	return int(floorMod(int64(d.DayOfWeek()-wf.firstDayOfWeek), 7)) + 1
+}
+
+// startOfWeekOffset returns the offset of the start of the week 1 from the first day of the month or the year,
+// given a day-of-month or day-of-year and its localized day-of-week.
+func (wf WeekFields) startOfWeekOffset(day, dayOfWeek int) int {
+	var weekStart = int(floorMod(int64(day-dayOfWeek), 7))
+	if weekStart+1 > int(wf.minimalDays) {
+		// the first partial week is too short, it's the week 0
+		return 7 - weekStart
+	}
+	return -weekStart
+}
+
+func computeWeek(offset, day int) int {
+	return (7 + offset + (day - 1)) / 7
+}
+
+// weekBasedYear returns the week-based-year and the week-of-week-based-year of a day-of-year.
+func (wf WeekFields) weekBasedYear(year Year, dayOfYear, dayOfWeek int) (Year, int) {
+	var offset = wf.startOfWeekOffset(dayOfYear, dayOfWeek)
+	var week = computeWeek(offset, dayOfYear)
+	if week == 0 {
+		// before the week 1, it's the last week of the previous year
+		return wf.weekBasedYear(year-1, dayOfYear+(year-1).Length(), dayOfWeek)
+	}
+	if newYearWeek := computeWeek(offset, year.Length()+int(wf.minimalDays)); week >= newYearWeek {
+		return year + 1, week - newYearWeek + 1
+	}
+	return year, week
+}
+
+func (wf WeekFields) getFrom(d LocalDate, kind int) int64 {
+	var dayOfWeek = wf.localizedDayOfWeek(d)
+	switch kind {
+	case weekFieldDayOfWeek:
+		return int64(dayOfWeek)
+	case weekFieldWeekOfMonth:
+		return int64(computeWeek(wf.startOfWeekOffset(d.DayOfMonth(), dayOfWeek), d.DayOfMonth()))
+	case weekFieldWeekOfYear:
+		return int64(computeWeek(wf.startOfWeekOffset(d.DayOfYear(), dayOfWeek), d.DayOfYear()))
+	case weekFieldWeekOfWeekBasedYear:
+		_, week := wf.weekBasedYear(d.Year(), d.DayOfYear(), dayOfWeek)
+		return int64(week)
+	default:
+		year, _ := wf.weekBasedYear(d.Year(), d.DayOfYear(), dayOfWeek)
+		return year.Int64()
+	}
+}
+
+// adjustInto sets the field of the date, the value is checked against the fixed range by the caller.
+// The weeks are changed in whole weeks, keeping the localized day-of-week, and the result must stay
+// in the month, the year or the week-based-year of the date.
+func (wf WeekFields) adjustInto(l LocalDateChain, kind int, newValue int64) LocalDateChain {
+	var current = wf.getFrom(l.value, kind)
+	if newValue == current {
+		return l
+	}
+	switch kind {
+	case weekFieldDayOfWeek:
+		return l.PlusDays(newValue - current)
+	case weekFieldWeekBasedYear:
+		l.value, l.eError = wf.ofWeekBasedYear(Year(newValue), int(wf.getFrom(l.value, weekFieldWeekOfWeekBasedYear)), wf.localizedDayOfWeek(l.value))
+		return l
+	default:
+		var r = l.PlusWeeks(newValue - current)
+		if r.ok() && !wf.samePeriod(l.value, r.value, kind) {
+			l.eError = fieldOutOfRangeError(wf.field(kind), newValue)
+			return l
+		}
+		return r
+	}
+}
+
+// samePeriod returns true if both dates are in the same month, year or week-based-year, depending on the week field.
+func (wf WeekFields) samePeriod(a, b LocalDate, kind int) bool {
+	switch kind {
+	case weekFieldWeekOfMonth:
+		return a.YearMonth() == b.YearMonth()
+	case weekFieldWeekOfYear:
+		return a.Year() == b.Year()
+	default:
+		return wf.getFrom(a, weekFieldWeekBasedYear) == wf.getFrom(b, weekFieldWeekBasedYear)
+	}
+}
+
+// ofWeekBasedYear returns the date of the week-based-year, the week-of-week-based-year and the localized day-of-week.
+// The week is clamped to the last week of the year.
+func (wf WeekFields) ofWeekBasedYear(year Year, week, dayOfWeek int) (LocalDate, error) {
+	jan1, e := LocalDateOf(year, January, 1)
+	if e != nil {
+		return LocalDate{}, e
+	}
+	var offset = wf.startOfWeekOffset(1, wf.localizedDayOfWeek(jan1))
+	week = min(week, computeWeek(offset, year.Length()+int(wf.minimalDays))-1)
+	return jan1.Chain().PlusDays(int64(-offset + (dayOfWeek - 1) + (week-1)*7)).GetResult()
+}
+
+// WeekFieldsOf returns the WeekFields of the first day of the week and the minimal days in the first week (1-7).
+// Returns an error if either is out of range.
+func WeekFieldsOf(firstDayOfWeek DayOfWeek, minimalDaysInFirstWeek int) (wf WeekFields, e error) {
+	FieldDayOfWeek.checkSetE(int64(firstDayOfWeek), &e)
+	if e == nil && (minimalDaysInFirstWeek < 1 || minimalDaysInFirstWeek > 7) {
+		e = newError("invalid minimal days in first week %d, it must be from 1 to 7", minimalDaysInFirstWeek)
+	}
+	if e != nil {
+		return
+	}
+	return WeekFields{firstDayOfWeek: firstDayOfWeek, minimalDays: int8(minimalDaysInFirstWeek)}, nil
+}
+
+// MustWeekFieldsOf returns the WeekFields of the first day of the week and the minimal days in the first week.
+// Panics if either is out of range.
+func MustWeekFieldsOf(firstDayOfWeek DayOfWeek, minimalDaysInFirstWeek int) WeekFields {
+	return mustValue(WeekFieldsOf(firstDayOfWeek, minimalDaysInFirstWeek))
+}
+
+// WeekFieldsISO returns the ISO-8601 WeekFields, weeks start on Monday and the week 1 has at least 4 days.
+func WeekFieldsISO() WeekFields {
+	return WeekFields{firstDayOfWeek: Monday, minimalDays: 4}
+}
+
+// WeekFieldsSundayStart returns the WeekFields starting weeks on Sunday with the week 1 containing January 1,
+// as used in the US.
+func WeekFieldsSundayStart() WeekFields {
+	return WeekFields{firstDayOfWeek: Sunday, minimalDays: 1}
+}
+
+// WeekFieldsOfRegion returns the WeekFields used in the region of the ISO 3166 code, such as "US" or "de",
+// following the week data of the Unicode CLDR. A language tag such as "en-US" is accepted as well.
+// Returns Monday with 1 minimal day, the CLDR default, for an unknown region.
+func WeekFieldsOfRegion(region string) WeekFields {
+	if i := strings.LastIndexAny(region, "-_"); i >= 0 {
+		region = region[i+1:]
+	}
+	region = strings.ToUpper(region)
+	var wf = WeekFields{firstDayOfWeek: Monday, minimalDays: 1}
+	for _, it := range weekFieldsFirstDays {
+		if strings.Contains(it.regions, region) && len(region) == 2 {
+			wf.firstDayOfWeek = it.day
+		}
+	}
+	if len(region) == 2 && strings.Contains(weekFieldsMinimalDays4, region) {
+		wf.minimalDays = 4
+	}
+	return wf
+}
+
+// weekFieldsFirstDays is the regions whose weeks don't start on Monday, from the week data of the Unicode CLDR.
+var weekFieldsFirstDays = []struct {
+	day     DayOfWeek
+	regions string
+}{
+	{Friday, "MV"},
+	{Saturday, "AE AF BH DJ DZ EG IQ IR JO KW LY OM QA SD SY"},
+	{Sunday, "AG AS BD BR BS BT BW BZ CA CN CO DM DO ET GT GU HK HN ID IL IN JM JP KE KH KR LA MH MM MO MT MX MZ " +
+		"NI NP PA PE PH PK PR PT PY SA SG SV TH TT TW UM US VE VI WS YE ZA ZW"},
+}
+
+// weekFieldsMinimalDays4 is the regions whose week 1 has at least 4 days, from the week data of the Unicode CLDR.
+const weekFieldsMinimalDays4 = "AD AN AT AX BE BG CH CZ DE DK EE ES FI FJ FO FR GB GF GG GI GP GR HU IE IM IS IT JE " +
+	"LI LT LU MC MQ NL NO PL RE RU SE SJ SK SM VA"
@@ -0,0 +1,261 @@
+package goda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeekFieldsOf(t *testing.T) {
+	wf, e := WeekFieldsOf(Sunday, 1)
+	require.NoError(t, e)
+	assert.Equal(t, WeekFieldsSundayStart(), wf)
+	assert.Equal(t, Sunday, wf.FirstDayOfWeek())
+	assert.Equal(t, 1, wf.MinimalDaysInFirstWeek())
+	assert.Equal(t, WeekFieldsISO(), MustWeekFieldsOf(Monday, 4))
+	assert.False(t, wf.IsZero())
+	assert.True(t, WeekFields{}.IsZero())
+	assert.Equal(t, "WeekFields[Sunday,1]", wf.String())
+	assert.Equal(t, "", WeekFields{}.String())
+
+	_, e = WeekFieldsOf(0, 1)
+	assert.Error(t, e)
+	_, e = WeekFieldsOf(Monday, 0)
+	assert.Error(t, e)
+	_, e = WeekFieldsOf(Monday, 8)
+	assert.Error(t, e)
+	assert.Panics(t, func() { MustWeekFieldsOf(Monday, 8) })
+
+	assert.Equal(t, WeekFieldsSundayStart(), WeekFieldsOfRegion("US"))
+	assert.Equal(t, WeekFieldsSundayStart(), WeekFieldsOfRegion("en-US"))
+	assert.Equal(t, WeekFieldsISO(), WeekFieldsOfRegion("de"))
+	assert.Equal(t, WeekFieldsISO(), WeekFieldsOfRegion("en_GB"))
+	assert.Equal(t, MustWeekFieldsOf(Saturday, 1), WeekFieldsOfRegion("EG"))
+	assert.Equal(t, MustWeekFieldsOf(Monday, 1), WeekFieldsOfRegion("XX"))
+	assert.Equal(t, MustWeekFieldsOf(Monday, 1), WeekFieldsOfRegion(""))
+}
+
+func TestWeekFields_Field(t *testing.T) {
+	var wf = WeekFieldsSundayStart()
+	var fields = []Field{wf.DayOfWeek(), wf.WeekOfMonth(), wf.WeekOfYear(), wf.WeekOfWeekBasedYear(), wf.WeekBasedYear()}
+	for _, field := range fields {
+		assert.True(t, field.Valid(), field.String())
+		assert.True(t, field.IsDateBased(), field.String())
+		assert.False(t, field.IsTimeBased(), field.String())
+	}
+	assert.Equal(t, "WeekOfYear[WeekFields[Sunday,1]]", wf.WeekOfYear().String())
+	assert.Equal(t, "WeekFields.of(DayOfWeek.SUNDAY, 1).weekOfYear()", wf.WeekOfYear().JavaName())
+	assert.Equal(t, "WeekBasedYear[WeekFields[Monday,4]]", WeekFieldsISO().WeekBasedYear().String())
+	assert.NotEqual(t, WeekFieldsISO().WeekOfYear(), wf.WeekOfYear())
+	assert.Equal(t, MustWeekFieldsOf(Sunday, 1).WeekOfYear(), wf.WeekOfYear())
+
+	// every WeekFields has distinct fields
+	var seen = map[Field]bool{}
+	for dow := Monday; dow <= Sunday; dow++ {
+		for days := 1; days <= 7; days++ {
+			var it = MustWeekFieldsOf(dow, days)
+			for _, field := range []Field{it.DayOfWeek(), it.WeekOfMonth(), it.WeekOfYear(), it.WeekOfWeekBasedYear(), it.WeekBasedYear()} {
+				assert.False(t, seen[field], field.String())
+				seen[field] = true
+				got, kind, ok := field.weekFields()
+				assert.True(t, ok)
+				assert.Equal(t, it, got)
+				assert.Equal(t, field, got.field(kind))
+			}
+		}
+	}
+
+	assert.Equal(t, Field(0), WeekFields{}.WeekOfYear())
+	assert.False(t, WeekFields{}.WeekOfYear().Valid())
+	assert.False(t, (fieldWeekFieldsBase + 5).Valid())
+	assert.False(t, (fieldWeekFieldsBase + 7*7*8).Valid())
+}
+
+func TestWeekFields_GetField(t *testing.T) {
+	var us = WeekFieldsSundayStart()
+	var iso = WeekFieldsISO()
+
+	var d = MustLocalDateOf(2024, January, 1) // Monday
+	assert.True(t, d.IsSupportedField(us.WeekOfYear()))
+	assert.Equal(t, int64(2), d.GetField(us.DayOfWeek()).Int64())
+	assert.Equal(t, int64(1), d.GetField(iso.DayOfWeek()).Int64())
+	assert.Equal(t, int64(1), d.GetField(us.WeekOfYear()).Int64())
+
+	d = MustLocalDateOf(2023, December, 31) // Sunday
+	assert.Equal(t, int64(1), d.GetField(us.DayOfWeek()).Int64())
+	assert.Equal(t, int64(53), d.GetField(us.WeekOfYear()).Int64())
+	assert.Equal(t, int64(1), d.GetField(us.WeekOfWeekBasedYear()).Int64())
+	assert.Equal(t, int64(2024), d.GetField(us.WeekBasedYear()).Int64())
+	assert.Equal(t, int64(52), d.GetField(iso.WeekOfYear()).Int64())
+	assert.Equal(t, int64(2023), d.GetField(iso.WeekBasedYear()).Int64())
+
+	d = MustLocalDateOf(2024, March, 1) // Friday
+	assert.Equal(t, int64(0), d.GetField(iso.WeekOfMonth()).Int64())
+	assert.Equal(t, int64(1), d.GetField(us.WeekOfMonth()).Int64())
+	assert.Equal(t, int64(2), MustLocalDateOf(2024, March, 3).GetField(us.WeekOfMonth()).Int64())
+
+	assert.Equal(t, int64(53), MustLocalDateTimeParse("2023-12-31T10:00").GetField(us.WeekOfYear()).Int64())
+	assert.Equal(t, int64(2024), MustOffsetDateTimeParse("2023-12-31T10:00+08:00").GetField(us.WeekBasedYear()).Int64())
+	assert.True(t, LocalDate{}.GetField(us.WeekOfYear()).Unsupported())
+	assert.False(t, MustLocalTimeOf(1, 0, 0, 0).IsSupportedField(us.WeekOfYear()))
+}
+
+func TestWeekFields_ISO(t *testing.T) {
+	var iso = WeekFieldsISO()
+	for d := MustLocalDateOf(1990, January, 1); d.Year() < 2030; d = d.Chain().PlusDays(1).MustGet() {
+		if !assert.Equal(t, d.GetField(FieldWeekOfWeekBasedYear), d.GetField(iso.WeekOfWeekBasedYear()), d.String()) ||
+			!assert.Equal(t, d.GetField(FieldWeekBasedYear), d.GetField(iso.WeekBasedYear()), d.String()) ||
+			!assert.Equal(t, d.GetField(FieldDayOfWeek), d.GetField(iso.DayOfWeek()), d.String()) {
+			return
+		}
+	}
+	for _, it := range []struct {
+		date string
+		year Year
+	}{
+		{"2020-12-31", 2021},
+		{"2021-01-01", 2026},
+		{"2015-06-15", 2020},
+		{"2024-12-30", 2020},
+	} {
+		var d = MustLocalDateParse(it.date)
+		assert.Equal(t,
+			d.Chain().WithField(FieldWeekBasedYear, TemporalValueOf(it.year.Int64())).MustGet(),
+			d.Chain().WithField(iso.WeekBasedYear(), TemporalValueOf(it.year.Int64())).MustGet(), it.date)
+	}
+}
+
+// weekOfYearReference counts the week-of-year from the start of the week 1 of the year.
+func weekOfYearReference(wf WeekFields, d LocalDate) int64 {
+	var jan1 = MustLocalDateOf(d.Year(), January, 1)
+	var jan1Dow = int64(wf.localizedDayOfWeek(jan1))
+	var week1Start = jan1.UnixEpochDays() - (jan1Dow - 1)
+	if 8-jan1Dow < int64(wf.minimalDays) {
+		week1Start += 7
+	}
+	return floorDiv(d.UnixEpochDays()-week1Start, 7) + 1
+}
+
+func TestWeekFields_AllWeekFields(t *testing.T) {
+	for dow := Monday; dow <= Sunday; dow++ {
+		for days := 1; days <= 7; days++ {
+			var wf = MustWeekFieldsOf(dow, days)
+			var last = MustLocalDateOf(2000, January, 1).Chain().MinusDays(1).MustGet()
+			for d := MustLocalDateOf(2000, January, 1); d.Year() < 2008; d = d.Chain().PlusDays(1).MustGet() {
+				var week = d.GetField(wf.WeekOfYear()).Int64()
+				if !assert.Equal(t, weekOfYearReference(wf, d), week, "%v %v", wf, d) {
+					return
+				}
+				var wby = d.GetField(wf.WeekBasedYear()).Int64()
+				var wowby = d.GetField(wf.WeekOfWeekBasedYear()).Int64()
+				if week > 0 && wby == d.Year().Int64() {
+					assert.Equal(t, week, wowby, "%v %v", wf, d)
+				}
+				// the week-based weeks change only at the first day of the week
+				if d.GetField(wf.DayOfWeek()).Int64() == 1 {
+					assert.NotEqual(t, last.GetField(wf.WeekOfWeekBasedYear()), d.GetField(wf.WeekOfWeekBasedYear()), "%v %v", wf, d)
+				} else {
+					assert.Equal(t, last.GetField(wf.WeekOfWeekBasedYear()), d.GetField(wf.WeekOfWeekBasedYear()), "%v %v", wf, d)
+					assert.Equal(t, last.GetField(wf.WeekBasedYear()), d.GetField(wf.WeekBasedYear()), "%v %v", wf, d)
+				}
+				assert.Equal(t, d, d.Chain().WithField(wf.WeekBasedYear(), TemporalValueOf(wby)).MustGet(), "%v %v", wf, d)
+				var next = d.Chain().WithField(wf.WeekBasedYear(), TemporalValueOf(wby+1)).MustGet()
+				assert.Equal(t, wby+1, next.GetField(wf.WeekBasedYear()).Int64(), "%v %v", wf, d)
+				assert.Equal(t, d.GetField(wf.DayOfWeek()), next.GetField(wf.DayOfWeek()), "%v %v", wf, d)
+				assert.LessOrEqual(t, next.GetField(wf.WeekOfWeekBasedYear()).Int64(), wowby, "%v %v", wf, d)
+				last = d
+			}
+		}
+	}
+}
+
+func TestWeekFields_WithField(t *testing.T) {
+	var us = WeekFieldsSundayStart()
+	var d = MustLocalDateOf(2024, January, 3) // Wednesday
+
+	assert.Equal(t, MustLocalDateOf(2023, December, 31), d.Chain().WithField(us.DayOfWeek(), TemporalValueOf(1)).MustGet())
+	assert.Equal(t, MustLocalDateOf(2024, January, 6), d.Chain().WithField(us.DayOfWeek(), TemporalValueOf(7)).MustGet())
+	assert.Equal(t, MustLocalDateOf(2024, January, 1), d.Chain().WithField(WeekFieldsISO().DayOfWeek(), TemporalValueOf(1)).MustGet())
+	assert.Equal(t, MustLocalDateOf(2024, March, 6), d.Chain().WithField(us.WeekOfYear(), TemporalValueOf(10)).MustGet())
+	assert.Equal(t, MustLocalDateOf(2024, January, 17), d.Chain().WithField(us.WeekOfMonth(), TemporalValueOf(3)).MustGet())
+	assert.Equal(t, MustLocalDateOf(2024, December, 25), d.Chain().WithField(us.WeekOfWeekBasedYear(), TemporalValueOf(52)).MustGet())
+	assert.Equal(t, MustLocalDateOf(2025, January, 1), d.Chain().WithField(us.WeekBasedYear(), TemporalValueOf(2025)).MustGet())
+	assert.Equal(t, d, d.Chain().WithField(us.WeekOfYear(), TemporalValueOf(1)).MustGet())
+
+	assert.Equal(t, MustLocalDateTimeParse("2023-12-31T10:00"),
+		MustLocalDateTimeParse("2024-01-03T10:00").Chain().WithField(us.DayOfWeek(), TemporalValueOf(1)).MustGet())
+	assert.Equal(t, MustOffsetDateTimeParse("2024-03-06T10:00+08:00"),
+		MustOffsetDateTimeParse("2024-01-03T10:00+08:00").Chain().WithField(us.WeekOfYear(), TemporalValueOf(10)).MustGet())
+
+	_, e := d.Chain().WithField(us.WeekOfYear(), TemporalValueOf(55)).GetResult()
+	assert.ErrorIs(t, e, ErrOutOfRange)
+	_, e = d.Chain().WithField(us.DayOfWeek(), TemporalValueOf(0)).GetResult()
+	assert.ErrorIs(t, e, ErrOutOfRange)
+	assert.Equal(t, Year(YearMax), d.Chain().WithField(us.WeekBasedYear(), TemporalValueOf(int64(YearMax))).MustGet().Year())
+	_, e = d.Chain().WithField(WeekFields{}.WeekOfYear(), TemporalValueOf(1)).GetResult()
+	assert.Error(t, e)
+
+	var june15 = MustLocalDateOf(2021, June, 15)
+	for _, it := range []struct {
+		field Field
+		value int64
+	}{
+		{WeekFieldsISO().WeekOfWeekBasedYear(), 53},
+		{WeekFieldsISO().WeekOfMonth(), 6},
+		{WeekFieldsISO().WeekOfMonth(), 0},
+		{us.WeekOfMonth(), 0},
+		{us.WeekOfYear(), 54},
+		{us.WeekOfYear(), 0},
+	} {
+		_, e = june15.Chain().WithField(it.field, TemporalValueOf(it.value)).GetResult()
+		assert.ErrorIs(t, e, ErrOutOfRange, "%v %d", it.field, it.value)
+	}
+	assert.Equal(t, MustLocalDateOf(2021, June, 29), june15.Chain().WithField(WeekFieldsISO().WeekOfMonth(), TemporalValueOf(5)).MustGet())
+	assert.Equal(t, MustLocalDateOf(2021, December, 28), june15.Chain().WithField(WeekFieldsISO().WeekOfWeekBasedYear(), TemporalValueOf(52)).MustGet())
+	assert.Equal(t, MustLocalDateOf(2020, December, 29), MustLocalDateOf(2020, June, 16).Chain().WithField(WeekFieldsISO().WeekOfWeekBasedYear(), TemporalValueOf(53)).MustGet())
+}
+
+func TestWeekFields_WithFieldISO(t *testing.T) {
+	var iso = WeekFieldsISO()
+	for d := MustLocalDateOf(2019, December, 1); d.Year() < 2022; d = d.Chain().PlusDays(3).MustGet() {
+		for week := int64(1); week <= 53; week++ {
+			expected, e1 := d.Chain().WithField(FieldWeekOfWeekBasedYear, TemporalValueOf(week)).GetResult()
+			actual, e2 := d.Chain().WithField(iso.WeekOfWeekBasedYear(), TemporalValueOf(week)).GetResult()
+			if !assert.Equal(t, e1 == nil, e2 == nil, "%v %d", d, week) || !assert.Equal(t, expected, actual, "%v %d", d, week) {
+				return
+			}
+		}
+	}
+}
+
+func TestWeekFields_WithFieldRange(t *testing.T) {
+	for dow := Monday; dow <= Sunday; dow++ {
+		for days := 1; days <= 7; days++ {
+			var wf = MustWeekFieldsOf(dow, days)
+			for d := MustLocalDateOf(2020, December, 20); d.Year() < 2022; d = d.Chain().PlusDays(5).MustGet() {
+				for _, field := range []Field{wf.WeekOfMonth(), wf.WeekOfYear(), wf.WeekOfWeekBasedYear()} {
+					for week := int64(0); week <= 54; week++ {
+						if field.check(week) != nil {
+							continue
+						}
+						actual, e := d.Chain().WithField(field, TemporalValueOf(week)).GetResult()
+						if e != nil {
+							assert.ErrorIs(t, e, ErrOutOfRange, "%v %v %d", d, field, week)
+							continue
+						}
+						assert.Equal(t, week, actual.GetField(field).Int64(), "%v %v %d", d, field, week)
+						switch field {
+						case wf.WeekOfMonth():
+							assert.Equal(t, d.YearMonth(), actual.YearMonth(), "%v %v %d", d, field, week)
+						case wf.WeekOfYear():
+							assert.Equal(t, d.Year(), actual.Year(), "%v %v %d", d, field, week)
+						default:
+							assert.Equal(t, d.GetField(wf.WeekBasedYear()), actual.GetField(wf.WeekBasedYear()), "%v %v %d", d, field, week)
+						}
+					}
+				}
+			}
+		}
+	}
+}
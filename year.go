@@ -1,7 +1,10 @@
 package goda
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
+	"encoding/json"
 	"fmt"
 )
 
@@ -37,5 +40,32 @@ func (y Year) Length() int {
 	return 365
 }
 
-var _ encoding.TextAppender = Year(0)
-var _ fmt.Stringer = Year(0)
+// weeksOfWeekBasedYear returns the number of ISO-8601 weeks in this week-based-year (52 or 53).
+// A week-based-year has 53 weeks if it ends on a Thursday, or if the previous year ends on a Wednesday.
+func (y Year) weeksOfWeekBasedYear() int {
+	var dayOfWeekOfLastDay = func(y int64) int64 {
+		return floorMod(y+floorDiv(y, 4)-floorDiv(y, 100)+floorDiv(y, 400), 7)
+	}
+	if dayOfWeekOfLastDay(y.Int64()) == 4 || dayOfWeekOfLastDay(y.Int64()-1) == 3 {
+		return 53
+	}
+	return 52
+}
+
+// YearNowClock returns the current year of the clock in the time zone of the clock.
+// Returns 0 if the instant or the zone of the clock is zero.
+func YearNowClock(clock Clock) Year {
+	return zonedDateTimeNowClock(clock).LocalDate().Year()
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = Year(0)
+	_ fmt.Stringer             = Year(0)
+	_ encoding.TextMarshaler   = Year(0)
+	_ encoding.TextUnmarshaler = (*Year)(nil)
+	_ json.Marshaler           = Year(0)
+	_ json.Unmarshaler         = (*Year)(nil)
+	_ driver.Valuer            = Year(0)
+	_ sql.Scanner              = (*Year)(nil)
+)
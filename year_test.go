@@ -1,7 +1,9 @@
 package goda
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -157,3 +159,74 @@ func TestYear_LeapYearPattern(t *testing.T) {
 	year2 := Year(100 + 400)
 	assert.Equal(t, year1.IsLeapYear(), year2.IsLeapYear(), "Leap year pattern should repeat every 400 years")
 }
+
+func TestYear_Text(t *testing.T) {
+	for _, tt := range []struct {
+		text string
+		year Year
+	}{
+		{"2024", 2024},
+		{"0001", 1},
+		{"-0043", -43},
+		{"+12345", 12345},
+	} {
+		var y Year
+		require.NoError(t, y.UnmarshalText([]byte(tt.text)), tt.text)
+		assert.Equal(t, tt.year, y)
+	}
+	text, err := Year(-43).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "-0043", string(text))
+
+	var y Year
+	assert.Error(t, y.UnmarshalText([]byte("20x4")))
+	assert.Error(t, y.UnmarshalText([]byte("999999999999999")))
+}
+
+func TestYear_JSON(t *testing.T) {
+	data, err := json.Marshal(Year(2024))
+	require.NoError(t, err)
+	assert.Equal(t, "2024", string(data))
+	data, err = json.Marshal(Year(-43))
+	require.NoError(t, err)
+	assert.Equal(t, "-43", string(data))
+
+	for _, data := range []string{`2024`, `"2024"`} {
+		var y Year
+		require.NoError(t, json.Unmarshal([]byte(data), &y), data)
+		assert.Equal(t, Year(2024), y)
+	}
+	var y = Year(2024)
+	require.NoError(t, json.Unmarshal([]byte(`null`), &y))
+	assert.Equal(t, Year(0), y)
+	assert.Error(t, json.Unmarshal([]byte(`2024.5`), &y))
+}
+
+func TestYear_SQL(t *testing.T) {
+	value, err := Year(2024).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2024), value)
+
+	for _, src := range []any{int64(2024), "2024", []byte("2024"), time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)} {
+		var y Year
+		require.NoError(t, y.Scan(src), "%v", src)
+		assert.Equal(t, Year(2024), y)
+	}
+	var y = Year(2024)
+	require.NoError(t, y.Scan(nil))
+	assert.Equal(t, Year(0), y)
+	value, err = y.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+	assert.Error(t, y.Scan(int64(YearMax)+1))
+	assert.Error(t, y.Scan(2024.0))
+}
+
+func TestYear_ValueMySQL(t *testing.T) {
+	var mysql = GetMySQL(t)
+	var actual, actualYear Year
+	var e = mysql.QueryRow("SELECT CAST(? AS UNSIGNED), YEAR(CAST('2024-03-15' AS DATE))", Year(2024)).Scan(&actual, &actualYear)
+	assert.NoError(t, e)
+	assert.Equal(t, Year(2024), actual)
+	assert.Equal(t, Year(2024), actualYear)
+}
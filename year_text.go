@@ -1,6 +1,10 @@
 package goda
 
-import "strconv"
+import (
+	"database/sql/driver"
+	"strconv"
+	"time"
+)
 
 // String returns the string representation of this year.
 // Years 0-9999 are formatted as 4 digits with leading zeros (e.g., "0001", "2024").
@@ -20,3 +24,81 @@ func (y Year) AppendText(b []byte) ([]byte, error) {
 	b = append(b, strconv.FormatInt(y.Int64(), 10)...)
 	return b, nil
 }
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (y Year) MarshalText() ([]byte, error) {
+	return marshalTextImpl(y)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the year number with an optional sign, such as "2024" or "-0043".
+// Empty input is treated as year 0.
+func (y *Year) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*y = 0
+		return nil
+	}
+	v, e := parseInt64(text)
+	FieldYear.checkSetE(v, &e)
+	if e != nil {
+		return
+	}
+	*y = Year(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The year is a JSON number, such as 2024.
+func (y Year) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, y.Int64(), 10), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON numbers, JSON strings in any form UnmarshalText accepts, or JSON null.
+func (y *Year) UnmarshalJSON(data []byte) error {
+	if len(data) == 4 && string(data) == "null" {
+		*y = 0
+		return nil
+	}
+	if len(data) > 0 && data[0] != '"' {
+		return y.UnmarshalText(data)
+	}
+	return unmarshalJsonImpl(y, data)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil for year 0, the same as what Scan converts nil to,
+// otherwise the year as an int64, which suits integer columns and the MySQL YEAR type.
+func (y Year) Value() (driver.Value, error) {
+	if y == 0 {
+		return nil, nil
+	}
+	return y.Int64(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports scanning from nil, int64, string, []byte, and time.Time of which the year is taken.
+// Nil values are converted to year 0.
+func (y *Year) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*y = 0
+		return nil
+	case int64:
+		if e := FieldYear.check(v); e != nil {
+			return e
+		}
+		*y = Year(v)
+		return nil
+	case string:
+		return y.UnmarshalText([]byte(v))
+	case []byte:
+		return y.UnmarshalText(v)
+	case time.Time:
+		*y = Year(v.Year())
+		return nil
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
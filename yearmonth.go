@@ -44,6 +44,63 @@ func (y YearMonth) Month() Month {
 	return Month(y.v & 0xffff)
 }
 
+// IsSupportedField returns true if the field is supported by YearMonth.
+// YearMonth supports the month-of-year, proleptic-month, year-of-era, year and era fields.
+func (y YearMonth) IsSupportedField(field Field) bool {
+	switch field {
+	case FieldMonthOfYear, FieldProlepticMonth, FieldYearOfEra, FieldYear, FieldEra:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetField returns the value of the specified field as a TemporalValue.
+// Returns an unsupported TemporalValue for zero values or unsupported fields.
+func (y YearMonth) GetField(field Field) TemporalValue {
+	if y.IsZero() {
+		return TemporalValue{unsupported: true}
+	}
+	switch field {
+	case FieldMonthOfYear:
+		return TemporalValue{v: int64(y.Month())}
+	case FieldProlepticMonth:
+		return TemporalValue{v: y.ProlepticMonth()}
+	case FieldYearOfEra:
+		if y.Year() < 1 {
+			return TemporalValue{v: 1 - y.Year().Int64()}
+		}
+		return TemporalValue{v: y.Year().Int64()}
+	case FieldYear:
+		return TemporalValue{v: y.Year().Int64()}
+	case FieldEra:
+		if y.Year() < 1 {
+			return TemporalValue{v: 0}
+		}
+		return TemporalValue{v: 1}
+	default:
+		return TemporalValue{unsupported: true}
+	}
+}
+
+// UntilUnit returns the amount of time until another year-month in terms of the unit, such as the number of complete years.
+// The start is included, but the end is not, the result is negative if the end is before the start.
+//
+// The units from UnitMonths to UnitEras are supported, otherwise an error wrapping ErrUnsupported is returned.
+// Returns zero if either year-month is zero.
+func (y YearMonth) UntilUnit(endExclusive YearMonth, unit Unit) (int64, error) {
+	if y.IsZero() || endExclusive.IsZero() {
+		return 0, nil
+	}
+	switch unit {
+	case UnitMonths, UnitYears, UnitDecades, UnitCenturies, UnitMillennia:
+		return (endExclusive.ProlepticMonth() - y.ProlepticMonth()) / unit.months(), nil
+	case UnitEras:
+		return endExclusive.GetField(FieldEra).Int64() - y.GetField(FieldEra).Int64(), nil
+	}
+	return 0, unsupportedUnit(unit)
+}
+
 func (y YearMonth) Chain() (chain YearMonthChain) {
 	chain.value = y
 	return
@@ -62,6 +119,12 @@ func MustYearMonthOf(year Year, month Month) YearMonth {
 	return mustValue(YearMonthOf(year, month))
 }
 
+// YearMonthNowClock returns the current year-month of the clock in the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func YearMonthNowClock(clock Clock) YearMonth {
+	return zonedDateTimeNowClock(clock).LocalDate().YearMonth()
+}
+
 // Compile-time interface checks
 var (
 	_ encoding.TextAppender    = (*YearMonth)(nil)
@@ -72,6 +135,7 @@ var (
 	_ json.Unmarshaler         = (*YearMonth)(nil)
 	_ driver.Valuer            = (*YearMonth)(nil)
 	_ sql.Scanner              = (*YearMonth)(nil)
+	_ TemporalAccessor         = (*YearMonth)(nil)
 )
 
 // Compile-time check that YearMonth is comparable
@@ -56,6 +56,44 @@ func (y YearMonthChain) MinusYears(years int64) YearMonthChain {
 	return y.PlusYears(-years)
 }
 
+// PlusUnit returns a copy of this year-month with the amount of the unit added, such as 2 UnitDecades.
+// The units from UnitMonths to UnitEras are supported, otherwise an error wrapping ErrUnsupported is returned.
+func (y YearMonthChain) PlusUnit(amount int64, unit Unit) YearMonthChain {
+	defer y.leaveFunction(tyYearMonth, fnPlusUnit)
+	if !y.ok() {
+		return y
+	}
+	switch unit {
+	case UnitMonths:
+		return y.PlusMonths(amount)
+	case UnitYears, UnitDecades, UnitCenturies, UnitMillennia:
+		years, overflow := mulExact(amount, unit.months()/12)
+		if overflow {
+			y.eError = overflowError()
+			return y
+		}
+		return y.PlusYears(years)
+	case UnitEras:
+		era, overflow := addExactly(y.value.GetField(FieldEra).Int64(), amount)
+		if overflow {
+			y.eError = overflowError()
+			return y
+		}
+		return y.WithField(FieldEra, TemporalValueOf(era))
+	}
+	y.eError = unsupportedUnit(unit)
+	return y
+}
+
+// MinusUnit returns a copy of this year-month with the amount of the unit subtracted, see PlusUnit.
+func (y YearMonthChain) MinusUnit(amount int64, unit Unit) YearMonthChain {
+	defer y.leaveFunction(tyYearMonth, fnMinusUnit)
+	if amount == math.MinInt64 {
+		return y.PlusUnit(math.MaxInt64, unit).PlusUnit(1, unit)
+	}
+	return y.PlusUnit(-amount, unit)
+}
+
 func (y YearMonthChain) WithMonth(month Month) YearMonthChain {
 	defer y.leaveFunction(tyYearMonth, fnWithMonth)
 	return y.WithField(FieldMonthOfYear, TemporalValueOf(int64(month)))
@@ -0,0 +1,227 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// YearQuarter represents a quarter of a year in the ISO-8601 calendar system, such as 2024-Q1.
+// It's suitable for keying financial reports by quarter.
+//
+// YearQuarter is comparable and can be used as a map key.
+// The zero value represents an unset year-quarter and IsZero returns true for it.
+//
+// YearQuarter implements sql.Scanner and driver.Valuer for database operations,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: yyyy-Qq (e.g., "2024-Q1").
+//
+// This is similar to YearQuarter of ThreeTen-Extra.
+type YearQuarter struct {
+	v int64
+}
+
+// Year returns the year component.
+func (yq YearQuarter) Year() Year {
+	return Year(yq.v >> 8)
+}
+
+// Quarter returns the quarter component (Q1-Q4).
+func (yq YearQuarter) Quarter() Quarter {
+	return Quarter(yq.v & 0xff)
+}
+
+// IsZero returns true if this is the zero value.
+func (yq YearQuarter) IsZero() bool {
+	return yq.v == 0
+}
+
+// IsLeapYear returns true if the year is a leap year.
+func (yq YearQuarter) IsLeapYear() bool {
+	return yq.Year().IsLeapYear()
+}
+
+// LengthOfQuarter returns the number of days in the quarter (90-92).
+// Returns 0 for zero value.
+func (yq YearQuarter) LengthOfQuarter() int {
+	if yq.IsZero() {
+		return 0
+	}
+	return yq.Quarter().Length(yq.IsLeapYear())
+}
+
+// FirstDay returns the first day of the quarter, such as 2024-04-01 for 2024-Q2.
+// Returns zero value for zero value.
+func (yq YearQuarter) FirstDay() LocalDate {
+	if yq.IsZero() {
+		return LocalDate{}
+	}
+	return MustLocalDateOf(yq.Year(), yq.Quarter().FirstMonth(), 1)
+}
+
+// LastDay returns the last day of the quarter, such as 2024-06-30 for 2024-Q2.
+// Returns zero value for zero value.
+func (yq YearQuarter) LastDay() LocalDate {
+	if yq.IsZero() {
+		return LocalDate{}
+	}
+	var month = yq.Quarter().FirstMonth() + 2
+	return MustLocalDateOf(yq.Year(), month, month.Length(yq.IsLeapYear()))
+}
+
+// FirstYearMonth returns the first month of the quarter, such as 2024-04 for 2024-Q2.
+// Returns zero value for zero value.
+func (yq YearQuarter) FirstYearMonth() YearMonth {
+	if yq.IsZero() {
+		return YearMonth{}
+	}
+	return MustYearMonthOf(yq.Year(), yq.Quarter().FirstMonth())
+}
+
+// LastYearMonth returns the last month of the quarter, such as 2024-06 for 2024-Q2.
+// Returns zero value for zero value.
+func (yq YearQuarter) LastYearMonth() YearMonth {
+	if yq.IsZero() {
+		return YearMonth{}
+	}
+	return MustYearMonthOf(yq.Year(), yq.Quarter().FirstMonth()+2)
+}
+
+// YearMonths returns the three months of the quarter in order.
+// Returns nil for zero value.
+func (yq YearQuarter) YearMonths() []YearMonth {
+	if yq.IsZero() {
+		return nil
+	}
+	var first = yq.Quarter().FirstMonth()
+	return []YearMonth{
+		MustYearMonthOf(yq.Year(), first),
+		MustYearMonthOf(yq.Year(), first+1),
+		MustYearMonthOf(yq.Year(), first+2),
+	}
+}
+
+// Compare compares this year-quarter with another, by the year then by the quarter.
+// Returns -1 if this is before other, 0 if equal, 1 if after.
+func (yq YearQuarter) Compare(other YearQuarter) int {
+	return doCompare(yq, other, compareZero, comparing(YearQuarter.Year), comparing(YearQuarter.Quarter))
+}
+
+// IsBefore returns true if this year-quarter is before the other.
+func (yq YearQuarter) IsBefore(other YearQuarter) bool {
+	return yq.Compare(other) < 0
+}
+
+// IsAfter returns true if this year-quarter is after the other.
+func (yq YearQuarter) IsAfter(other YearQuarter) bool {
+	return yq.Compare(other) > 0
+}
+
+// IsSupportedField returns true if the field is supported by YearQuarter.
+// YearQuarter supports FieldQuarterOfYear, FieldYearOfEra, FieldYear and FieldEra.
+func (yq YearQuarter) IsSupportedField(field Field) bool {
+	switch field {
+	case FieldQuarterOfYear, FieldYearOfEra, FieldYear, FieldEra:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetField returns the value of the specified field as a TemporalValue.
+// Returns an unsupported TemporalValue for zero values or unsupported fields.
+func (yq YearQuarter) GetField(field Field) TemporalValue {
+	if yq.IsZero() {
+		return TemporalValue{unsupported: true}
+	}
+	switch field {
+	case FieldQuarterOfYear:
+		return TemporalValue{v: int64(yq.Quarter())}
+	case FieldYearOfEra, FieldYear, FieldEra:
+		return yq.FirstYearMonth().GetField(field)
+	default:
+		return TemporalValue{unsupported: true}
+	}
+}
+
+func (yq YearQuarter) Chain() (chain YearQuarterChain) {
+	chain.value = yq
+	return
+}
+
+// YearQuarter returns the year-quarter of this year-month, such as 2024-Q2 for 2024-05.
+// Returns zero value for zero value.
+func (y YearMonth) YearQuarter() YearQuarter {
+	if y.IsZero() {
+		return YearQuarter{}
+	}
+	return MustYearQuarterOf(y.Year(), y.Month().Quarter())
+}
+
+// YearQuarter returns the year-quarter of this date, such as 2024-Q2 for 2024-05-15.
+// Returns zero value for zero value.
+func (d LocalDate) YearQuarter() YearQuarter {
+	if d.IsZero() {
+		return YearQuarter{}
+	}
+	return MustYearQuarterOf(d.Year(), d.Month().Quarter())
+}
+
+// YearQuarterOf creates a new YearQuarter from the year and the quarter.
+// Returns an error if the year or the quarter is out of range.
+func YearQuarterOf(year Year, quarter Quarter) (yq YearQuarter, e error) {
+	FieldYear.checkSetE(year.Int64(), &e)
+	FieldQuarterOfYear.checkSetE(int64(quarter), &e)
+	if e != nil {
+		return
+	}
+	return YearQuarter{v: year.Int64()<<8 | int64(quarter)}, nil
+}
+
+// MustYearQuarterOf creates a new YearQuarter from the year and the quarter.
+// Panics if the year-quarter is invalid.
+func MustYearQuarterOf(year Year, quarter Quarter) YearQuarter {
+	return mustValue(YearQuarterOf(year, quarter))
+}
+
+// YearQuarterNowClock returns the current year-quarter of the clock in the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func YearQuarterNowClock(clock Clock) YearQuarter {
+	return zonedDateTimeNowClock(clock).LocalDate().YearQuarter()
+}
+
+// YearQuarterParse parses a year-quarter string in yyyy-Qq format (e.g., "2024-Q1").
+// Returns an error if the string is invalid.
+func YearQuarterParse(s string) (YearQuarter, error) {
+	var yq YearQuarter
+	err := yq.UnmarshalText([]byte(s))
+	return yq, err
+}
+
+// MustYearQuarterParse parses a year-quarter string in yyyy-Qq format (e.g., "2024-Q1").
+// Panics if the string is invalid.
+func MustYearQuarterParse(s string) YearQuarter {
+	return mustValue(YearQuarterParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*YearQuarter)(nil)
+	_ fmt.Stringer             = (*YearQuarter)(nil)
+	_ encoding.TextMarshaler   = (*YearQuarter)(nil)
+	_ encoding.TextUnmarshaler = (*YearQuarter)(nil)
+	_ json.Marshaler           = (*YearQuarter)(nil)
+	_ json.Unmarshaler         = (*YearQuarter)(nil)
+	_ driver.Valuer            = (*YearQuarter)(nil)
+	_ sql.Scanner              = (*YearQuarter)(nil)
+	_ TemporalAccessor         = (*YearQuarter)(nil)
+)
+
+// Compile-time check that YearQuarter is comparable
+func _assertYearQuarterIsComparable[T comparable](t T) {}
+
+var _ = _assertYearQuarterIsComparable[YearQuarter]
@@ -0,0 +1,100 @@
+package goda
+
+import (
+	"math"
+)
+
+type YearQuarterChain struct {
+	Chain[YearQuarter]
+}
+
+// PlusQuarters returns a copy of this year-quarter with the quarters added, crossing the years as needed.
+func (y YearQuarterChain) PlusQuarters(quarters int64) YearQuarterChain {
+	defer y.leaveFunction(tyYearQuarter, fnPlusQuarters)
+	if !y.ok() {
+		return y
+	}
+	if quarters == 0 {
+		return y
+	}
+	var quarterCount = y.value.Year().Int64()*4 + int64(y.value.Quarter()) - 1
+	calcQuarter, overflow := addExactly(quarterCount, quarters)
+	if overflow {
+		y.eError = overflowError()
+		return y
+	}
+	y.value, y.eError = YearQuarterOf(Year(floorDiv(calcQuarter, 4)), Quarter(floorMod(calcQuarter, 4)+1))
+	return y
+}
+
+func (y YearQuarterChain) MinusQuarters(quarters int64) YearQuarterChain {
+	defer y.leaveFunction(tyYearQuarter, fnMinusQuarters)
+	if quarters == math.MinInt64 {
+		return y.PlusQuarters(math.MaxInt64).PlusQuarters(1)
+	}
+	return y.PlusQuarters(-quarters)
+}
+
+// PlusYears returns a copy of this year-quarter with the years added, keeping the quarter.
+func (y YearQuarterChain) PlusYears(years int64) YearQuarterChain {
+	defer y.leaveFunction(tyYearQuarter, fnPlusYears)
+	if !y.ok() {
+		return y
+	}
+	newYear, overflow := addExactly(y.value.Year().Int64(), years)
+	if overflow {
+		y.eError = overflowError()
+	}
+	return y.WithField(FieldYear, TemporalValueOf(newYear))
+}
+
+func (y YearQuarterChain) MinusYears(years int64) YearQuarterChain {
+	defer y.leaveFunction(tyYearQuarter, fnMinusYears)
+	if years == math.MinInt64 {
+		return y.PlusYears(math.MaxInt64).PlusYears(1)
+	}
+	return y.PlusYears(-years)
+}
+
+func (y YearQuarterChain) WithQuarter(quarter Quarter) YearQuarterChain {
+	defer y.leaveFunction(tyYearQuarter, fnWithQuarter)
+	return y.WithField(FieldQuarterOfYear, TemporalValueOf(int64(quarter)))
+}
+
+func (y YearQuarterChain) WithYear(year Year) YearQuarterChain {
+	defer y.leaveFunction(tyYearQuarter, fnWithYear)
+	return y.WithField(FieldYear, TemporalValueOf(int64(year)))
+}
+
+// WithField returns a copy of this year-quarter with the specified field replaced.
+// FieldQuarterOfYear, FieldYear, FieldYearOfEra and FieldEra are supported.
+func (y YearQuarterChain) WithField(field Field, value TemporalValue) YearQuarterChain {
+	defer y.leaveFunction(tyYearQuarter, fnWithField)
+	field.checkSetE(value.Int64(), &y.eError)
+	if !y.ok() {
+		return y
+	}
+	var year = y.value.Year()
+	var quarter = y.value.Quarter()
+	switch field {
+	case FieldQuarterOfYear:
+		quarter = Quarter(value.v)
+	case FieldYear:
+		year = Year(value.v)
+	case FieldYearOfEra:
+		if year >= 1 {
+			year = Year(value.v)
+		} else {
+			year = Year(1 - value.v)
+		}
+	case FieldEra:
+		if y.value.GetField(FieldEra).Int64() != value.v {
+			year = 1 - year
+		}
+	default:
+		y.eError = unsupportedField(field)
+		return y
+	}
+	y.value, y.eError = YearQuarterOf(year, quarter)
+	return y
+}
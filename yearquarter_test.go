@@ -0,0 +1,181 @@
+package goda
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuarter(t *testing.T) {
+	assert.Equal(t, "Q1", Q1.String())
+	assert.Equal(t, "", Quarter(0).String())
+	assert.Equal(t, October, Q4.FirstMonth())
+	assert.Equal(t, Month(0), Quarter(0).FirstMonth())
+	assert.Equal(t, 90, Q1.Length(false))
+	assert.Equal(t, 91, Q1.Length(true))
+	assert.Equal(t, 91, Q2.Length(false))
+	assert.Equal(t, 92, Q4.Length(true))
+	assert.Panics(t, func() { Quarter(5).Length(false) })
+	for m := January; m <= December; m++ {
+		assert.Equal(t, Quarter(m+2)/3, m.Quarter(), m.String())
+	}
+	assert.Equal(t, Quarter(0), Month(0).Quarter())
+}
+
+func TestYearQuarterOf(t *testing.T) {
+	yq, err := YearQuarterOf(2024, Q2)
+	require.NoError(t, err)
+	assert.Equal(t, Year(2024), yq.Year())
+	assert.Equal(t, Q2, yq.Quarter())
+	assert.Equal(t, "2024-Q2", yq.String())
+	assert.False(t, MustYearQuarterOf(0, Q1).IsZero())
+	assert.True(t, YearQuarter{}.IsZero())
+	assert.Equal(t, "-0001-Q4", MustYearQuarterOf(-1, Q4).String())
+
+	_, err = YearQuarterOf(2024, 0)
+	assert.Error(t, err)
+	_, err = YearQuarterOf(2024, 5)
+	assert.Error(t, err)
+	_, err = YearQuarterOf(YearMax+1, Q1)
+	assert.Error(t, err)
+	assert.Panics(t, func() { MustYearQuarterOf(2024, 5) })
+
+	assert.Equal(t, yq, MustLocalDateOf(2024, May, 15).YearQuarter())
+	assert.Equal(t, yq, MustYearMonthOf(2024, June).YearQuarter())
+	assert.True(t, LocalDate{}.YearQuarter().IsZero())
+	assert.True(t, YearMonth{}.YearQuarter().IsZero())
+}
+
+func TestYearQuarter_Days(t *testing.T) {
+	for _, it := range []struct {
+		yq          YearQuarter
+		first, last string
+		length      int
+	}{
+		{MustYearQuarterOf(2024, Q1), "2024-01-01", "2024-03-31", 91},
+		{MustYearQuarterOf(2023, Q1), "2023-01-01", "2023-03-31", 90},
+		{MustYearQuarterOf(2024, Q2), "2024-04-01", "2024-06-30", 91},
+		{MustYearQuarterOf(2024, Q3), "2024-07-01", "2024-09-30", 92},
+		{MustYearQuarterOf(2024, Q4), "2024-10-01", "2024-12-31", 92},
+	} {
+		assert.Equal(t, it.first, it.yq.FirstDay().String())
+		assert.Equal(t, it.last, it.yq.LastDay().String())
+		assert.Equal(t, it.length, it.yq.LengthOfQuarter())
+		assert.Equal(t, int64(it.length-1), it.yq.LastDay().UnixEpochDays()-it.yq.FirstDay().UnixEpochDays())
+	}
+	var yq = MustYearQuarterOf(2024, Q2)
+	assert.Equal(t, MustYearMonthOf(2024, April), yq.FirstYearMonth())
+	assert.Equal(t, MustYearMonthOf(2024, June), yq.LastYearMonth())
+	assert.Equal(t, []YearMonth{MustYearMonthOf(2024, April), MustYearMonthOf(2024, May), MustYearMonthOf(2024, June)}, yq.YearMonths())
+	assert.True(t, YearQuarter{}.FirstDay().IsZero())
+	assert.True(t, YearQuarter{}.LastDay().IsZero())
+	assert.True(t, YearQuarter{}.FirstYearMonth().IsZero())
+	assert.True(t, YearQuarter{}.LastYearMonth().IsZero())
+	assert.Nil(t, YearQuarter{}.YearMonths())
+	assert.Equal(t, 0, YearQuarter{}.LengthOfQuarter())
+}
+
+func TestYearQuarter_Compare(t *testing.T) {
+	var a = MustYearQuarterOf(2023, Q4)
+	var b = MustYearQuarterOf(2024, Q1)
+	assert.Equal(t, -1, a.Compare(b))
+	assert.Equal(t, 1, b.Compare(a))
+	assert.Equal(t, 0, b.Compare(MustYearQuarterParse("2024-Q1")))
+	assert.True(t, a.IsBefore(b))
+	assert.True(t, b.IsAfter(a))
+	assert.Equal(t, -1, YearQuarter{}.Compare(a))
+}
+
+func TestYearQuarter_GetField(t *testing.T) {
+	var yq = MustYearQuarterOf(2024, Q3)
+	assert.True(t, yq.IsSupportedField(FieldQuarterOfYear))
+	assert.True(t, yq.IsSupportedField(FieldYear))
+	assert.False(t, yq.IsSupportedField(FieldMonthOfYear))
+	assert.Equal(t, int64(3), yq.GetField(FieldQuarterOfYear).Int64())
+	assert.Equal(t, int64(2024), yq.GetField(FieldYear).Int64())
+	assert.Equal(t, int64(1), yq.GetField(FieldEra).Int64())
+	assert.Equal(t, int64(2), MustYearQuarterOf(-1, Q1).GetField(FieldYearOfEra).Int64())
+	assert.True(t, yq.GetField(FieldMonthOfYear).Unsupported())
+	assert.True(t, YearQuarter{}.GetField(FieldQuarterOfYear).Unsupported())
+}
+
+func TestYearQuarterChain(t *testing.T) {
+	var yq = MustYearQuarterOf(2024, Q3)
+	assert.Equal(t, "2025-Q1", yq.Chain().PlusQuarters(2).MustGet().String())
+	assert.Equal(t, "2023-Q4", yq.Chain().MinusQuarters(3).MustGet().String())
+	assert.Equal(t, "2024-Q3", yq.Chain().PlusQuarters(0).MustGet().String())
+	assert.Equal(t, "-0001-Q4", MustYearQuarterOf(0, Q1).Chain().MinusQuarters(1).MustGet().String())
+	assert.Equal(t, "2030-Q3", yq.Chain().PlusYears(6).MustGet().String())
+	assert.Equal(t, "2019-Q3", yq.Chain().MinusYears(5).MustGet().String())
+	assert.Equal(t, "2024-Q1", yq.Chain().WithQuarter(Q1).MustGet().String())
+	assert.Equal(t, "1999-Q3", yq.Chain().WithYear(1999).MustGet().String())
+	assert.Equal(t, "-2023-Q3", yq.Chain().WithField(FieldEra, TemporalValueOf(0)).MustGet().String())
+	assert.Equal(t, "-0009-Q3", MustYearQuarterOf(-1, Q3).Chain().WithField(FieldYearOfEra, TemporalValueOf(10)).MustGet().String())
+
+	_, err := yq.Chain().WithQuarter(5).GetResult()
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	_, err = yq.Chain().WithField(FieldMonthOfYear, TemporalValueOf(1)).GetResult()
+	assert.ErrorIs(t, err, ErrUnsupported)
+	_, err = yq.Chain().PlusYears(YearMax).GetResult()
+	assert.Error(t, err)
+	_, err = yq.Chain().PlusQuarters(1 << 62).GetResult()
+	assert.Error(t, err)
+	assert.True(t, YearQuarter{}.Chain().PlusQuarters(1).MustGet().IsZero())
+}
+
+func TestYearQuarter_Text(t *testing.T) {
+	for _, text := range []string{"2024-Q1", "2024-Q4", "0001-Q2", "-0001-Q3", "+12345-Q1"} {
+		yq, err := YearQuarterParse(text)
+		require.NoError(t, err, text)
+		if text[0] == '+' {
+			text = text[1:]
+		}
+		assert.Equal(t, text, yq.String())
+	}
+	assert.Equal(t, MustYearQuarterOf(2024, Q2), MustYearQuarterParse("2024-q2"))
+	for _, text := range []string{"2024Q1", "2024-Q0", "2024-Q5", "2024-Q01", "2024-1", "-Q1", "2024-Qx", "2024-03"} {
+		_, err := YearQuarterParse(text)
+		assert.Error(t, err, text)
+	}
+	yq, err := YearQuarterParse("")
+	require.NoError(t, err)
+	assert.True(t, yq.IsZero())
+	assert.Equal(t, "", yq.String())
+}
+
+func TestYearQuarter_JSON(t *testing.T) {
+	type report struct {
+		Quarter YearQuarter `json:"quarter"`
+		Prev    YearQuarter `json:"prev"`
+	}
+	data, err := json.Marshal(report{Quarter: MustYearQuarterOf(2024, Q1)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"quarter":"2024-Q1","prev":""}`, string(data))
+
+	var r report
+	require.NoError(t, json.Unmarshal([]byte(`{"quarter":"2024-Q4","prev":null}`), &r))
+	assert.Equal(t, MustYearQuarterOf(2024, Q4), r.Quarter)
+	assert.True(t, r.Prev.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`{"quarter":20241}`), &r))
+}
+
+func TestYearQuarter_Scan(t *testing.T) {
+	var yq YearQuarter
+	require.NoError(t, yq.Scan("2024-Q1"))
+	assert.Equal(t, MustYearQuarterOf(2024, Q1), yq)
+	require.NoError(t, yq.Scan([]byte("2024-Q2")))
+	assert.Equal(t, MustYearQuarterOf(2024, Q2), yq)
+	require.NoError(t, yq.Scan(nil))
+	assert.True(t, yq.IsZero())
+	assert.Error(t, yq.Scan(time.Now()))
+
+	v, err := MustYearQuarterOf(2024, Q1).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2024-Q1", v)
+	v, err = YearQuarter{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
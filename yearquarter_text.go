@@ -0,0 +1,89 @@
+package goda
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON strings in yyyy-Qq format or JSON null.
+func (yq *YearQuarter) UnmarshalJSON(bytes []byte) error {
+	if len(bytes) == 4 && string(bytes) == "null" {
+		*yq = YearQuarter{}
+		return nil
+	}
+	return unmarshalJsonImpl(yq, bytes)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It returns the year-quarter as a JSON string in yyyy-Qq format, or empty string for zero value.
+func (yq YearQuarter) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(yq)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the year-quarter in yyyy-Qq format, or empty for zero value.
+func (yq YearQuarter) MarshalText() (text []byte, err error) {
+	return marshalTextImpl(yq)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses year-quarters in yyyy-Qq format, the 'Q' may be lowercase. Empty input is treated as zero value.
+func (yq *YearQuarter) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*yq = YearQuarter{}
+		return nil
+	}
+	var i = bytes.LastIndexByte(text, '-')
+	if i < 1 || len(text)-i != 3 || text[i+1] != 'Q' && text[i+1] != 'q' || text[i+2] < '1' || text[i+2] > '4' {
+		return errors.New("yyyy-Qq required")
+	}
+	year, e := parseInt64(text[:i])
+	if e != nil {
+		return
+	}
+	*yq, e = YearQuarterOf(Year(year), Quarter(text[i+2]-'0'))
+	return
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the year-quarter in yyyy-Qq format to b, or nothing for zero value.
+func (yq YearQuarter) AppendText(b []byte) ([]byte, error) {
+	if yq.IsZero() {
+		return b, nil
+	}
+	b, _ = yq.Year().AppendText(b)
+	return append(b, '-', 'Q', byte('0'+yq.Quarter())), nil
+}
+
+// String returns the year-quarter in yyyy-Qq format, or empty string for zero value.
+func (yq YearQuarter) String() string {
+	return stringImpl(yq)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil.
+func (yq *YearQuarter) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*yq = YearQuarter{}
+		return nil
+	case string:
+		return yq.UnmarshalText([]byte(v))
+	case []byte:
+		return yq.UnmarshalText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the year-quarter in yyyy-Qq format, or nil for zero value.
+func (yq YearQuarter) Value() (driver.Value, error) {
+	if yq.IsZero() {
+		return nil, nil
+	}
+	return yq.String(), nil
+}
@@ -0,0 +1,200 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// YearWeek represents an ISO-8601 week of a week-based-year, such as 2024-W52.
+// Weeks start on Monday, and the week 1 of a week-based-year is the week containing its first Thursday,
+// so a week-based-year has 52 or 53 weeks and may start in the previous year or end in the next one.
+//
+// YearWeek is comparable and can be used as a map key.
+// The zero value represents an unset year-week and IsZero returns true for it.
+//
+// YearWeek implements sql.Scanner and driver.Valuer for database operations,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: yyyy-Www (e.g., "2024-W52").
+//
+// This is similar to YearWeek of ThreeTen-Extra.
+type YearWeek struct {
+	v int64
+}
+
+// Year returns the week-based-year, which may differ from the year of the days in the week.
+func (yw YearWeek) Year() Year {
+	return Year(yw.v >> 8)
+}
+
+// Week returns the week-of-week-based-year (1-53).
+func (yw YearWeek) Week() int {
+	return int(yw.v & 0xff)
+}
+
+// IsZero returns true if this is the zero value.
+func (yw YearWeek) IsZero() bool {
+	return yw.v == 0
+}
+
+// Is53WeekYear returns true if the week-based-year has 53 weeks.
+func (yw YearWeek) Is53WeekYear() bool {
+	return yw.LengthOfYear() == 53
+}
+
+// LengthOfYear returns the number of weeks in the week-based-year (52 or 53).
+// Returns 0 for zero value.
+func (yw YearWeek) LengthOfYear() int {
+	if yw.IsZero() {
+		return 0
+	}
+	return yw.Year().weeksOfWeekBasedYear()
+}
+
+// AtDay combines this year-week with a day-of-week to create a LocalDate.
+// Returns zero value if this is zero, the day-of-week is invalid, or the date is out of range.
+func (yw YearWeek) AtDay(dayOfWeek DayOfWeek) LocalDate {
+	if yw.IsZero() {
+		return LocalDate{}
+	}
+	d, e := yw.atDay(dayOfWeek)
+	if e != nil {
+		return LocalDate{}
+	}
+	return d
+}
+
+func (yw YearWeek) atDay(dayOfWeek DayOfWeek) (d LocalDate, e error) {
+	FieldDayOfWeek.checkSetE(int64(dayOfWeek), &e)
+	if e != nil {
+		return
+	}
+	// January 4 is always in the week 1
+	var jan4 = MustLocalDateOf(yw.Year(), January, 4)
+	var monday = jan4.UnixEpochDays() - int64(jan4.DayOfWeek()-Monday)
+	return LocalDateOfEpochDays(monday + int64(yw.Week()-1)*7 + int64(dayOfWeek-Monday))
+}
+
+// Compare compares this year-week with another, by the week-based-year then by the week.
+// Returns -1 if this is before other, 0 if equal, 1 if after.
+func (yw YearWeek) Compare(other YearWeek) int {
+	return doCompare(yw, other, compareZero, comparing(YearWeek.Year), comparing(YearWeek.Week))
+}
+
+// IsBefore returns true if this year-week is before the other.
+func (yw YearWeek) IsBefore(other YearWeek) bool {
+	return yw.Compare(other) < 0
+}
+
+// IsAfter returns true if this year-week is after the other.
+func (yw YearWeek) IsAfter(other YearWeek) bool {
+	return yw.Compare(other) > 0
+}
+
+// IsSupportedField returns true if the field is supported by YearWeek.
+// YearWeek supports FieldWeekOfWeekBasedYear and FieldWeekBasedYear.
+func (yw YearWeek) IsSupportedField(field Field) bool {
+	switch field {
+	case FieldWeekOfWeekBasedYear, FieldWeekBasedYear:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetField returns the value of the specified field as a TemporalValue.
+// Returns an unsupported TemporalValue for zero values or unsupported fields.
+func (yw YearWeek) GetField(field Field) TemporalValue {
+	if yw.IsZero() {
+		return TemporalValue{unsupported: true}
+	}
+	switch field {
+	case FieldWeekOfWeekBasedYear:
+		return TemporalValue{v: int64(yw.Week())}
+	case FieldWeekBasedYear:
+		return TemporalValue{v: yw.Year().Int64()}
+	default:
+		return TemporalValue{unsupported: true}
+	}
+}
+
+func (yw YearWeek) Chain() (chain YearWeekChain) {
+	chain.value = yw
+	return
+}
+
+// YearWeek returns the ISO-8601 year-week of this date, 2024-12-30 is in 2025-W01.
+// Returns zero value for zero value, or if the week-based-year is out of range.
+func (d LocalDate) YearWeek() YearWeek {
+	if d.IsZero() {
+		return YearWeek{}
+	}
+	year, week := d.weekBasedYear()
+	yw, e := YearWeekOf(year, week)
+	if e != nil {
+		return YearWeek{}
+	}
+	return yw
+}
+
+// YearWeekOf creates a new YearWeek from the week-based-year and the week-of-week-based-year.
+// Returns an error if the week is out of range, such as week 53 in a 52-week year.
+func YearWeekOf(year Year, week int) (yw YearWeek, e error) {
+	FieldWeekBasedYear.checkSetE(year.Int64(), &e)
+	FieldWeekOfWeekBasedYear.checkSetE(int64(week), &e)
+	if e != nil {
+		return
+	}
+	if week > year.weeksOfWeekBasedYear() {
+		return yw, newFieldError(FieldWeekOfWeekBasedYear, "invalid week 53 of week-based-year %d", year)
+	}
+	return YearWeek{v: year.Int64()<<8 | int64(week)}, nil
+}
+
+// MustYearWeekOf creates a new YearWeek from the week-based-year and the week-of-week-based-year.
+// Panics if the year-week is invalid.
+func MustYearWeekOf(year Year, week int) YearWeek {
+	return mustValue(YearWeekOf(year, week))
+}
+
+// YearWeekNowClock returns the current ISO-8601 year-week of the clock in the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func YearWeekNowClock(clock Clock) YearWeek {
+	return zonedDateTimeNowClock(clock).LocalDate().YearWeek()
+}
+
+// YearWeekParse parses a year-week string in yyyy-Www format (e.g., "2024-W52").
+// Returns an error if the string is invalid.
+func YearWeekParse(s string) (YearWeek, error) {
+	var yw YearWeek
+	err := yw.UnmarshalText([]byte(s))
+	return yw, err
+}
+
+// MustYearWeekParse parses a year-week string in yyyy-Www format (e.g., "2024-W52").
+// Panics if the string is invalid.
+func MustYearWeekParse(s string) YearWeek {
+	return mustValue(YearWeekParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*YearWeek)(nil)
+	_ fmt.Stringer             = (*YearWeek)(nil)
+	_ encoding.TextMarshaler   = (*YearWeek)(nil)
+	_ encoding.TextUnmarshaler = (*YearWeek)(nil)
+	_ json.Marshaler           = (*YearWeek)(nil)
+	_ json.Unmarshaler         = (*YearWeek)(nil)
+	_ driver.Valuer            = (*YearWeek)(nil)
+	_ sql.Scanner              = (*YearWeek)(nil)
+	_ TemporalAccessor         = (*YearWeek)(nil)
+)
+
+// Compile-time check that YearWeek is comparable
+func _assertYearWeekIsComparable[T comparable](t T) {}
+
+var _ = _assertYearWeekIsComparable[YearWeek]
@@ -0,0 +1,93 @@
+package goda
+
+import (
+	"math"
+)
+
+type YearWeekChain struct {
+	Chain[YearWeek]
+}
+
+// PlusWeeks returns a copy of this year-week with the weeks added, crossing the week-based-years as needed.
+func (y YearWeekChain) PlusWeeks(weeks int64) YearWeekChain {
+	defer y.leaveFunction(tyYearWeek, fnPlusWeeks)
+	if !y.ok() || weeks == 0 {
+		return y
+	}
+	var monday = y.value.AtDay(Monday)
+	if monday.IsZero() {
+		y.eError = overflowError()
+		return y
+	}
+	monday, y.eError = monday.Chain().PlusWeeks(weeks).GetResult()
+	if y.eError != nil {
+		return y
+	}
+	year, week := monday.weekBasedYear()
+	y.value, y.eError = YearWeekOf(year, week)
+	return y
+}
+
+func (y YearWeekChain) MinusWeeks(weeks int64) YearWeekChain {
+	defer y.leaveFunction(tyYearWeek, fnMinusWeeks)
+	if weeks == math.MinInt64 {
+		return y.PlusWeeks(math.MaxInt64).PlusWeeks(1)
+	}
+	return y.PlusWeeks(-weeks)
+}
+
+// PlusYears returns a copy of this year-week with the week-based-years added.
+// Week 53 becomes week 52 if the resulting year has 52 weeks.
+func (y YearWeekChain) PlusYears(years int64) YearWeekChain {
+	defer y.leaveFunction(tyYearWeek, fnPlusYears)
+	if !y.ok() {
+		return y
+	}
+	newYear, overflow := addExactly(y.value.Year().Int64(), years)
+	if overflow {
+		y.eError = overflowError()
+	}
+	return y.WithField(FieldWeekBasedYear, TemporalValueOf(newYear))
+}
+
+func (y YearWeekChain) MinusYears(years int64) YearWeekChain {
+	defer y.leaveFunction(tyYearWeek, fnMinusYears)
+	if years == math.MinInt64 {
+		return y.PlusYears(math.MaxInt64).PlusYears(1)
+	}
+	return y.PlusYears(-years)
+}
+
+// WithWeek returns a copy of this year-week with the week changed.
+// Returns an error if the week doesn't exist in the week-based-year.
+func (y YearWeekChain) WithWeek(week int) YearWeekChain {
+	defer y.leaveFunction(tyYearWeek, fnWithWeek)
+	return y.WithField(FieldWeekOfWeekBasedYear, TemporalValueOf(week))
+}
+
+// WithYear returns a copy of this year-week with the week-based-year changed.
+// Week 53 becomes week 52 if the new year has 52 weeks.
+func (y YearWeekChain) WithYear(year Year) YearWeekChain {
+	defer y.leaveFunction(tyYearWeek, fnWithYear)
+	return y.WithField(FieldWeekBasedYear, TemporalValueOf(int64(year)))
+}
+
+// WithField returns a copy of this year-week with the specified field replaced.
+// FieldWeekOfWeekBasedYear and FieldWeekBasedYear are supported, see WithWeek and WithYear.
+func (y YearWeekChain) WithField(field Field, value TemporalValue) YearWeekChain {
+	defer y.leaveFunction(tyYearWeek, fnWithField)
+	field.checkSetE(value.Int64(), &y.eError)
+	if !y.ok() {
+		return y
+	}
+	switch field {
+	case FieldWeekOfWeekBasedYear:
+		y.value, y.eError = YearWeekOf(y.value.Year(), int(value.v))
+	case FieldWeekBasedYear:
+		var year = Year(value.v)
+		y.value, y.eError = YearWeekOf(year, min(y.value.Week(), year.weeksOfWeekBasedYear()))
+	default:
+		y.eError = unsupportedField(field)
+	}
+	return y
+}
@@ -0,0 +1,234 @@
+package goda
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDate_IsoFields(t *testing.T) {
+	var weeks = map[Year]int{}
+	for d := MustLocalDateOf(1890, January, 1); d.Year() < 2110; d = d.Chain().PlusDays(1).MustGet() {
+		year, week := d.GoTime().ISOWeek()
+		if !assert.Equal(t, int64(year), d.GetField(FieldWeekBasedYear).Int64(), d.String()) ||
+			!assert.Equal(t, int64(week), d.GetField(FieldWeekOfWeekBasedYear).Int64(), d.String()) {
+			return
+		}
+		weeks[Year(year)] = max(weeks[Year(year)], week)
+		var firstDayOfQuarter = MustLocalDateOf(d.Year(), (d.Month()-1)/3*3+1, 1)
+		assert.Equal(t, int64(d.Month()+2)/3, d.GetField(FieldQuarterOfYear).Int64(), d.String())
+		assert.Equal(t, d.UnixEpochDays()-firstDayOfQuarter.UnixEpochDays()+1, d.GetField(FieldDayOfQuarter).Int64(), d.String())
+		assert.Equal(t, MustYearWeekOf(Year(year), week), d.YearWeek(), d.String())
+		assert.Equal(t, d, d.YearWeek().AtDay(d.DayOfWeek()), d.String())
+	}
+	for year := Year(1891); year < 2109; year++ {
+		assert.Equal(t, weeks[year], year.weeksOfWeekBasedYear(), year.String())
+	}
+
+	var d = MustLocalDateOf(2024, December, 30)
+	assert.True(t, d.IsSupportedField(FieldWeekBasedYear))
+	assert.True(t, d.IsSupportedField(FieldDayOfQuarter))
+	assert.Equal(t, int64(2025), d.GetField(FieldWeekBasedYear).Int64())
+	assert.Equal(t, int64(1), d.GetField(FieldWeekOfWeekBasedYear).Int64())
+	assert.Equal(t, int64(1), MustLocalDateTimeParse("2024-12-30T10:00").GetField(FieldWeekOfWeekBasedYear).Int64())
+	assert.Equal(t, int64(2025), MustOffsetDateTimeParse("2024-12-30T10:00+08:00").GetField(FieldWeekBasedYear).Int64())
+	assert.True(t, LocalDate{}.GetField(FieldWeekBasedYear).Unsupported())
+	assert.True(t, LocalDate{}.YearWeek().IsZero())
+	assert.NotPanics(t, func() {
+		LocalDateMax().GetField(FieldWeekBasedYear)
+		LocalDateMin().GetField(FieldWeekBasedYear)
+		LocalDateMax().YearWeek()
+	})
+}
+
+func TestLocalDateChain_WithIsoFields(t *testing.T) {
+	for _, it := range []struct {
+		date     string
+		field    Field
+		value    int64
+		expected string
+	}{
+		{"2024-12-30", FieldWeekOfWeekBasedYear, 52, "2025-12-22"},
+		{"2024-12-30", FieldWeekOfWeekBasedYear, 1, "2024-12-30"},
+		{"2024-03-15", FieldWeekOfWeekBasedYear, 1, "2024-01-05"},
+		{"2021-01-01", FieldWeekOfWeekBasedYear, 53, "2021-01-01"},
+		{"2024-12-30", FieldWeekBasedYear, 2026, "2025-12-29"},
+		{"2020-12-31", FieldWeekBasedYear, 2021, "2021-12-30"},
+		{"2021-01-01", FieldWeekBasedYear, 2026, "2027-01-01"},
+		{"2021-01-01", FieldWeekBasedYear, 2024, "2024-12-27"},
+		{"2024-05-31", FieldQuarterOfYear, 1, "2024-02-29"},
+		{"2024-05-15", FieldQuarterOfYear, 4, "2024-11-15"},
+		{"2024-05-15", FieldDayOfQuarter, 1, "2024-04-01"},
+		{"2024-02-10", FieldDayOfQuarter, 91, "2024-03-31"},
+		{"2024-11-10", FieldDayOfQuarter, 92, "2024-12-31"},
+	} {
+		d, err := MustLocalDateParse(it.date).Chain().WithField(it.field, TemporalValueOf(it.value)).GetResult()
+		require.NoError(t, err, "%s %s %d", it.date, it.field, it.value)
+		assert.Equal(t, it.expected, d.String(), "%s %s %d", it.date, it.field, it.value)
+	}
+	for _, it := range []struct {
+		date  string
+		field Field
+		value int64
+	}{
+		{"2024-03-15", FieldWeekOfWeekBasedYear, 54},
+		{"2024-03-15", FieldWeekOfWeekBasedYear, 0},
+		{"2024-03-15", FieldQuarterOfYear, 5},
+		{"2024-03-15", FieldDayOfQuarter, 93},
+		// the range of the week-based-year and the quarter of the date
+		{"2021-06-01", FieldWeekOfWeekBasedYear, 53},
+		{"2023-06-01", FieldWeekOfWeekBasedYear, 53},
+		{"2024-03-15", FieldDayOfQuarter, 92},
+		{"2023-02-10", FieldDayOfQuarter, 91},
+		{"2024-05-15", FieldDayOfQuarter, 92},
+	} {
+		_, err := MustLocalDateParse(it.date).Chain().WithField(it.field, TemporalValueOf(it.value)).GetResult()
+		assert.ErrorIs(t, err, ErrOutOfRange, "%s %s %d", it.date, it.field, it.value)
+	}
+
+	ldt, err := MustLocalDateTimeParse("2024-12-30T10:00").Chain().WithField(FieldWeekOfWeekBasedYear, TemporalValueOf(2)).GetResult()
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-06T10:00:00", ldt.String())
+	odt, err := MustOffsetDateTimeParse("2024-12-30T10:00+08:00").Chain().WithField(FieldWeekBasedYear, TemporalValueOf(2024)).GetResult()
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01T10:00:00+08:00", odt.String())
+}
+
+func TestField_IsoFields(t *testing.T) {
+	assert.Equal(t, "WeekOfWeekBasedYear", FieldWeekOfWeekBasedYear.String())
+	assert.Equal(t, "IsoFields.WEEK_BASED_YEAR", FieldWeekBasedYear.JavaName())
+	assert.Equal(t, "ChronoField.OFFSET_SECONDS", FieldOffsetSeconds.JavaName())
+	assert.True(t, FieldQuarterOfYear.IsDateBased())
+	assert.False(t, (FieldWeekBasedYear + 1).Valid())
+}
+
+func TestYearWeekOf(t *testing.T) {
+	yw, err := YearWeekOf(2020, 53)
+	require.NoError(t, err)
+	assert.Equal(t, Year(2020), yw.Year())
+	assert.Equal(t, 53, yw.Week())
+	assert.True(t, yw.Is53WeekYear())
+	assert.Equal(t, 53, yw.LengthOfYear())
+	assert.Equal(t, 52, MustYearWeekOf(2024, 1).LengthOfYear())
+	assert.Equal(t, 0, YearWeek{}.LengthOfYear())
+
+	for _, it := range [][2]int{{2024, 53}, {2024, 0}, {2024, 54}} {
+		_, err = YearWeekOf(Year(it[0]), it[1])
+		assert.Error(t, err, "%v", it)
+	}
+	assert.Panics(t, func() { MustYearWeekOf(2023, 53) })
+	assert.False(t, MustYearWeekOf(0, 1).IsZero())
+	assert.Equal(t, "-0001-W52", MustYearWeekOf(-1, 52).String())
+}
+
+func TestYearWeek_AtDay(t *testing.T) {
+	var yw = MustYearWeekOf(2025, 1)
+	assert.Equal(t, MustLocalDateOf(2024, December, 30), yw.AtDay(Monday))
+	assert.Equal(t, MustLocalDateOf(2025, January, 5), yw.AtDay(Sunday))
+	assert.Equal(t, MustLocalDateOf(2021, January, 3), MustYearWeekOf(2020, 53).AtDay(Sunday))
+	assert.True(t, yw.AtDay(0).IsZero())
+	assert.True(t, yw.AtDay(8).IsZero())
+	assert.True(t, YearWeek{}.AtDay(Monday).IsZero())
+}
+
+func TestYearWeek_Compare(t *testing.T) {
+	var a = MustYearWeekOf(2020, 53)
+	var b = MustYearWeekOf(2021, 1)
+	assert.Equal(t, -1, a.Compare(b))
+	assert.Equal(t, 1, b.Compare(a))
+	assert.Equal(t, 0, a.Compare(MustYearWeekParse("2020-W53")))
+	assert.True(t, a.IsBefore(b))
+	assert.True(t, b.IsAfter(a))
+	assert.Equal(t, -1, YearWeek{}.Compare(a))
+}
+
+func TestYearWeek_GetField(t *testing.T) {
+	var yw = MustYearWeekOf(2024, 52)
+	assert.True(t, yw.IsSupportedField(FieldWeekOfWeekBasedYear))
+	assert.True(t, yw.IsSupportedField(FieldWeekBasedYear))
+	assert.False(t, yw.IsSupportedField(FieldYear))
+	assert.Equal(t, int64(52), yw.GetField(FieldWeekOfWeekBasedYear).Int64())
+	assert.Equal(t, int64(2024), yw.GetField(FieldWeekBasedYear).Int64())
+	assert.True(t, yw.GetField(FieldYear).Unsupported())
+	assert.True(t, YearWeek{}.GetField(FieldWeekBasedYear).Unsupported())
+}
+
+func TestYearWeekChain(t *testing.T) {
+	var yw = MustYearWeekOf(2020, 52)
+	assert.Equal(t, "2020-W53", yw.Chain().PlusWeeks(1).MustGet().String())
+	assert.Equal(t, "2021-W01", yw.Chain().PlusWeeks(2).MustGet().String())
+	assert.Equal(t, "2019-W51", yw.Chain().MinusWeeks(53).MustGet().String())
+	assert.Equal(t, "2020-W52", yw.Chain().PlusWeeks(0).MustGet().String())
+	assert.Equal(t, "2026-W53", MustYearWeekOf(2020, 53).Chain().PlusYears(6).MustGet().String())
+	assert.Equal(t, "2021-W52", MustYearWeekOf(2020, 53).Chain().PlusYears(1).MustGet().String())
+	assert.Equal(t, "2015-W53", MustYearWeekOf(2020, 53).Chain().MinusYears(5).MustGet().String())
+	assert.Equal(t, "2020-W10", yw.Chain().WithWeek(10).MustGet().String())
+	assert.Equal(t, "1999-W52", yw.Chain().WithYear(1999).MustGet().String())
+
+	_, err := MustYearWeekOf(2021, 1).Chain().WithWeek(53).GetResult()
+	assert.Error(t, err)
+	_, err = yw.Chain().WithField(FieldYear, TemporalValueOf(2024)).GetResult()
+	assert.ErrorIs(t, err, ErrUnsupported)
+	_, err = yw.Chain().PlusYears(YearMax).GetResult()
+	assert.Error(t, err)
+	_, err = MustYearWeekOf(YearMax, 1).Chain().PlusWeeks(1 << 62).GetResult()
+	assert.Error(t, err)
+	assert.True(t, YearWeek{}.Chain().PlusWeeks(1).MustGet().IsZero())
+}
+
+func TestYearWeek_Text(t *testing.T) {
+	for _, text := range []string{"2024-W01", "2020-W53", "0001-W01", "-0001-W52", "+12345-W10"} {
+		yw, err := YearWeekParse(text)
+		require.NoError(t, err, text)
+		if text[0] == '+' {
+			text = text[1:]
+		}
+		assert.Equal(t, text, yw.String())
+	}
+	assert.Equal(t, MustYearWeekOf(2024, 52), MustYearWeekParse("2024-w52"))
+	for _, text := range []string{"2024W52", "2024-W5", "2024-52", "2024-W053", "-W52", "2024-W53", "2024-W00", "2024-Wxx", "2024-12-30"} {
+		_, err := YearWeekParse(text)
+		assert.Error(t, err, text)
+	}
+	yw, err := YearWeekParse("")
+	require.NoError(t, err)
+	assert.True(t, yw.IsZero())
+	assert.Equal(t, "", yw.String())
+}
+
+func TestYearWeek_JSON(t *testing.T) {
+	type report struct {
+		Week YearWeek `json:"week"`
+		Prev YearWeek `json:"prev"`
+	}
+	data, err := json.Marshal(report{Week: MustYearWeekOf(2024, 52)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"week":"2024-W52","prev":""}`, string(data))
+
+	var r report
+	require.NoError(t, json.Unmarshal([]byte(`{"week":"2025-W01","prev":null}`), &r))
+	assert.Equal(t, MustYearWeekOf(2025, 1), r.Week)
+	assert.True(t, r.Prev.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`{"week":202452}`), &r))
+}
+
+func TestYearWeek_Scan(t *testing.T) {
+	var yw YearWeek
+	require.NoError(t, yw.Scan("2024-W52"))
+	assert.Equal(t, MustYearWeekOf(2024, 52), yw)
+	require.NoError(t, yw.Scan([]byte("2020-W53")))
+	assert.Equal(t, MustYearWeekOf(2020, 53), yw)
+	require.NoError(t, yw.Scan(nil))
+	assert.True(t, yw.IsZero())
+	assert.Error(t, yw.Scan(time.Now()))
+
+	v, err := MustYearWeekOf(2024, 52).Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2024-W52", v)
+	v, err = YearWeek{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
@@ -0,0 +1,94 @@
+package goda
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON strings in yyyy-Www format or JSON null.
+func (yw *YearWeek) UnmarshalJSON(bytes []byte) error {
+	if len(bytes) == 4 && string(bytes) == "null" {
+		*yw = YearWeek{}
+		return nil
+	}
+	return unmarshalJsonImpl(yw, bytes)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It returns the year-week as a JSON string in yyyy-Www format, or empty string for zero value.
+func (yw YearWeek) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(yw)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the year-week in yyyy-Www format, or empty for zero value.
+func (yw YearWeek) MarshalText() (text []byte, err error) {
+	return marshalTextImpl(yw)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses year-weeks in yyyy-Www format, the 'W' may be lowercase. Empty input is treated as zero value.
+func (yw *YearWeek) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*yw = YearWeek{}
+		return nil
+	}
+	var i = bytes.LastIndexByte(text, '-')
+	if i < 1 || len(text)-i != 4 || text[i+1] != 'W' && text[i+1] != 'w' {
+		return errors.New("yyyy-Www required")
+	}
+	year, e := parseInt64(text[:i])
+	if e != nil {
+		return
+	}
+	week, e := parseInt(text[i+2:])
+	if e != nil {
+		return
+	}
+	*yw, e = YearWeekOf(Year(year), week)
+	return
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the year-week in yyyy-Www format to b, or nothing for zero value.
+func (yw YearWeek) AppendText(b []byte) ([]byte, error) {
+	if yw.IsZero() {
+		return b, nil
+	}
+	b, _ = yw.Year().AppendText(b)
+	var week = yw.Week()
+	return append(b, '-', 'W', byte('0'+week/10), byte('0'+week%10)), nil
+}
+
+// String returns the year-week in yyyy-Www format, or empty string for zero value.
+func (yw YearWeek) String() string {
+	return stringImpl(yw)
+}
+
+// Scan implements the sql.Scanner interface.
+// It supports string, []byte and nil.
+func (yw *YearWeek) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*yw = YearWeek{}
+		return nil
+	case string:
+		return yw.UnmarshalText([]byte(v))
+	case []byte:
+		return yw.UnmarshalText(v)
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the year-week in yyyy-Www format, or nil for zero value.
+func (yw YearWeek) Value() (driver.Value, error) {
+	if yw.IsZero() {
+		return nil, nil
+	}
+	return yw.String(), nil
+}
@@ -0,0 +1,365 @@
+package goda
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ZonedDateTime represents a date-time with a time-zone,
+// such as 2024-03-15T14:30:45.123456789+01:00[Europe/Paris].
+//
+// ZonedDateTime stores a LocalDateTime, the resolved ZoneOffset and the ZoneId.
+// The offset is resolved from the zone rules, so that arithmetic respects daylight saving transitions:
+//   - Date-based operations (PlusDays, PlusMonths, WithDayOfMonth, ...) keep the local time
+//     and re-resolve the offset, so "09:00 every day" stays at 09:00.
+//   - Time-based operations (PlusHours, PlusSeconds, ...) operate on the instant time-line,
+//     so adding 24 hours across a transition changes the local time.
+//
+// When a local date-time falls into a gap (e.g. spring forward), it is shifted later by the length of the gap.
+// When it falls into an overlap (e.g. fall back), the earlier offset is used unless the previous offset
+// is still valid. Use WithEarlierOffsetAtOverlap and WithLaterOffsetAtOverlap to choose explicitly.
+//
+// ZonedDateTime is comparable and can be used as a map key.
+// The zero value represents an unset date-time and IsZero returns true for it.
+//
+// ZonedDateTime implements sql.Scanner and driver.Valuer for database operations,
+// encoding.TextMarshaler and encoding.TextUnmarshaler for text serialization,
+// and json.Marshaler and json.Unmarshaler for JSON serialization.
+//
+// Format: yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]±HH:mm[ZoneId] (e.g., "2024-03-31T03:30:00+02:00[Europe/Paris]").
+// The bracketed zone is omitted for zones with a fixed offset, matching Java's ZonedDateTime#toString.
+type ZonedDateTime struct {
+	datetime LocalDateTime
+	offset   ZoneOffset
+	zone     ZoneId
+}
+
+// LocalDateTime returns the local date-time part.
+func (zdt ZonedDateTime) LocalDateTime() LocalDateTime {
+	return zdt.datetime
+}
+
+// LocalDate returns the date part of this date-time.
+func (zdt ZonedDateTime) LocalDate() LocalDate {
+	return zdt.datetime.LocalDate()
+}
+
+// LocalTime returns the time part of this date-time.
+func (zdt ZonedDateTime) LocalTime() LocalTime {
+	return zdt.datetime.LocalTime()
+}
+
+// Offset returns the zone offset resolved for this date-time.
+func (zdt ZonedDateTime) Offset() ZoneOffset {
+	return zdt.offset
+}
+
+// Zone returns the time-zone.
+func (zdt ZonedDateTime) Zone() ZoneId {
+	return zdt.zone
+}
+
+// Year returns the year component.
+func (zdt ZonedDateTime) Year() Year {
+	return zdt.datetime.Year()
+}
+
+// Month returns the month component.
+func (zdt ZonedDateTime) Month() Month {
+	return zdt.datetime.Month()
+}
+
+// DayOfMonth returns the day-of-month component.
+func (zdt ZonedDateTime) DayOfMonth() int {
+	return zdt.datetime.DayOfMonth()
+}
+
+// DayOfWeek returns the day-of-week.
+func (zdt ZonedDateTime) DayOfWeek() DayOfWeek {
+	return zdt.datetime.DayOfWeek()
+}
+
+// DayOfYear returns the day-of-year.
+func (zdt ZonedDateTime) DayOfYear() int {
+	return zdt.datetime.DayOfYear()
+}
+
+// Hour returns the hour component (0-23).
+func (zdt ZonedDateTime) Hour() int {
+	return zdt.datetime.Hour()
+}
+
+// Minute returns the minute component (0-59).
+func (zdt ZonedDateTime) Minute() int {
+	return zdt.datetime.Minute()
+}
+
+// Second returns the second component (0-59).
+func (zdt ZonedDateTime) Second() int {
+	return zdt.datetime.Second()
+}
+
+// Millisecond returns the millisecond component (0-999).
+func (zdt ZonedDateTime) Millisecond() int {
+	return zdt.datetime.Millisecond()
+}
+
+// Nanosecond returns the nanosecond component (0-999999999).
+func (zdt ZonedDateTime) Nanosecond() int {
+	return zdt.datetime.Nanosecond()
+}
+
+// IsZero returns true if this is the zero value.
+func (zdt ZonedDateTime) IsZero() bool {
+	return zdt.datetime.IsZero() && zdt.zone.IsZero()
+}
+
+// IsLeapYear returns true if the year is a leap year.
+func (zdt ZonedDateTime) IsLeapYear() bool {
+	return zdt.datetime.IsLeapYear()
+}
+
+// IsSupportedField returns true if the field is supported by ZonedDateTime.
+// ZonedDateTime supports the same fields as OffsetDateTime.
+func (zdt ZonedDateTime) IsSupportedField(field Field) bool {
+	return zdt.ToOffsetDateTime().IsSupportedField(field)
+}
+
+// GetField returns the value of the specified field as a TemporalValue.
+// ZonedDateTime supports the same fields as OffsetDateTime, see OffsetDateTime.GetField.
+//
+// If the date-time is zero (IsZero() returns true), an unsupported TemporalValue is returned.
+func (zdt ZonedDateTime) GetField(field Field) TemporalValue {
+	if zdt.IsZero() {
+		return TemporalValue{unsupported: true}
+	}
+	return zdt.ToOffsetDateTime().GetField(field)
+}
+
+// ToOffsetDateTime converts this date-time to an OffsetDateTime, dropping the zone.
+// Returns zero value for zero value.
+func (zdt ZonedDateTime) ToOffsetDateTime() OffsetDateTime {
+	if zdt.IsZero() {
+		return OffsetDateTime{}
+	}
+	return zdt.datetime.AtOffset(zdt.offset)
+}
+
+// ToInstant converts this date-time to an Instant.
+// Returns zero value for zero value.
+func (zdt ZonedDateTime) ToInstant() Instant {
+	return zdt.ToOffsetDateTime().ToInstant()
+}
+
+// EpochSecond returns the number of seconds since Unix epoch (1970-01-01T00:00:00Z).
+func (zdt ZonedDateTime) EpochSecond() int64 {
+	return zdt.ToOffsetDateTime().EpochSecond()
+}
+
+// GoTime converts this date-time to a time.Time in the location of the zone.
+// Zones with a fixed offset use a fixed time.Location.
+// Returns time.Time{} (zero) for zero value.
+func (zdt ZonedDateTime) GoTime() time.Time {
+	if zdt.IsZero() {
+		return time.Time{}
+	}
+	var loc = zdt.zone.loc
+	if loc == nil {
+		loc = time.FixedZone(zdt.zone.String(), zdt.offset.TotalSeconds())
+	}
+	return time.Unix(zdt.EpochSecond(), int64(zdt.Nanosecond())).In(loc)
+}
+
+// Compare compares this date-time with another.
+// The comparison is based on the instant, then on the local date-time, then on the zone ID.
+// Returns -1 if this is before other, 0 if equal, 1 if after.
+func (zdt ZonedDateTime) Compare(other ZonedDateTime) int {
+	return doCompare(zdt, other, compareZero, comparing(ZonedDateTime.EpochSecond), comparing(ZonedDateTime.Nanosecond), comparing1(ZonedDateTime.LocalDateTime), comparing(func(z ZonedDateTime) string {
+		return z.zone.String()
+	}))
+}
+
+// IsBefore returns true if the instant of this date-time is before the specified date-time.
+func (zdt ZonedDateTime) IsBefore(other ZonedDateTime) bool {
+	return doCompare(zdt, other, compareZero, comparing(ZonedDateTime.EpochSecond), comparing(ZonedDateTime.Nanosecond)) < 0
+}
+
+// IsAfter returns true if the instant of this date-time is after the specified date-time.
+func (zdt ZonedDateTime) IsAfter(other ZonedDateTime) bool {
+	return doCompare(zdt, other, compareZero, comparing(ZonedDateTime.EpochSecond), comparing(ZonedDateTime.Nanosecond)) > 0
+}
+
+// UntilUnit returns the amount of time until another date-time in terms of the unit, such as the number of complete days.
+//
+// Date units operate on the local date-times, after moving the end to the zone of this date-time,
+// so one day is always one day even across a daylight saving change.
+// Time units operate on the instants, so a day with a gap of one hour is 23 hours.
+// Returns an error if the unit is unsupported or the result overflows, or zero if either date-time is zero.
+func (zdt ZonedDateTime) UntilUnit(endExclusive ZonedDateTime, unit Unit) (int64, error) {
+	if zdt.IsZero() || endExclusive.IsZero() {
+		return 0, nil
+	}
+	if !unit.IsDateBased() {
+		return zdt.ToOffsetDateTime().UntilUnit(endExclusive.ToOffsetDateTime(), unit)
+	}
+	end, e := ZonedDateTimeOfInstant(endExclusive.ToInstant(), zdt.zone)
+	if e != nil {
+		return 0, e
+	}
+	return zdt.datetime.UntilUnit(end.datetime, unit)
+}
+
+func (zdt ZonedDateTime) Chain() (chain ZonedDateTimeChain) {
+	chain.value = zdt
+	return
+}
+
+// zonedDateTimeOfLocal resolves a local date-time in the zone.
+// In an overlap, preferred is used if it is the later offset, otherwise the earlier offset is used.
+// In a gap, the local date-time is shifted later by the length of the gap.
+func zonedDateTimeOfLocal(ldt LocalDateTime, zone ZoneId, preferred *ZoneOffset) (r ZonedDateTime, e error) {
+	if ldt.IsZero() {
+		return
+	}
+	if zone.IsZero() {
+		e = &Error{reason: errReasonInvalidZoneId}
+		return
+	}
+	prev, next, mode := zone.getOffsets(ldt)
+	var offset = prev
+	switch mode {
+	case zoneOffsetModeOverlap:
+		if preferred != nil && *preferred == next {
+			offset = next
+		}
+	case zoneOffsetModeGap:
+		ldt, e = ldt.Chain().PlusSeconds(int64(next.TotalSeconds() - prev.TotalSeconds())).GetResult()
+		if e != nil {
+			return
+		}
+		offset = next
+	}
+	return ZonedDateTime{datetime: ldt, offset: offset, zone: zone}, nil
+}
+
+// zonedDateTimeOfEpochSecond creates a ZonedDateTime from an instant, resolving the offset from the zone.
+func zonedDateTimeOfEpochSecond(epochSecond int64, nanoOfSecond int64, zone ZoneId) (r ZonedDateTime, e error) {
+	if zone.IsZero() {
+		e = &Error{reason: errReasonInvalidZoneId}
+		return
+	}
+	var offset = zone.offsetOfEpochSecond(epochSecond)
+	r.datetime, e = LocalDateTimeOfEpochSecond(epochSecond, nanoOfSecond, offset)
+	if e != nil {
+		return
+	}
+	r.offset = offset
+	r.zone = zone
+	return
+}
+
+// ZonedDateTimeOf creates a ZonedDateTime from a local date-time and a zone.
+//
+// If the local date-time falls into a gap, it is shifted later by the length of the gap.
+// If it falls into an overlap, the earlier offset is used.
+//
+// Returns an error if the zone is zero while the local date-time is not.
+// Returns zero value if localDateTime is zero.
+func ZonedDateTimeOf(localDateTime LocalDateTime, zone ZoneId) (ZonedDateTime, error) {
+	return zonedDateTimeOfLocal(localDateTime, zone, nil)
+}
+
+// MustZonedDateTimeOf creates a ZonedDateTime from a local date-time and a zone.
+// Panics if the zone is zero. Use ZonedDateTimeOf for error handling.
+func MustZonedDateTimeOf(localDateTime LocalDateTime, zone ZoneId) ZonedDateTime {
+	return mustValue(ZonedDateTimeOf(localDateTime, zone))
+}
+
+// ZonedDateTimeOfInstant creates a ZonedDateTime from an instant and a zone.
+// The offset is the one in effect at the instant, there is no ambiguity.
+// Returns zero value if instant is zero, returns an error if the zone is zero.
+func ZonedDateTimeOfInstant(instant Instant, zone ZoneId) (ZonedDateTime, error) {
+	if instant.IsZero() {
+		return ZonedDateTime{}, nil
+	}
+	return zonedDateTimeOfEpochSecond(instant.EpochSecond(), int64(instant.Nano()), zone)
+}
+
+// MustZonedDateTimeOfInstant creates a ZonedDateTime from an instant and a zone.
+// Panics if the zone is zero. Use ZonedDateTimeOfInstant for error handling.
+func MustZonedDateTimeOfInstant(instant Instant, zone ZoneId) ZonedDateTime {
+	return mustValue(ZonedDateTimeOfInstant(instant, zone))
+}
+
+// ZonedDateTimeOfGoTime creates a ZonedDateTime from a time.Time.
+// The zone is created from t.Location() using ZoneIdOfGoLocation.
+// Returns zero value if t.IsZero().
+func ZonedDateTimeOfGoTime(t time.Time) ZonedDateTime {
+	if t.IsZero() {
+		return ZonedDateTime{}
+	}
+	return MustZonedDateTimeOfInstant(InstantOfGoTime(t), ZoneIdOfGoLocation(t.Location()))
+}
+
+// ZonedDateTimeNow returns the current date-time in the system's default time zone.
+func ZonedDateTimeNow() ZonedDateTime {
+	return MustZonedDateTimeOfInstant(InstantNow(), ZoneIdDefault())
+}
+
+// ZonedDateTimeNowIn returns the current date-time in the specified time zone.
+// Returns zero value if the zone is zero.
+func ZonedDateTimeNowIn(zone ZoneId) ZonedDateTime {
+	r, _ := ZonedDateTimeOfInstant(InstantNow(), zone)
+	return r
+}
+
+// ZonedDateTimeNowClock returns the current date-time of the clock in the time zone of the clock.
+// Returns zero value if the instant or the zone of the clock is zero.
+func ZonedDateTimeNowClock(clock Clock) ZonedDateTime {
+	return zonedDateTimeNowClock(clock)
+}
+
+// ZonedDateTimeParse parses a date-time string with offset and zone ID,
+// such as "2024-03-15T14:30:45+01:00[Europe/Paris]".
+//
+// The bracketed zone ID is optional, without it the offset is used as a fixed-offset zone.
+// When both are present, the instant described by the date-time and offset is kept,
+// and the offset is adjusted to the zone rules if necessary, as Java's ZonedDateTime#parse does.
+//
+// Examples:
+//
+//	zdt, err := ZonedDateTimeParse("2024-03-31T03:30:00+02:00[Europe/Paris]")
+//	zdt, err := ZonedDateTimeParse("2024-03-15T14:30:45Z")
+func ZonedDateTimeParse(s string) (ZonedDateTime, error) {
+	var zdt ZonedDateTime
+	err := zdt.UnmarshalText([]byte(s))
+	return zdt, err
+}
+
+// MustZonedDateTimeParse parses a date-time string with offset and zone ID.
+// Panics if the string is invalid. Use ZonedDateTimeParse for error handling.
+func MustZonedDateTimeParse(s string) ZonedDateTime {
+	return mustValue(ZonedDateTimeParse(s))
+}
+
+// Compile-time interface checks
+var (
+	_ encoding.TextAppender    = (*ZonedDateTime)(nil)
+	_ fmt.Stringer             = (*ZonedDateTime)(nil)
+	_ encoding.TextMarshaler   = (*ZonedDateTime)(nil)
+	_ encoding.TextUnmarshaler = (*ZonedDateTime)(nil)
+	_ json.Marshaler           = (*ZonedDateTime)(nil)
+	_ json.Unmarshaler         = (*ZonedDateTime)(nil)
+	_ driver.Valuer            = (*ZonedDateTime)(nil)
+	_ sql.Scanner              = (*ZonedDateTime)(nil)
+	_ TemporalAccessor         = (*ZonedDateTime)(nil)
+)
+
+// Compile-time check that ZonedDateTime is comparable
+func _assertZonedDateTimeIsComparable[T comparable](t T) {}
+
+var _ = _assertZonedDateTimeIsComparable[ZonedDateTime]
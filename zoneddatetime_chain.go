@@ -0,0 +1,307 @@
+package goda
+
+type ZonedDateTimeChain struct {
+	Chain[ZonedDateTime]
+}
+
+func (zdt ZonedDateTime) chainWithError(e error) (chain ZonedDateTimeChain) {
+	chain = zdt.Chain()
+	chain.eError = e
+	return
+}
+
+// resolveLocal replaces the local date-time and resolves the offset, retaining the current offset if possible.
+func (z ZonedDateTimeChain) resolveLocal(ldt LocalDateTime) ZonedDateTimeChain {
+	if !z.ok() {
+		return z
+	}
+	z.value, z.eError = zonedDateTimeOfLocal(ldt, z.value.zone, &z.value.offset)
+	return z
+}
+
+// resolveInstant interprets the local date-time with the current offset and resolves the offset
+// in effect at the resulting instant.
+func (z ZonedDateTimeChain) resolveInstant(ldt LocalDateTime) ZonedDateTimeChain {
+	if !z.ok() {
+		return z
+	}
+	seconds, overflow := ldt.AtOffset(z.value.offset).epochSecondOverflow()
+	if overflow {
+		z.eError = overflowError()
+		return z
+	}
+	z.value, z.eError = zonedDateTimeOfEpochSecond(seconds, int64(ldt.Nanosecond()), z.value.zone)
+	return z
+}
+
+// WithField returns a copy of this ZonedDateTime with the specified field replaced.
+// Zero values return zero immediately.
+//
+//   - FieldInstantSeconds: moves to the specified instant, the offset is resolved at the new instant.
+//   - FieldOffsetSeconds: the offset is changed only if it is valid for the local date-time,
+//     i.e. to switch between the two offsets of an overlap. Otherwise the value is unchanged.
+//   - Other fields are applied to the local date-time, then the offset is resolved, retaining the
+//     current offset if possible.
+func (z ZonedDateTimeChain) WithField(field Field, value TemporalValue) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithField)
+	newValue := value.Int64()
+	field.checkSetE(newValue, &z.eError)
+	if !z.ok() {
+		return z
+	}
+	switch field {
+	case FieldInstantSeconds:
+		z.value, z.eError = zonedDateTimeOfEpochSecond(newValue, int64(z.value.Nanosecond()), z.value.zone)
+	case FieldOffsetSeconds:
+		var offset = ZoneOffset{totalSeconds: int32(newValue)}
+		prev, next, mode := z.value.zone.getOffsets(z.value.datetime)
+		if offset == prev || (mode == zoneOffsetModeOverlap && offset == next) {
+			z.value.offset = offset
+		}
+	default:
+		ldt := z.value.datetime.chainWithError(z.eError).WithField(field, value).mergeError(&z.eError)
+		return z.resolveLocal(ldt)
+	}
+	return z
+}
+
+// WithZoneSameInstant returns a copy with a different zone, retaining the instant.
+// The local date-time is adjusted to the new zone. Returns an error if the zone is zero.
+func (z ZonedDateTimeChain) WithZoneSameInstant(zone ZoneId) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithZoneSameInstant)
+	if !z.ok() {
+		return z
+	}
+	z.value, z.eError = zonedDateTimeOfEpochSecond(z.value.EpochSecond(), int64(z.value.Nanosecond()), zone)
+	return z
+}
+
+// WithZoneSameLocal returns a copy with a different zone, retaining the local date-time if possible.
+// The local date-time is only changed if it falls into a gap in the new zone.
+// Returns an error if the zone is zero.
+func (z ZonedDateTimeChain) WithZoneSameLocal(zone ZoneId) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithZoneSameLocal)
+	if !z.ok() {
+		return z
+	}
+	z.value, z.eError = zonedDateTimeOfLocal(z.value.datetime, zone, &z.value.offset)
+	return z
+}
+
+// WithEarlierOffsetAtOverlap returns a copy using the earlier of the two valid offsets
+// if the local date-time is in an overlap, such as an autumn daylight saving cutover.
+// Otherwise the value is unchanged.
+func (z ZonedDateTimeChain) WithEarlierOffsetAtOverlap() ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithEarlierOffsetAtOverlap)
+	if !z.ok() {
+		return z
+	}
+	prev, _, mode := z.value.zone.getOffsets(z.value.datetime)
+	if mode == zoneOffsetModeOverlap {
+		z.value.offset = prev
+	}
+	return z
+}
+
+// WithLaterOffsetAtOverlap returns a copy using the later of the two valid offsets
+// if the local date-time is in an overlap, such as an autumn daylight saving cutover.
+// Otherwise the value is unchanged.
+func (z ZonedDateTimeChain) WithLaterOffsetAtOverlap() ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithLaterOffsetAtOverlap)
+	if !z.ok() {
+		return z
+	}
+	_, next, mode := z.value.zone.getOffsets(z.value.datetime)
+	if mode == zoneOffsetModeOverlap {
+		z.value.offset = next
+	}
+	return z
+}
+
+func (z ZonedDateTimeChain) PlusYears(years int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusYears)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusYears(years).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) MinusYears(years int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusYears)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusYears(years).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) PlusMonths(months int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusMonths)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusMonths(months).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) MinusMonths(months int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusMonths)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusMonths(months).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) PlusWeeks(weeks int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusWeeks)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusWeeks(weeks).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) MinusWeeks(weeks int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusWeeks)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusWeeks(weeks).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) PlusDays(days int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusDays)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusDays(days).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) MinusDays(days int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusDays)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusDays(days).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) PlusHours(hours int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusHours)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusHours(hours).mergeError(&z.eError)
+	return z.resolveInstant(ldt)
+}
+
+func (z ZonedDateTimeChain) MinusHours(hours int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusHours)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusHours(hours).mergeError(&z.eError)
+	return z.resolveInstant(ldt)
+}
+
+func (z ZonedDateTimeChain) PlusMinutes(minutes int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusMinutes)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusMinutes(minutes).mergeError(&z.eError)
+	return z.resolveInstant(ldt)
+}
+
+func (z ZonedDateTimeChain) MinusMinutes(minutes int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusMinutes)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusMinutes(minutes).mergeError(&z.eError)
+	return z.resolveInstant(ldt)
+}
+
+func (z ZonedDateTimeChain) PlusSeconds(seconds int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusSeconds)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusSeconds(seconds).mergeError(&z.eError)
+	return z.resolveInstant(ldt)
+}
+
+func (z ZonedDateTimeChain) MinusSeconds(seconds int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusSeconds)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusSeconds(seconds).mergeError(&z.eError)
+	return z.resolveInstant(ldt)
+}
+
+func (z ZonedDateTimeChain) PlusNanos(nanos int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusNanos)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusNanos(nanos).mergeError(&z.eError)
+	return z.resolveInstant(ldt)
+}
+
+func (z ZonedDateTimeChain) MinusNanos(nanos int64) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusNanos)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusNanos(nanos).mergeError(&z.eError)
+	return z.resolveInstant(ldt)
+}
+
+// Plus returns a copy of this date-time with the specified amount added.
+// A Period is added to the local date-time and the offset is resolved, as the date-based Plus methods do.
+// A Duration is added on the instant time-line, as the time-based Plus methods do.
+func (z ZonedDateTimeChain) Plus(amount TemporalAmount) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlus)
+	ldt := z.value.datetime.chainWithError(z.eError).Plus(amount).mergeError(&z.eError)
+	if _, ok := amount.(Period); ok {
+		return z.resolveLocal(ldt)
+	}
+	return z.resolveInstant(ldt)
+}
+
+// Minus returns a copy of this date-time with the specified amount subtracted, see Plus.
+func (z ZonedDateTimeChain) Minus(amount TemporalAmount) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinus)
+	ldt := z.value.datetime.chainWithError(z.eError).Minus(amount).mergeError(&z.eError)
+	if _, ok := amount.(Period); ok {
+		return z.resolveLocal(ldt)
+	}
+	return z.resolveInstant(ldt)
+}
+
+// PlusUnit returns a copy of this date-time with the amount of the unit added.
+// Date units are added to the local date-time and the offset is resolved, as the date-based Plus methods do.
+// Time units are added on the instant time-line, as the time-based Plus methods do.
+func (z ZonedDateTimeChain) PlusUnit(amount int64, unit Unit) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnPlusUnit)
+	ldt := z.value.datetime.chainWithError(z.eError).PlusUnit(amount, unit).mergeError(&z.eError)
+	if unit.IsDateBased() {
+		return z.resolveLocal(ldt)
+	}
+	return z.resolveInstant(ldt)
+}
+
+// MinusUnit returns a copy of this date-time with the amount of the unit subtracted, see PlusUnit.
+func (z ZonedDateTimeChain) MinusUnit(amount int64, unit Unit) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnMinusUnit)
+	ldt := z.value.datetime.chainWithError(z.eError).MinusUnit(amount, unit).mergeError(&z.eError)
+	if unit.IsDateBased() {
+		return z.resolveLocal(ldt)
+	}
+	return z.resolveInstant(ldt)
+}
+
+func (z ZonedDateTimeChain) WithYear(year Year) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithYear)
+	ldt := z.value.datetime.chainWithError(z.eError).WithYear(year).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) WithMonth(month Month) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithMonth)
+	ldt := z.value.datetime.chainWithError(z.eError).WithMonth(month).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) WithDayOfMonth(dayOfMonth int) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithDayOfMonth)
+	ldt := z.value.datetime.chainWithError(z.eError).WithDayOfMonth(dayOfMonth).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) WithDayOfYear(dayOfYear int) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithDayOfYear)
+	ldt := z.value.datetime.chainWithError(z.eError).WithDayOfYear(dayOfYear).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) WithHour(hour int) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithHour)
+	ldt := z.value.datetime.chainWithError(z.eError).WithHour(hour).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) WithMinute(minute int) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithMinute)
+	ldt := z.value.datetime.chainWithError(z.eError).WithMinute(minute).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) WithSecond(second int) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithSecond)
+	ldt := z.value.datetime.chainWithError(z.eError).WithSecond(second).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
+
+func (z ZonedDateTimeChain) WithNano(nanoOfSecond int) ZonedDateTimeChain {
+	defer z.leaveFunction(tyZonedDateTime, fnWithNano)
+	ldt := z.value.datetime.chainWithError(z.eError).WithNano(nanoOfSecond).mergeError(&z.eError)
+	return z.resolveLocal(ldt)
+}
@@ -0,0 +1,262 @@
+package goda
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZonedDateTimeOf(t *testing.T) {
+	paris := MustZoneIdOf("Europe/Paris")
+
+	t.Run("normal", func(t *testing.T) {
+		zdt, err := ZonedDateTimeOf(MustLocalDateTimeParse("2024-03-15T14:30:45"), paris)
+		require.NoError(t, err)
+		assert.Equal(t, MustZoneOffsetOfHours(1), zdt.Offset())
+		assert.Equal(t, "2024-03-15T14:30:45+01:00[Europe/Paris]", zdt.String())
+	})
+
+	t.Run("gap shifts later", func(t *testing.T) {
+		zdt, err := ZonedDateTimeOf(MustLocalDateTimeParse("2024-03-31T02:30"), paris)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03-31T03:30:00+02:00[Europe/Paris]", zdt.String())
+	})
+
+	t.Run("overlap uses earlier offset", func(t *testing.T) {
+		zdt, err := ZonedDateTimeOf(MustLocalDateTimeParse("2024-10-27T02:30"), paris)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-10-27T02:30:00+02:00[Europe/Paris]", zdt.String())
+	})
+
+	t.Run("overlap where the later offset is UTC", func(t *testing.T) {
+		zdt, err := ZonedDateTimeOf(MustLocalDateTimeParse("2024-10-27T01:30"), MustZoneIdOf("Europe/London"))
+		require.NoError(t, err)
+		assert.Equal(t, "2024-10-27T01:30:00+01:00[Europe/London]", zdt.String())
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		zdt, err := ZonedDateTimeOf(LocalDateTime{}, paris)
+		require.NoError(t, err)
+		assert.True(t, zdt.IsZero())
+
+		_, err = ZonedDateTimeOf(MustLocalDateTimeParse("2024-03-15T14:30"), ZoneId{})
+		assert.Error(t, err)
+		assert.Panics(t, func() { MustZonedDateTimeOf(MustLocalDateTimeParse("2024-03-15T14:30"), ZoneId{}) })
+	})
+}
+
+func TestZonedDateTimeOfInstant(t *testing.T) {
+	paris := MustZoneIdOf("Europe/Paris")
+	i := MustInstantParse("2024-10-27T00:30:00Z")
+	zdt, err := ZonedDateTimeOfInstant(i, paris)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-10-27T02:30:00+02:00[Europe/Paris]", zdt.String())
+	assert.Equal(t, i, zdt.ToInstant())
+
+	zdt = MustZonedDateTimeOfInstant(i.Chain().PlusSeconds(3600).MustGet(), paris)
+	assert.Equal(t, "2024-10-27T02:30:00+01:00[Europe/Paris]", zdt.String())
+
+	zdt, err = ZonedDateTimeOfInstant(Instant{}, paris)
+	require.NoError(t, err)
+	assert.True(t, zdt.IsZero())
+	_, err = ZonedDateTimeOfInstant(i, ZoneId{})
+	assert.Error(t, err)
+}
+
+func TestZonedDateTime_GoTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	goTime := time.Date(2024, time.July, 4, 12, 0, 0, 5, loc)
+	zdt := ZonedDateTimeOfGoTime(goTime)
+	assert.Equal(t, "2024-07-04T12:00:00.000000005-04:00[America/New_York]", zdt.String())
+	assert.True(t, goTime.Equal(zdt.GoTime()))
+	assert.Equal(t, loc, zdt.GoTime().Location())
+
+	fixed := MustZonedDateTimeParse("2024-07-04T12:00:00+05:30")
+	_, offset := fixed.GoTime().Zone()
+	assert.Equal(t, 5*3600+1800, offset)
+
+	assert.True(t, ZonedDateTimeOfGoTime(time.Time{}).IsZero())
+	assert.True(t, ZonedDateTime{}.GoTime().IsZero())
+	assert.False(t, ZonedDateTimeNow().IsZero())
+	assert.Equal(t, ZoneIdUTC(), ZonedDateTimeNowIn(ZoneIdUTC()).Zone())
+}
+
+func TestZonedDateTime_Compare(t *testing.T) {
+	a := MustZonedDateTimeParse("2024-03-15T14:30:00+01:00[Europe/Paris]")
+	b := MustZonedDateTimeParse("2024-03-15T13:30:00Z")
+	c := MustZonedDateTimeParse("2024-03-15T13:30:01Z")
+
+	assert.False(t, a.IsBefore(b))
+	assert.False(t, a.IsAfter(b))
+	assert.NotEqual(t, 0, a.Compare(b))
+	assert.Equal(t, -b.Compare(a), a.Compare(b))
+	assert.Equal(t, -1, a.Compare(c))
+	assert.True(t, a.IsBefore(c))
+	assert.True(t, c.IsAfter(b))
+	assert.Equal(t, 0, a.Compare(a))
+	assert.Equal(t, -1, ZonedDateTime{}.Compare(a))
+}
+
+func TestZonedDateTime_GetField(t *testing.T) {
+	zdt := MustZonedDateTimeParse("2024-03-15T14:30:45+01:00[Europe/Paris]")
+	assert.True(t, zdt.IsSupportedField(FieldOffsetSeconds))
+	assert.Equal(t, int64(3600), zdt.GetField(FieldOffsetSeconds).Int64())
+	assert.Equal(t, int64(14), zdt.GetField(FieldHourOfDay).Int64())
+	assert.Equal(t, zdt.EpochSecond(), zdt.GetField(FieldInstantSeconds).Int64())
+	assert.True(t, ZonedDateTime{}.GetField(FieldHourOfDay).Unsupported())
+}
+
+func TestZonedDateTimeChain(t *testing.T) {
+	paris := MustZoneIdOf("Europe/Paris")
+	beforeGap := MustZonedDateTimeOf(MustLocalDateTimeParse("2024-03-30T09:00"), paris)
+
+	t.Run("date-based keeps local time", func(t *testing.T) {
+		assert.Equal(t, "2024-03-31T09:00:00+02:00[Europe/Paris]", beforeGap.Chain().PlusDays(1).MustGet().String())
+		assert.Equal(t, "2024-03-29T09:00:00+01:00[Europe/Paris]", beforeGap.Chain().MinusDays(1).MustGet().String())
+		assert.Equal(t, "2024-04-30T09:00:00+02:00[Europe/Paris]", beforeGap.Chain().PlusMonths(1).MustGet().String())
+		assert.Equal(t, "2025-03-30T09:00:00+02:00[Europe/Paris]", beforeGap.Chain().PlusYears(1).MustGet().String())
+		assert.Equal(t, "2024-04-06T09:00:00+02:00[Europe/Paris]", beforeGap.Chain().PlusWeeks(1).MustGet().String())
+	})
+
+	t.Run("time-based follows the instant", func(t *testing.T) {
+		assert.Equal(t, "2024-03-31T10:00:00+02:00[Europe/Paris]", beforeGap.Chain().PlusHours(24).MustGet().String())
+		assert.Equal(t, "2024-03-31T10:00:00+02:00[Europe/Paris]", beforeGap.Chain().PlusMinutes(24*60).MustGet().String())
+		assert.Equal(t, "2024-03-31T10:00:00+02:00[Europe/Paris]", beforeGap.Chain().PlusSeconds(24*3600).MustGet().String())
+		assert.Equal(t, beforeGap, beforeGap.Chain().PlusHours(24).MinusHours(24).MustGet())
+		assert.Equal(t, beforeGap, beforeGap.Chain().PlusNanos(1).MinusNanos(1).MustGet())
+
+		overlap := MustZonedDateTimeParse("2024-10-27T02:30:00+02:00[Europe/Paris]")
+		assert.Equal(t, "2024-10-27T02:30:00+01:00[Europe/Paris]", overlap.Chain().PlusHours(1).MustGet().String())
+	})
+
+	t.Run("with retains offset in overlap", func(t *testing.T) {
+		later := MustZonedDateTimeParse("2024-10-27T02:10:00+01:00[Europe/Paris]")
+		assert.Equal(t, "2024-10-27T02:40:00+01:00[Europe/Paris]", later.Chain().WithMinute(40).MustGet().String())
+		assert.Equal(t, "2024-10-27T02:10:00+02:00[Europe/Paris]", later.Chain().WithEarlierOffsetAtOverlap().MustGet().String())
+		assert.Equal(t, later, later.Chain().WithEarlierOffsetAtOverlap().WithLaterOffsetAtOverlap().MustGet())
+		assert.Equal(t, beforeGap, beforeGap.Chain().WithLaterOffsetAtOverlap().MustGet())
+	})
+
+	t.Run("with resolves gap", func(t *testing.T) {
+		zdt := beforeGap.Chain().WithDayOfMonth(31).WithHour(2).MustGet()
+		assert.Equal(t, "2024-03-31T03:00:00+02:00[Europe/Paris]", zdt.String())
+	})
+
+	t.Run("with field", func(t *testing.T) {
+		overlap := MustZonedDateTimeParse("2024-10-27T02:30:00+02:00[Europe/Paris]")
+		assert.Equal(t, "2024-10-27T02:30:00+01:00[Europe/Paris]", overlap.Chain().WithField(FieldOffsetSeconds, TemporalValueOf(3600)).MustGet().String())
+		assert.Equal(t, overlap, overlap.Chain().WithField(FieldOffsetSeconds, TemporalValueOf(7200*2)).MustGet())
+		assert.Equal(t, "1970-01-01T01:00:00+01:00[Europe/Paris]", overlap.Chain().WithField(FieldInstantSeconds, TemporalValueOf(0)).MustGet().String())
+		assert.Equal(t, "2024-10-27T05:30:00+01:00[Europe/Paris]", overlap.Chain().WithField(FieldHourOfDay, TemporalValueOf(5)).MustGet().String())
+		_, err := overlap.Chain().WithField(FieldHourOfDay, TemporalValueOf(25)).GetResult()
+		assert.Error(t, err)
+	})
+
+	t.Run("zone", func(t *testing.T) {
+		tokyo := MustZoneIdOf("Asia/Tokyo")
+		assert.Equal(t, "2024-03-30T17:00:00+09:00[Asia/Tokyo]", beforeGap.Chain().WithZoneSameInstant(tokyo).MustGet().String())
+		assert.Equal(t, "2024-03-30T09:00:00+09:00[Asia/Tokyo]", beforeGap.Chain().WithZoneSameLocal(tokyo).MustGet().String())
+		assert.Equal(t, "2024-03-30T08:00:00Z", beforeGap.Chain().WithZoneSameInstant(ZoneIdUTC()).MustGet().String())
+		_, err := beforeGap.Chain().WithZoneSameInstant(ZoneId{}).GetResult()
+		assert.Error(t, err)
+		_, err = beforeGap.Chain().WithZoneSameLocal(ZoneId{}).GetResult()
+		assert.Error(t, err)
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		assert.True(t, ZonedDateTime{}.Chain().PlusDays(1).PlusHours(1).MustGet().IsZero())
+	})
+}
+
+func TestZonedDateTime_Text(t *testing.T) {
+	t.Run("parse", func(t *testing.T) {
+		zdt, err := ZonedDateTimeParse("2024-03-15T14:30:45.123+01:00[Europe/Paris]")
+		require.NoError(t, err)
+		assert.Equal(t, MustZoneIdOf("Europe/Paris"), zdt.Zone())
+		assert.Equal(t, "2024-03-15T14:30:45.123+01:00[Europe/Paris]", zdt.String())
+
+		// the instant is retained, the offset is corrected by the zone rules
+		zdt, err = ZonedDateTimeParse("2024-07-15T14:30:00+01:00[Europe/Paris]")
+		require.NoError(t, err)
+		assert.Equal(t, "2024-07-15T15:30:00+02:00[Europe/Paris]", zdt.String())
+
+		// the offset distinguishes the two local date-times of an overlap
+		zdt, err = ZonedDateTimeParse("2024-10-27T02:30:00+01:00[Europe/Paris]")
+		require.NoError(t, err)
+		assert.Equal(t, MustZoneOffsetOfHours(1), zdt.Offset())
+
+		zdt, err = ZonedDateTimeParse("2024-03-15T14:30:45-05:00")
+		require.NoError(t, err)
+		assert.Equal(t, ZoneIdOfOffset(MustZoneOffsetOfHours(-5)), zdt.Zone())
+		assert.Equal(t, "2024-03-15T14:30:45-05:00", zdt.String())
+
+		zdt, err = ZonedDateTimeParse("")
+		require.NoError(t, err)
+		assert.True(t, zdt.IsZero())
+
+		for _, s := range []string{
+			"2024-03-15T14:30:45",
+			"2024-03-15T14:30:45+01:00[Invalid/Zone]",
+			"2024-03-15T14:30:45+01:00Europe/Paris]",
+			"2024-03-15T14:30:45[Europe/Paris]",
+		} {
+			_, err = ZonedDateTimeParse(s)
+			assert.Error(t, err, s)
+		}
+		assert.Panics(t, func() { MustZonedDateTimeParse("invalid") })
+	})
+
+	t.Run("json", func(t *testing.T) {
+		type S struct {
+			At ZonedDateTime `json:"at"`
+		}
+		expected := MustZonedDateTimeParse("2024-03-31T03:30:00+02:00[Europe/Paris]")
+		data, err := json.Marshal(S{At: expected})
+		require.NoError(t, err)
+		assert.Equal(t, `{"at":"2024-03-31T03:30:00+02:00[Europe/Paris]"}`, string(data))
+
+		var s S
+		require.NoError(t, json.Unmarshal(data, &s))
+		assert.Equal(t, expected, s.At)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"at":null}`), &s))
+		assert.True(t, s.At.IsZero())
+	})
+}
+
+func TestZonedDateTime_Scan(t *testing.T) {
+	var zdt ZonedDateTime
+	require.NoError(t, zdt.Scan("2024-03-15T14:30:45+01:00[Europe/Paris]"))
+	assert.Equal(t, "2024-03-15T14:30:45+01:00[Europe/Paris]", zdt.String())
+
+	require.NoError(t, zdt.Scan([]byte("2024-03-15T14:30:45Z")))
+	assert.Equal(t, "2024-03-15T14:30:45Z", zdt.String())
+
+	require.NoError(t, zdt.Scan(time.Date(2024, time.March, 15, 14, 30, 45, 0, time.UTC)))
+	assert.Equal(t, int64(1710513045), zdt.EpochSecond())
+
+	require.NoError(t, zdt.Scan(nil))
+	assert.True(t, zdt.IsZero())
+
+	assert.Error(t, zdt.Scan(1))
+
+	v, err := MustZonedDateTimeParse("2024-03-15T14:30:45+01:00[Europe/Paris]").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15T14:30:45+01:00", v)
+	v, err = ZonedDateTime{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestZonedDateTime_ValuePostgres(t *testing.T) {
+	var pg = GetPG(t)
+	expected := MustZonedDateTimeParse("2000-12-29T12:00:00.123456+08:00")
+	var actual ZonedDateTime
+	err := pg.QueryRow("SELECT $1::timestamptz", expected).Scan(&actual)
+	assert.NoError(t, err)
+	assert.True(t, expected.ToInstant() == actual.ToInstant())
+}
@@ -0,0 +1,113 @@
+package goda
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"time"
+)
+
+// String returns the ISO 8601 string representation (yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]±HH:mm[ZoneId]).
+func (zdt ZonedDateTime) String() string {
+	return stringImpl(zdt)
+}
+
+// AppendText implements encoding.TextAppender.
+// The bracketed zone ID is only appended for region-based zones.
+func (zdt ZonedDateTime) AppendText(b []byte) ([]byte, error) {
+	if zdt.IsZero() {
+		return b, nil
+	}
+	b, _ = zdt.datetime.AppendText(b)
+	b, _ = zdt.offset.AppendText(b)
+	if zdt.zone.loc != nil {
+		b = append(b, '[')
+		b = append(b, zdt.zone.String()...)
+		b = append(b, ']')
+	}
+	return b, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (zdt ZonedDateTime) MarshalText() ([]byte, error) {
+	return marshalTextImpl(zdt)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Accepts ISO 8601 format: yyyy-MM-ddTHH:mm:ss[.nnnnnnnnn]±HH:mm[:ss] or Z for UTC,
+// optionally followed by a zone ID in brackets.
+// Empty input is treated as zero value.
+func (zdt *ZonedDateTime) UnmarshalText(text []byte) (e error) {
+	defer deferOpInParse(text, &e)
+	if len(text) == 0 {
+		*zdt = ZonedDateTime{}
+		return nil
+	}
+	var zone ZoneId
+	if text[len(text)-1] == ']' {
+		var idx = bytes.LastIndexByte(text, '[')
+		if idx < 0 {
+			return &Error{reason: errReasonInvalidZoneId}
+		}
+		if zone, e = ZoneIdOf(string(text[idx+1 : len(text)-1])); e != nil {
+			return
+		}
+		text = text[:idx]
+	}
+	var odt OffsetDateTime
+	if e = odt.UnmarshalText(text); e != nil {
+		return
+	}
+	if zone.IsZero() {
+		zone = ZoneIdOfOffset(odt.Offset())
+	}
+	seconds, overflow := odt.epochSecondOverflow()
+	if overflow {
+		return overflowError()
+	}
+	*zdt, e = zonedDateTimeOfEpochSecond(seconds, int64(odt.Nanosecond()), zone)
+	return
+}
+
+// MarshalJSON implements json.Marshaler.
+func (zdt ZonedDateTime) MarshalJSON() ([]byte, error) {
+	return marshalJsonImpl(zdt)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (zdt *ZonedDateTime) UnmarshalJSON(data []byte) error {
+	if len(data) == 4 && string(data) == "null" {
+		*zdt = ZonedDateTime{}
+		return nil
+	}
+	return unmarshalJsonImpl(zdt, data)
+}
+
+// Scan implements sql.Scanner.
+// It supports scanning from nil, string, []byte, and time.Time.
+// Nil values are converted to the zero value of ZonedDateTime.
+func (zdt *ZonedDateTime) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*zdt = ZonedDateTime{}
+		return nil
+	case string:
+		return zdt.UnmarshalText([]byte(v))
+	case []byte:
+		return zdt.UnmarshalText(v)
+	case time.Time:
+		*zdt = ZonedDateTimeOfGoTime(v)
+		return nil
+	default:
+		return sqlScannerDefaultBranch(v)
+	}
+}
+
+// Value implements driver.Valuer.
+// It returns nil for zero values, otherwise returns the date-time as an OffsetDateTime string,
+// since SQL databases do not store region-based zone IDs.
+func (zdt ZonedDateTime) Value() (driver.Value, error) {
+	if zdt.IsZero() {
+		return nil, nil
+	}
+	return zdt.ToOffsetDateTime().String(), nil
+}
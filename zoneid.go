@@ -6,23 +6,35 @@ import (
 	"encoding"
 	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
-var locLoadMap sync.Map
+var locLoadMap sync.Map // string -> *loadedLocation
+
+// loadedLocation is a location loaded by ID, with its zoneTable created on first use.
+type loadedLocation struct {
+	loc   *time.Location
+	table atomic.Pointer[zoneTable]
+}
 
 func loadLocation(id string) (l *time.Location, e error) {
 	v, ok := locLoadMap.Load(id)
 	if ok {
-		return v.(*time.Location), nil
+		return v.(*loadedLocation).loc, nil
 	}
-	l, e = time.LoadLocation(id)
-	if e != nil {
+	if l, e = loadProvidedLocation(id); e != nil {
 		return nil, e
 	}
-	locLoadMap.Store(id, l)
+	if l == nil {
+		if l, e = time.LoadLocation(id); e != nil {
+			return nil, e
+		}
+	}
+	locLoadMap.Store(id, &loadedLocation{loc: l})
 	return
 }
 
@@ -57,6 +69,129 @@ var builtinZoneShortIdMap = map[string]string{
 	"HST": "Pacific/Honolulu",
 }
 
+// zoneLinkMap maps the backward compatible names of the IANA time zone database to the zones they were renamed to,
+// such as "Asia/Calcutta" to "Asia/Kolkata". Links merging zones of different places aren't included.
+var zoneLinkMap = map[string]string{
+	"America/Argentina/ComodRivadavia": "America/Argentina/Catamarca",
+	"America/Atka":                     "America/Adak",
+	"America/Buenos_Aires":             "America/Argentina/Buenos_Aires",
+	"America/Catamarca":                "America/Argentina/Catamarca",
+	"America/Cordoba":                  "America/Argentina/Cordoba",
+	"America/Ensenada":                 "America/Tijuana",
+	"America/Fort_Wayne":               "America/Indiana/Indianapolis",
+	"America/Godthab":                  "America/Nuuk",
+	"America/Indianapolis":             "America/Indiana/Indianapolis",
+	"America/Jujuy":                    "America/Argentina/Jujuy",
+	"America/Knox_IN":                  "America/Indiana/Knox",
+	"America/Louisville":               "America/Kentucky/Louisville",
+	"America/Mendoza":                  "America/Argentina/Mendoza",
+	"America/Porto_Acre":               "America/Rio_Branco",
+	"America/Shiprock":                 "America/Denver",
+	"America/Virgin":                   "America/St_Thomas",
+	"Asia/Ashkhabad":                   "Asia/Ashgabat",
+	"Asia/Calcutta":                    "Asia/Kolkata",
+	"Asia/Chongqing":                   "Asia/Shanghai",
+	"Asia/Chungking":                   "Asia/Shanghai",
+	"Asia/Dacca":                       "Asia/Dhaka",
+	"Asia/Harbin":                      "Asia/Shanghai",
+	"Asia/Istanbul":                    "Europe/Istanbul",
+	"Asia/Katmandu":                    "Asia/Kathmandu",
+	"Asia/Macao":                       "Asia/Macau",
+	"Asia/Rangoon":                     "Asia/Yangon",
+	"Asia/Saigon":                      "Asia/Ho_Chi_Minh",
+	"Asia/Tel_Aviv":                    "Asia/Jerusalem",
+	"Asia/Thimbu":                      "Asia/Thimphu",
+	"Asia/Ujung_Pandang":               "Asia/Makassar",
+	"Asia/Ulan_Bator":                  "Asia/Ulaanbaatar",
+	"Atlantic/Faeroe":                  "Atlantic/Faroe",
+	"Australia/ACT":                    "Australia/Sydney",
+	"Australia/Canberra":               "Australia/Sydney",
+	"Australia/LHI":                    "Australia/Lord_Howe",
+	"Australia/NSW":                    "Australia/Sydney",
+	"Australia/North":                  "Australia/Darwin",
+	"Australia/Queensland":             "Australia/Brisbane",
+	"Australia/South":                  "Australia/Adelaide",
+	"Australia/Tasmania":               "Australia/Hobart",
+	"Australia/Victoria":               "Australia/Melbourne",
+	"Australia/West":                   "Australia/Perth",
+	"Australia/Yancowinna":             "Australia/Broken_Hill",
+	"Brazil/Acre":                      "America/Rio_Branco",
+	"Brazil/DeNoronha":                 "America/Noronha",
+	"Brazil/East":                      "America/Sao_Paulo",
+	"Brazil/West":                      "America/Manaus",
+	"Canada/Atlantic":                  "America/Halifax",
+	"Canada/Central":                   "America/Winnipeg",
+	"Canada/Eastern":                   "America/Toronto",
+	"Canada/Mountain":                  "America/Edmonton",
+	"Canada/Newfoundland":              "America/St_Johns",
+	"Canada/Pacific":                   "America/Vancouver",
+	"Canada/Saskatchewan":              "America/Regina",
+	"Canada/Yukon":                     "America/Whitehorse",
+	"Chile/Continental":                "America/Santiago",
+	"Chile/EasterIsland":               "Pacific/Easter",
+	"Cuba":                             "America/Havana",
+	"Egypt":                            "Africa/Cairo",
+	"Eire":                             "Europe/Dublin",
+	"Etc/GMT+0":                        "Etc/GMT",
+	"Etc/GMT-0":                        "Etc/GMT",
+	"Etc/GMT0":                         "Etc/GMT",
+	"Etc/Greenwich":                    "Etc/GMT",
+	"Etc/UCT":                          "Etc/UTC",
+	"Etc/Universal":                    "Etc/UTC",
+	"Etc/Zulu":                         "Etc/UTC",
+	"Europe/Belfast":                   "Europe/London",
+	"Europe/Kiev":                      "Europe/Kyiv",
+	"Europe/Nicosia":                   "Asia/Nicosia",
+	"Europe/Tiraspol":                  "Europe/Chisinau",
+	"GB":                               "Europe/London",
+	"GB-Eire":                          "Europe/London",
+	"GMT+0":                            "Etc/GMT",
+	"GMT-0":                            "Etc/GMT",
+	"GMT0":                             "Etc/GMT",
+	"Greenwich":                        "Etc/GMT",
+	"Hongkong":                         "Asia/Hong_Kong",
+	"Iran":                             "Asia/Tehran",
+	"Israel":                           "Asia/Jerusalem",
+	"Jamaica":                          "America/Jamaica",
+	"Japan":                            "Asia/Tokyo",
+	"Kwajalein":                        "Pacific/Kwajalein",
+	"Libya":                            "Africa/Tripoli",
+	"Mexico/BajaNorte":                 "America/Tijuana",
+	"Mexico/BajaSur":                   "America/Mazatlan",
+	"Mexico/General":                   "America/Mexico_City",
+	"NZ":                               "Pacific/Auckland",
+	"NZ-CHAT":                          "Pacific/Chatham",
+	"Navajo":                           "America/Denver",
+	"PRC":                              "Asia/Shanghai",
+	"Pacific/Enderbury":                "Pacific/Kanton",
+	"Pacific/Ponape":                   "Pacific/Pohnpei",
+	"Pacific/Samoa":                    "Pacific/Pago_Pago",
+	"Pacific/Truk":                     "Pacific/Chuuk",
+	"Pacific/Yap":                      "Pacific/Chuuk",
+	"Poland":                           "Europe/Warsaw",
+	"Portugal":                         "Europe/Lisbon",
+	"ROC":                              "Asia/Taipei",
+	"ROK":                              "Asia/Seoul",
+	"Singapore":                        "Asia/Singapore",
+	"Turkey":                           "Europe/Istanbul",
+	"UCT":                              "Etc/UTC",
+	"US/Alaska":                        "America/Anchorage",
+	"US/Aleutian":                      "America/Adak",
+	"US/Arizona":                       "America/Phoenix",
+	"US/Central":                       "America/Chicago",
+	"US/East-Indiana":                  "America/Indiana/Indianapolis",
+	"US/Eastern":                       "America/New_York",
+	"US/Hawaii":                        "Pacific/Honolulu",
+	"US/Indiana-Starke":                "America/Indiana/Knox",
+	"US/Michigan":                      "America/Detroit",
+	"US/Mountain":                      "America/Denver",
+	"US/Pacific":                       "America/Los_Angeles",
+	"US/Samoa":                         "Pacific/Pago_Pago",
+	"Universal":                        "Etc/UTC",
+	"W-SU":                             "Europe/Moscow",
+	"Zulu":                             "Etc/UTC",
+}
+
 // ZoneId represents a time zone identifier such as "America/New_York" or "Asia/Tokyo".
 // It wraps a time.Location and provides serialization support for JSON, text, and SQL.
 //
@@ -83,6 +218,26 @@ const (
 )
 
 func (z ZoneId) getOffsets(ldt LocalDateTime) (previous, after ZoneOffset, mode int) {
+	if z.IsZero() || ldt.IsZero() {
+		return
+	}
+	if z.loc == nil {
+		return z.zo, z.zo, zoneOffsetModeNormal
+	}
+	var table = zoneTableOf(z.loc)
+	if table.fallback {
+		return z.getOffsetsByGoTime(ldt)
+	}
+	// Clamp the days to avoid overflow, the offsets beyond are those of the bounds
+	var days = max(min(ldt.date.UnixEpochDays(), zoneTableBounds/86400), -zoneTableBounds/86400)
+	var local = days*86400 + int64(ldt.time.SecondOfDay())
+	p, a, mode := table.offsetsAt(z.loc, local)
+	return ZoneOffset{totalSeconds: p}, ZoneOffset{totalSeconds: a}, mode
+}
+
+// getOffsetsByGoTime is getOffsets by probing the time package around the local date-time,
+// it's used if the transitions of the zone can't be searched.
+func (z ZoneId) getOffsetsByGoTime(ldt LocalDateTime) (previous, after ZoneOffset, mode int) {
 	if z.IsZero() {
 		return
 	}
@@ -111,7 +266,10 @@ func (z ZoneId) getOffsets(ldt LocalDateTime) (previous, after ZoneOffset, mode
 	}
 	tbBoundsBegin, tbBoundsEnd := tb.ZoneBounds()
 	taBoundsBegin, taBoundsEnd := ta.ZoneBounds()
-	mode = classifyZoneBoundsMode(tbBoundsBegin, tbBoundsEnd, taBoundsBegin, taBoundsEnd, t)
+	// The local date-time interpreted with the offset before and after the transition
+	atTb := localDateTimeToGoTime(ldt, time.FixedZone("", tbOffset))
+	atTa := localDateTimeToGoTime(ldt, time.FixedZone("", taOffset))
+	mode = classifyZoneBoundsMode(tbBoundsBegin, tbBoundsEnd, taBoundsBegin, taBoundsEnd, atTb, atTa)
 	switch mode {
 	case zoneOffsetModeNormal:
 		previous = MustZoneOffsetOfSeconds(tOffset)
@@ -123,20 +281,44 @@ func (z ZoneId) getOffsets(ldt LocalDateTime) (previous, after ZoneOffset, mode
 	return
 }
 
+// GetOffset returns the offset applicable to the specified local date-time in this zone.
+//
+// There are three cases, mirroring Java's ZoneRules#getOffset(LocalDateTime):
+//   - Normal: the single valid offset is returned.
+//   - Gap: the local date-time does not exist, the offset before the transition is returned.
+//   - Overlap: the local date-time exists twice, the offset before the transition (the earlier offset) is returned.
 func (z ZoneId) GetOffset(localDateTime LocalDateTime) (o ZoneOffset) {
-	prev, next, mode := z.getOffsets(localDateTime)
-	switch mode {
-	case zoneOffsetModeNormal:
-		return prev
-	case zoneOffsetModeOverlap:
-		return prev
-	case zoneOffsetModeGap:
-		return next
+	prev, _, _ := z.getOffsets(localDateTime)
+	return prev
+}
+
+// offsetOfEpochSecond returns the offset in effect at the specified instant.
+func (z ZoneId) offsetOfEpochSecond(epochSecond int64) ZoneOffset {
+	if z.loc == nil {
+		return z.zo
+	}
+	var table = zoneTableOf(z.loc)
+	if table.fallback {
+		return z.offsetOfEpochSecondByGoTime(epochSecond)
 	}
-	panic("unreachable")
+	return ZoneOffset{totalSeconds: table.offsetAt(z.loc, epochSecond)}
+}
+
+// offsetOfEpochSecondByGoTime is offsetOfEpochSecond by the time package.
+func (z ZoneId) offsetOfEpochSecondByGoTime(epochSecond int64) ZoneOffset {
+	if z.loc == nil {
+		return z.zo
+	}
+	// Clamp to a range representable by time.Time
+	epochSecond = max(min(epochSecond, math.MaxInt64/2), math.MinInt64/2)
+	_, offset := time.Unix(epochSecond, 0).In(z.loc).Zone()
+	return MustZoneOffsetOfSeconds(offset)
 }
 
 // ZoneIdOf creates a ZoneId from a time zone identifier string.
+//
+// The backward compatible names of renamed zones are canonicalized, so "Asia/Calcutta" returns "Asia/Kolkata",
+// and the returned ZoneId values compare equal.
 func ZoneIdOf(id string) (r ZoneId, e error) {
 	defer func() { r.valid = e == nil }()
 	if id == "" {
@@ -160,6 +342,12 @@ func ZoneIdOf(id string) (r ZoneId, e error) {
 			}
 		}
 	}
+	if canonical := zoneLinkMap[id]; canonical != "" {
+		// Fall back to the link itself if the zone database predates the rename
+		if r, e = ZoneIdOf(canonical); e == nil {
+			return
+		}
+	}
 	r.loc, e = loadLocation(id)
 	if e != nil {
 		key := builtinZoneShortIdMap[id]
@@ -188,6 +376,11 @@ func ZoneIdOfGoLocation(l *time.Location) ZoneId {
 	return ZoneId{loc: l, valid: true}
 }
 
+// ZoneIdOfOffset creates a ZoneId with a fixed offset, the offset applies at all instants.
+func ZoneIdOfOffset(offset ZoneOffset) ZoneId {
+	return ZoneId{zo: offset, valid: true}
+}
+
 // ZoneIdDefault returns the system's default time zone.
 // This corresponds to time.Local in Go's standard library.
 // If time.Local is nil, returns ZoneIdUTC.
@@ -628,3 +628,39 @@ func TestZoneId_GetOffset_LosAngeles1(t *testing.T) {
 		assert.Equal(t, off, zoneId.GetOffset(ldt))
 	}
 }
+
+func TestZoneId_getOffsets(t *testing.T) {
+	var zoneId = MustZoneIdOf("America/Los_Angeles")
+	var pst, pdt = MustZoneOffsetOfHours(-8), MustZoneOffsetOfHours(-7)
+	var data = []struct {
+		ldt        string
+		prev, next ZoneOffset
+		mode       int
+	}{
+		{"2025-03-09T01:59", pst, pst, zoneOffsetModeNormal},
+		{"2025-03-09T02:00", pst, pdt, zoneOffsetModeGap},
+		{"2025-03-09T02:30", pst, pdt, zoneOffsetModeGap},
+		{"2025-03-09T03:00", pdt, pdt, zoneOffsetModeNormal},
+		{"2025-11-02T00:59", pdt, pdt, zoneOffsetModeNormal},
+		{"2025-11-02T01:00", pdt, pst, zoneOffsetModeOverlap},
+		{"2025-11-02T01:30", pdt, pst, zoneOffsetModeOverlap},
+		{"2025-11-02T02:00", pst, pst, zoneOffsetModeNormal},
+	}
+	for _, it := range data {
+		prev, next, mode := zoneId.getOffsets(MustLocalDateTimeParse(it.ldt))
+		assert.Equal(t, it.prev, prev, it.ldt)
+		assert.Equal(t, it.next, next, it.ldt)
+		assert.Equal(t, it.mode, mode, it.ldt)
+	}
+}
+
+func TestZoneId_GetOffset_GapAndOverlap(t *testing.T) {
+	var zoneId = MustZoneIdOf("America/Los_Angeles")
+	var pst, pdt = MustZoneOffsetOfHours(-8), MustZoneOffsetOfHours(-7)
+	// the offset before the transition in both a gap and an overlap, same as Java's ZoneRules#getOffset
+	assert.Equal(t, pst, zoneId.GetOffset(MustLocalDateTimeParse("2025-03-09T02:00")))
+	assert.Equal(t, pst, zoneId.GetOffset(MustLocalDateTimeParse("2025-03-09T02:30")))
+	assert.Equal(t, pdt, zoneId.GetOffset(MustLocalDateTimeParse("2025-03-09T03:00")))
+	assert.Equal(t, pdt, zoneId.GetOffset(MustLocalDateTimeParse("2025-11-02T01:30")))
+	assert.Equal(t, pst, zoneId.GetOffset(MustLocalDateTimeParse("2025-11-02T02:00")))
+}
@@ -0,0 +1,307 @@
+package goda
+
+import (
+	"math"
+	"time"
+)
+
+// ZoneRules describes how the offset of a zone changes over time, such as the daylight saving time transitions.
+// It's obtained from ZoneId.Rules.
+//
+// The rules are read from the time.Location of the zone, so the transitions are those of Go's time zone database,
+// including the transitions predicted by its recurring rule for the future.
+// The rules of a zone with a fixed offset have no transitions.
+// The zero value has no rules, its methods return zero values.
+//
+// This is similar to Java's ZoneRules.
+type ZoneRules struct {
+	zone ZoneId
+}
+
+// Rules returns the rules of this zone. Returns zero value for zero value.
+func (z ZoneId) Rules() ZoneRules {
+	return ZoneRules{zone: z}
+}
+
+// IsZero returns true if this is the zero value of ZoneRules.
+func (r ZoneRules) IsZero() bool {
+	return r.zone.IsZero()
+}
+
+// IsFixedOffset returns true if the offset never changes.
+func (r ZoneRules) IsFixedOffset() bool {
+	if r.zone.IsZero() {
+		return false
+	}
+	if r.zone.loc == nil {
+		return true
+	}
+	start, end := time.Unix(0, 0).In(r.zone.loc).ZoneBounds()
+	return start.IsZero() && end.IsZero()
+}
+
+// GetOffset returns the offset applicable to the local date-time, see ZoneId.GetOffset.
+func (r ZoneRules) GetOffset(localDateTime LocalDateTime) ZoneOffset {
+	return r.zone.GetOffset(localDateTime)
+}
+
+// OffsetOfInstant returns the offset in effect at the instant.
+// Returns zero value if either the rules or the instant is zero.
+func (r ZoneRules) OffsetOfInstant(instant Instant) ZoneOffset {
+	if r.zone.IsZero() || instant.IsZero() {
+		return ZoneOffset{}
+	}
+	return r.zone.offsetOfEpochSecond(instant.EpochSecond())
+}
+
+// ValidOffsets returns the valid offsets for the local date-time.
+//
+// There are three cases, mirroring Java's ZoneRules#getValidOffsets:
+//   - Normal: one offset is valid.
+//   - Gap: no offset is valid, as the local date-time is skipped, an empty slice is returned.
+//   - Overlap: two offsets are valid, the earlier offset comes first.
+//
+// Returns nil if either the rules or the local date-time is zero.
+func (r ZoneRules) ValidOffsets(localDateTime LocalDateTime) []ZoneOffset {
+	if r.zone.IsZero() || localDateTime.IsZero() {
+		return nil
+	}
+	prev, next, mode := r.zone.getOffsets(localDateTime)
+	switch mode {
+	case zoneOffsetModeGap:
+		return []ZoneOffset{}
+	case zoneOffsetModeOverlap:
+		return []ZoneOffset{prev, next}
+	}
+	return []ZoneOffset{prev}
+}
+
+// Transition returns the transition at the local date-time if it's in a gap or an overlap.
+// Returns false if the local date-time has a single valid offset.
+func (r ZoneRules) Transition(localDateTime LocalDateTime) (ZoneOffsetTransition, bool) {
+	if r.zone.IsZero() || localDateTime.IsZero() || r.zone.loc == nil {
+		return ZoneOffsetTransition{}, false
+	}
+	prev, next, mode := r.zone.getOffsets(localDateTime)
+	if mode == zoneOffsetModeNormal {
+		return ZoneOffsetTransition{}, false
+	}
+	// The transition is the first one after the day before, as probed by getOffsets
+	var ldt = maxOf(minOf(localDateTime, localDateTimeMaxEpochSecondOneDay), localDateTimeMinEpochSecondOneDay)
+	tr, ok := r.nextTransition(localDateTimeToGoTime(ldt.Chain().MinusDays(1).MustGet(), r.zone.loc))
+	if !ok {
+		return ZoneOffsetTransition{}, false
+	}
+	tr.before, tr.after, tr.mode = prev, next, mode
+	return tr, true
+}
+
+// IsDaylightSavings returns true if daylight saving time is in effect at the instant.
+func (r ZoneRules) IsDaylightSavings(instant Instant) bool {
+	if r.zone.IsZero() || instant.IsZero() || r.zone.loc == nil {
+		return false
+	}
+	return r.goTime(instant.EpochSecond(), instant.Nano()).IsDST()
+}
+
+// StandardOffset returns the standard offset at the instant, which is the offset without daylight saving time.
+// It's the offset of the nearest period without daylight saving time, searching backwards first.
+// Returns zero value if either the rules or the instant is zero.
+func (r ZoneRules) StandardOffset(instant Instant) ZoneOffset {
+	if r.zone.IsZero() || instant.IsZero() {
+		return ZoneOffset{}
+	}
+	if r.zone.loc == nil {
+		return r.zone.zo
+	}
+	var t = r.goTime(instant.EpochSecond(), instant.Nano())
+	for s := t; ; {
+		if !s.IsDST() {
+			return goTimeOffset(s)
+		}
+		start, _ := s.ZoneBounds()
+		if start.IsZero() {
+			break
+		}
+		s = start.Add(-1)
+	}
+	for s := t; ; {
+		if !s.IsDST() {
+			return goTimeOffset(s)
+		}
+		_, end := s.ZoneBounds()
+		if end.IsZero() {
+			break
+		}
+		s = end
+	}
+	return goTimeOffset(t)
+}
+
+// DaylightSavings returns the amount of daylight saving time at the instant,
+// which is the difference between the actual offset and the standard offset, usually zero or one hour.
+func (r ZoneRules) DaylightSavings(instant Instant) Duration {
+	var seconds = r.OffsetOfInstant(instant).TotalSeconds() - r.StandardOffset(instant).TotalSeconds()
+	return mustValue(DurationOfSeconds(int64(seconds), 0))
+}
+
+// NextTransition returns the first transition after the instant, the offset changes in every transition.
+// Returns false if there is no later transition.
+func (r ZoneRules) NextTransition(instant Instant) (ZoneOffsetTransition, bool) {
+	if r.zone.IsZero() || instant.IsZero() || r.zone.loc == nil {
+		return ZoneOffsetTransition{}, false
+	}
+	return r.nextTransition(r.goTime(instant.EpochSecond(), instant.Nano()))
+}
+
+// PreviousTransition returns the last transition before the instant, the offset changes in every transition.
+// Returns false if there is no earlier transition.
+func (r ZoneRules) PreviousTransition(instant Instant) (ZoneOffsetTransition, bool) {
+	if r.zone.IsZero() || instant.IsZero() || r.zone.loc == nil {
+		return ZoneOffsetTransition{}, false
+	}
+	var t = r.goTime(instant.EpochSecond(), instant.Nano()).Add(-1)
+	for {
+		start, _ := t.ZoneBounds()
+		if start.IsZero() {
+			return ZoneOffsetTransition{}, false
+		}
+		var before = start.Add(-1)
+		if goTimeOffset(before) != goTimeOffset(start) {
+			return newZoneOffsetTransition(start, goTimeOffset(before), goTimeOffset(start)), true
+		}
+		t = before
+	}
+}
+
+// Transitions returns the transitions from the start (inclusive) to the end (exclusive) in order.
+// Returns nil if there is no transition in the range.
+func (r ZoneRules) Transitions(startInclusive, endExclusive Instant) []ZoneOffsetTransition {
+	if r.zone.IsZero() || startInclusive.IsZero() || endExclusive.IsZero() || r.zone.loc == nil {
+		return nil
+	}
+	var list []ZoneOffsetTransition
+	var t = r.goTime(startInclusive.EpochSecond(), startInclusive.Nano()).Add(-1)
+	for {
+		tr, ok := r.nextTransition(t)
+		if !ok || !tr.instant.IsBefore(endExclusive) {
+			return list
+		}
+		list = append(list, tr)
+		t = r.goTime(tr.instant.EpochSecond(), tr.instant.Nano())
+	}
+}
+
+// nextTransition returns the first transition after t where the offset changes,
+// the periods returned by time.Time.ZoneBounds may differ only in the abbreviation or the DST flag.
+func (r ZoneRules) nextTransition(t time.Time) (ZoneOffsetTransition, bool) {
+	var offset = goTimeOffset(t)
+	for {
+		var end = zoneBoundsEnd(t)
+		if end.IsZero() {
+			return ZoneOffsetTransition{}, false
+		}
+		if next := goTimeOffset(end); next != offset {
+			return newZoneOffsetTransition(end, offset, next), true
+		}
+		t = end
+	}
+}
+
+func (r ZoneRules) goTime(epochSecond int64, nano int) time.Time {
+	// Clamp to a range representable by time.Time
+	epochSecond = max(min(epochSecond, math.MaxInt64/2), math.MinInt64/2)
+	return time.Unix(epochSecond, int64(nano)).In(r.zone.loc)
+}
+
+func goTimeOffset(t time.Time) ZoneOffset {
+	_, offset := t.Zone()
+	return MustZoneOffsetOfSeconds(offset)
+}
+
+// ZoneOffsetTransition is a change of the offset of a zone, such as the start of daylight saving time.
+// It's either a gap, where the local date-times between the offsets are skipped,
+// or an overlap, where the local date-times between the offsets occur twice.
+//
+// ZoneOffsetTransition is comparable. The zero value represents no transition.
+//
+// This is similar to Java's ZoneOffsetTransition.
+type ZoneOffsetTransition struct {
+	instant Instant
+	before  ZoneOffset
+	after   ZoneOffset
+	mode    int
+}
+
+func newZoneOffsetTransition(t time.Time, before, after ZoneOffset) ZoneOffsetTransition {
+	var mode = zoneOffsetModeOverlap
+	if after.TotalSeconds() > before.TotalSeconds() {
+		mode = zoneOffsetModeGap
+	}
+	return ZoneOffsetTransition{instant: InstantOfGoTime(t), before: before, after: after, mode: mode}
+}
+
+// IsZero returns true if this is the zero value of ZoneOffsetTransition.
+func (t ZoneOffsetTransition) IsZero() bool {
+	return t.mode == zoneOffsetModeNormal
+}
+
+// Instant returns the instant of the transition, the after offset applies from it.
+func (t ZoneOffsetTransition) Instant() Instant {
+	return t.instant
+}
+
+// OffsetBefore returns the offset before the transition.
+func (t ZoneOffsetTransition) OffsetBefore() ZoneOffset {
+	return t.before
+}
+
+// OffsetAfter returns the offset after the transition.
+func (t ZoneOffsetTransition) OffsetAfter() ZoneOffset {
+	return t.after
+}
+
+// DateTimeBefore returns the local date-time at which the transition happens, using the offset before.
+// For a gap it's the first skipped local date-time, such as 02:00 when the clocks go from 02:00 to 03:00.
+func (t ZoneOffsetTransition) DateTimeBefore() LocalDateTime {
+	return LocalDateTimeOfInstant(t.instant, t.before)
+}
+
+// DateTimeAfter returns the local date-time at which the transition happens, using the offset after.
+func (t ZoneOffsetTransition) DateTimeAfter() LocalDateTime {
+	return LocalDateTimeOfInstant(t.instant, t.after)
+}
+
+// Duration returns the length of the transition, positive for a gap and negative for an overlap.
+func (t ZoneOffsetTransition) Duration() Duration {
+	return mustValue(DurationOfSeconds(int64(t.after.TotalSeconds()-t.before.TotalSeconds()), 0))
+}
+
+// IsGap returns true if the local date-times between the offsets are skipped, such as the start of summer time.
+func (t ZoneOffsetTransition) IsGap() bool {
+	return t.mode == zoneOffsetModeGap
+}
+
+// IsOverlap returns true if the local date-times between the offsets occur twice, such as the end of summer time.
+func (t ZoneOffsetTransition) IsOverlap() bool {
+	return t.mode == zoneOffsetModeOverlap
+}
+
+// IsValidOffset returns true if the offset is valid during the transition,
+// which is never for a gap, and either offset for an overlap.
+func (t ZoneOffsetTransition) IsValidOffset(offset ZoneOffset) bool {
+	return t.IsOverlap() && (offset == t.before || offset == t.after)
+}
+
+// String returns the transition in Java's format, such as "Transition[Gap at 2024-03-31T02:00:00+01:00 to +02:00]".
+// Returns an empty string for zero value.
+func (t ZoneOffsetTransition) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	var kind = "Overlap"
+	if t.IsGap() {
+		kind = "Gap"
+	}
+	return "Transition[" + kind + " at " + t.DateTimeBefore().String() + t.before.String() + " to " + t.after.String() + "]"
+}
@@ -0,0 +1,133 @@
+package goda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZoneRules_Transitions(t *testing.T) {
+	var rules = MustZoneIdOf("Europe/Paris").Rules()
+	var cet, cest = MustZoneOffsetOfHours(1), MustZoneOffsetOfHours(2)
+	assert.False(t, rules.IsZero())
+	assert.False(t, rules.IsFixedOffset())
+
+	list := rules.Transitions(MustInstantParse("2024-01-01T00:00:00Z"), MustInstantParse("2025-01-01T00:00:00Z"))
+	require.Len(t, list, 2)
+
+	var gap = list[0]
+	assert.Equal(t, "2024-03-31T01:00:00Z", gap.Instant().String())
+	assert.Equal(t, cet, gap.OffsetBefore())
+	assert.Equal(t, cest, gap.OffsetAfter())
+	assert.True(t, gap.IsGap())
+	assert.False(t, gap.IsOverlap())
+	assert.False(t, gap.IsValidOffset(cet))
+	assert.Equal(t, "2024-03-31T02:00:00", gap.DateTimeBefore().String())
+	assert.Equal(t, "2024-03-31T03:00:00", gap.DateTimeAfter().String())
+	assert.Equal(t, "PT1H", gap.Duration().String())
+	assert.Equal(t, "Transition[Gap at 2024-03-31T02:00:00+01:00 to +02:00]", gap.String())
+
+	var overlap = list[1]
+	assert.Equal(t, "2024-10-27T01:00:00Z", overlap.Instant().String())
+	assert.True(t, overlap.IsOverlap())
+	assert.True(t, overlap.IsValidOffset(cet))
+	assert.True(t, overlap.IsValidOffset(cest))
+	assert.False(t, overlap.IsValidOffset(ZoneOffsetUTC()))
+	assert.Equal(t, "PT-1H", overlap.Duration().String())
+	assert.Equal(t, "Transition[Overlap at 2024-10-27T03:00:00+02:00 to +01:00]", overlap.String())
+
+	// the start is inclusive, the end is exclusive
+	assert.Equal(t, list[:1], rules.Transitions(gap.Instant(), overlap.Instant()))
+	assert.Empty(t, rules.Transitions(MustInstantParse("2024-04-01T00:00:00Z"), MustInstantParse("2024-10-01T00:00:00Z")))
+
+	next, ok := rules.NextTransition(MustInstantParse("2024-01-01T00:00:00Z"))
+	require.True(t, ok)
+	assert.Equal(t, gap, next)
+	next, ok = rules.NextTransition(gap.Instant())
+	require.True(t, ok)
+	assert.Equal(t, overlap, next)
+	prev, ok := rules.PreviousTransition(overlap.Instant())
+	require.True(t, ok)
+	assert.Equal(t, gap, prev)
+	prev, ok = rules.PreviousTransition(overlap.Instant().Chain().PlusNanos(1).MustGet())
+	require.True(t, ok)
+	assert.Equal(t, overlap, prev)
+}
+
+func TestZoneRules_ValidOffsets(t *testing.T) {
+	var rules = MustZoneIdOf("America/Los_Angeles").Rules()
+	var pst, pdt = MustZoneOffsetOfHours(-8), MustZoneOffsetOfHours(-7)
+	for _, it := range []struct {
+		ldt      string
+		expected []ZoneOffset
+	}{
+		{"2025-03-09T01:59", []ZoneOffset{pst}},
+		{"2025-03-09T02:30", []ZoneOffset{}},
+		{"2025-03-09T03:00", []ZoneOffset{pdt}},
+		{"2025-11-02T01:30", []ZoneOffset{pdt, pst}},
+		{"2025-11-02T02:00", []ZoneOffset{pst}},
+	} {
+		var ldt = MustLocalDateTimeParse(it.ldt)
+		assert.Equal(t, it.expected, rules.ValidOffsets(ldt), it.ldt)
+		tr, ok := rules.Transition(ldt)
+		assert.Equal(t, len(it.expected) != 1, ok, it.ldt)
+		if ok {
+			assert.Equal(t, len(it.expected) == 0, tr.IsGap(), it.ldt)
+			if tr.IsGap() {
+				assert.Equal(t, []ZoneOffset{pst, pdt}, []ZoneOffset{tr.OffsetBefore(), tr.OffsetAfter()}, it.ldt)
+			} else {
+				assert.Equal(t, it.expected, []ZoneOffset{tr.OffsetBefore(), tr.OffsetAfter()}, it.ldt)
+			}
+		}
+	}
+
+	tr, ok := rules.Transition(MustLocalDateTimeParse("2025-03-09T02:30"))
+	require.True(t, ok)
+	assert.Equal(t, "2025-03-09T10:00:00Z", tr.Instant().String())
+	assert.Equal(t, "Transition[Gap at 2025-03-09T02:00:00-08:00 to -07:00]", tr.String())
+}
+
+func TestZoneRules_DaylightSavings(t *testing.T) {
+	var rules = MustZoneIdOf("Europe/London").Rules()
+	var summer = MustInstantParse("2024-07-01T00:00:00Z")
+	var winter = MustInstantParse("2024-01-01T00:00:00Z")
+	assert.True(t, rules.IsDaylightSavings(summer))
+	assert.False(t, rules.IsDaylightSavings(winter))
+	assert.Equal(t, MustZoneOffsetOfHours(1), rules.OffsetOfInstant(summer))
+	assert.Equal(t, ZoneOffsetUTC(), rules.StandardOffset(summer))
+	assert.Equal(t, ZoneOffsetUTC(), rules.StandardOffset(winter))
+	assert.Equal(t, "PT1H", rules.DaylightSavings(summer).String())
+	assert.Equal(t, "PT0S", rules.DaylightSavings(winter).String())
+	assert.Equal(t, MustZoneOffsetOfHours(1), rules.GetOffset(MustLocalDateTimeParse("2024-07-01T00:00")))
+}
+
+func TestZoneRules_FixedAndZero(t *testing.T) {
+	var instant = MustInstantParse("2024-07-01T00:00:00Z")
+	for _, rules := range []ZoneRules{
+		ZoneIdOfOffset(MustZoneOffsetOfHours(8)).Rules(),
+		ZoneIdOfGoLocation(time.FixedZone("X", 8*3600)).Rules(),
+	} {
+		assert.True(t, rules.IsFixedOffset())
+		assert.Equal(t, MustZoneOffsetOfHours(8), rules.OffsetOfInstant(instant))
+		assert.Equal(t, MustZoneOffsetOfHours(8), rules.StandardOffset(instant))
+		assert.False(t, rules.IsDaylightSavings(instant))
+		assert.Equal(t, []ZoneOffset{MustZoneOffsetOfHours(8)}, rules.ValidOffsets(MustLocalDateTimeParse("2024-07-01T00:00")))
+		_, ok := rules.NextTransition(instant)
+		assert.False(t, ok)
+		_, ok = rules.PreviousTransition(instant)
+		assert.False(t, ok)
+		assert.Nil(t, rules.Transitions(InstantMin(), InstantMax()))
+	}
+
+	var zero ZoneRules
+	assert.True(t, zero.IsZero())
+	assert.False(t, zero.IsFixedOffset())
+	assert.True(t, zero.OffsetOfInstant(instant).IsZero())
+	assert.Nil(t, zero.ValidOffsets(MustLocalDateTimeParse("2024-07-01T00:00")))
+	_, ok := zero.NextTransition(instant)
+	assert.False(t, ok)
+	assert.True(t, ZoneOffsetTransition{}.IsZero())
+	assert.Equal(t, "", ZoneOffsetTransition{}.String())
+}
@@ -0,0 +1,246 @@
+package goda
+
+import (
+	"archive/zip"
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ZoneRulesProvider provides the TZif data (RFC 8536) of time zones, such as the files of a zoneinfo directory.
+//
+// Providers are registered by RegisterZoneRulesProvider. ZoneIdOf consults them in registration order
+// before falling back to time.LoadLocation, which reads the zone database of the host.
+// Registering a provider with an embedded zoneinfo tree pins the rules regardless of the host.
+//
+// A provider may also implement Version() string to report the version of its tzdata, such as "2025b".
+//
+// This is similar to Java's ZoneRulesProvider.
+type ZoneRulesProvider interface {
+	// ZoneIds returns the IDs of the provided zones, such as "Europe/Paris".
+	ZoneIds() ([]string, error)
+	// TZif returns the TZif data of the zone.
+	// Returns an error wrapping fs.ErrNotExist if the zone isn't provided.
+	TZif(zoneId string) ([]byte, error)
+}
+
+var zoneRulesProviders struct {
+	sync.RWMutex
+	list []ZoneRulesProvider
+}
+
+// RegisterZoneRulesProvider registers the provider after the providers already registered.
+// Zones already loaded are loaded again through the providers when requested next time,
+// ZoneId values created before keep their rules.
+func RegisterZoneRulesProvider(provider ZoneRulesProvider) {
+	if provider == nil {
+		panic("goda: zone rules provider is nil")
+	}
+	zoneRulesProviders.Lock()
+	defer zoneRulesProviders.Unlock()
+	zoneRulesProviders.list = append(zoneRulesProviders.list, provider)
+	locLoadMap.Clear()
+}
+
+// loadProvidedLocation loads the zone from the registered providers.
+// Returns nil and no error if no provider has the zone.
+func loadProvidedLocation(id string) (*time.Location, error) {
+	zoneRulesProviders.RLock()
+	var providers = zoneRulesProviders.list
+	zoneRulesProviders.RUnlock()
+	for _, p := range providers {
+		data, e := p.TZif(id)
+		if errors.Is(e, fs.ErrNotExist) {
+			continue
+		}
+		if e != nil {
+			return nil, e
+		}
+		if e = checkTZif(data); e != nil {
+			return nil, e
+		}
+		return time.LoadLocationFromTZData(id, data)
+	}
+	return nil, nil
+}
+
+// AvailableZoneIds returns the zone IDs accepted by ZoneIdOf in ascending order.
+// It merges the zones of the registered providers, the zones of the host's zone database read by time.LoadLocation,
+// and the short IDs such as "PST" whose zone is available.
+// The zones of the time/tzdata package embedded in the binary can't be listed, register a provider instead.
+func AvailableZoneIds() ([]string, error) {
+	var ids []string
+	for _, p := range availableZoneRulesProviders() {
+		list, e := p.ZoneIds()
+		if e != nil {
+			return nil, e
+		}
+		ids = append(ids, list...)
+	}
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	for short, id := range builtinZoneShortIdMap {
+		if _, found := slices.BinarySearch(ids, id); found {
+			ids = append(ids, short)
+		}
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
+
+// ZoneRulesVersion returns the version of the tzdata in use, such as "2025b".
+// It's the version of the first registered provider reporting one, or else of the host's zone database.
+// Returns an empty string if unknown, such as for the zoneinfo.zip of Go.
+func ZoneRulesVersion() string {
+	for _, p := range availableZoneRulesProviders() {
+		if v, ok := p.(interface{ Version() string }); ok {
+			if version := v.Version(); version != "" {
+				return version
+			}
+		}
+	}
+	return ""
+}
+
+// availableZoneRulesProviders returns the registered providers followed by the host's zone database if found.
+func availableZoneRulesProviders() []ZoneRulesProvider {
+	zoneRulesProviders.RLock()
+	var providers = slices.Clone(zoneRulesProviders.list)
+	zoneRulesProviders.RUnlock()
+	if p := hostZoneRulesProvider(); p != nil {
+		providers = append(providers, p)
+	}
+	return providers
+}
+
+// hostZoneRulesProvider returns the zone database time.LoadLocation reads first, or nil if none is found.
+// The sources are $ZONEINFO, a directory or a zip file, then the system directories, the same as the time package.
+// The zoneinfo.zip under GOROOT is not looked up, as GOROOT is unknown to binaries built with -trimpath.
+var hostZoneRulesProvider = sync.OnceValue(func() ZoneRulesProvider {
+	var sources = []string{"/usr/share/zoneinfo/", "/usr/share/lib/zoneinfo/", "/usr/lib/locale/TZ/", "/etc/zoneinfo/"}
+	if runtime.GOOS == "windows" {
+		sources = nil
+	}
+	if env := os.Getenv("ZONEINFO"); env != "" {
+		sources = append([]string{env}, sources...)
+	}
+	for _, source := range sources {
+		info, e := os.Stat(source)
+		if e != nil {
+			continue
+		}
+		if info.IsDir() {
+			return ZoneRulesProviderOfDir(source)
+		}
+		if r, e := zip.OpenReader(source); e == nil {
+			return ZoneRulesProviderOfFS(r)
+		}
+	}
+	return nil
+})
+
+// ZoneRulesProviderOfFS returns a provider reading the TZif files of a zoneinfo tree, such as "Europe/Paris".
+// The file system may be an embed.FS, an os.DirFS or a zip.Reader of Go's zoneinfo.zip.
+// ZoneIds lists the files starting with the TZif magic, except the "posix" and "right" copies.
+// The version is read from the "tzdata.zi" or "+VERSION" file if any.
+func ZoneRulesProviderOfFS(fsys fs.FS) ZoneRulesProvider {
+	return fsZoneRulesProvider{fsys: fsys}
+}
+
+// ZoneRulesProviderOfDir returns a provider reading the TZif files of a zoneinfo directory,
+// such as "/usr/share/zoneinfo", see ZoneRulesProviderOfFS.
+func ZoneRulesProviderOfDir(dir string) ZoneRulesProvider {
+	return fsZoneRulesProvider{fsys: os.DirFS(dir)}
+}
+
+// ZoneRulesProviderOfBytes returns a provider of the TZif data by zone ID.
+// The map is copied, but the data isn't.
+func ZoneRulesProviderOfBytes(zones map[string][]byte) ZoneRulesProvider {
+	var m = make(bytesZoneRulesProvider, len(zones))
+	for id, data := range zones {
+		m[id] = data
+	}
+	return m
+}
+
+type fsZoneRulesProvider struct {
+	fsys fs.FS
+}
+
+func (p fsZoneRulesProvider) ZoneIds() (ids []string, e error) {
+	e = fs.WalkDir(p.fsys, ".", func(path string, d fs.DirEntry, e error) error {
+		if e != nil {
+			return e
+		}
+		if d.IsDir() {
+			if path == "posix" || path == "right" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if isTZifFile(p.fsys, path) {
+			ids = append(ids, path)
+		}
+		return nil
+	})
+	slices.Sort(ids)
+	return
+}
+
+func (p fsZoneRulesProvider) TZif(zoneId string) ([]byte, error) {
+	if !fs.ValidPath(zoneId) || zoneId == "." {
+		return nil, &fs.PathError{Op: "open", Path: zoneId, Err: fs.ErrNotExist}
+	}
+	return fs.ReadFile(p.fsys, zoneId)
+}
+
+func (p fsZoneRulesProvider) Version() string {
+	if f, e := p.fsys.Open("tzdata.zi"); e == nil {
+		defer f.Close()
+		var scanner = bufio.NewScanner(f)
+		if scanner.Scan() {
+			if version, ok := strings.CutPrefix(scanner.Text(), "# version "); ok {
+				return strings.TrimSpace(version)
+			}
+		}
+	}
+	if data, e := fs.ReadFile(p.fsys, "+VERSION"); e == nil {
+		return strings.TrimSpace(string(data))
+	}
+	return ""
+}
+
+func isTZifFile(fsys fs.FS, path string) bool {
+	f, e := fsys.Open(path)
+	if e != nil {
+		return false
+	}
+	defer f.Close()
+	var magic [4]byte
+	n, _ := f.Read(magic[:])
+	return n == 4 && string(magic[:]) == "TZif"
+}
+
+type bytesZoneRulesProvider map[string][]byte
+
+func (p bytesZoneRulesProvider) ZoneIds() ([]string, error) {
+	var ids = make([]string, 0, len(p))
+	for id := range p {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+func (p bytesZoneRulesProvider) TZif(zoneId string) ([]byte, error) {
+	if data, ok := p[zoneId]; ok {
+		return data, nil
+	}
+	return nil, &fs.PathError{Op: "open", Path: zoneId, Err: fs.ErrNotExist}
+}
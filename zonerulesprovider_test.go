@@ -0,0 +1,136 @@
+package goda
+
+import (
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withZoneRulesProviders restores the registered providers and the loaded zones after the test.
+func withZoneRulesProviders(t *testing.T) {
+	zoneRulesProviders.Lock()
+	var saved = zoneRulesProviders.list
+	zoneRulesProviders.Unlock()
+	t.Cleanup(func() {
+		zoneRulesProviders.Lock()
+		zoneRulesProviders.list = saved
+		zoneRulesProviders.Unlock()
+		locLoadMap.Clear()
+	})
+}
+
+func TestZoneRulesProviderOfFS(t *testing.T) {
+	paris, e := fs.ReadFile(testZoneinfoFS(t), "Europe/Paris")
+	require.NoError(t, e)
+	var p = ZoneRulesProviderOfFS(fstest.MapFS{
+		"Europe/Paris":       {Data: paris},
+		"posix/Europe/Paris": {Data: paris},
+		"zone1970.tab":       {Data: []byte("# tab")},
+		"Etc/Bad":            {Data: []byte("TZ")},
+	})
+	ids, e := p.ZoneIds()
+	require.NoError(t, e)
+	assert.Equal(t, []string{"Europe/Paris"}, ids)
+
+	data, e := p.TZif("Europe/Paris")
+	require.NoError(t, e)
+	assert.Equal(t, paris, data)
+	for _, id := range []string{"Europe/Berlin", "../Europe/Paris", ".", ""} {
+		_, e = p.TZif(id)
+		assert.ErrorIs(t, e, fs.ErrNotExist, id)
+	}
+}
+
+func TestZoneRulesProviderOfDir(t *testing.T) {
+	if _, e := os.Stat("/usr/share/zoneinfo/Europe/Paris"); e != nil {
+		t.Skip("no zoneinfo directory")
+	}
+	var p = ZoneRulesProviderOfDir("/usr/share/zoneinfo")
+	ids, e := p.ZoneIds()
+	require.NoError(t, e)
+	assert.Contains(t, ids, "Europe/Paris")
+	assert.NotContains(t, ids, "zone1970.tab")
+	data, e := p.TZif("Europe/Paris")
+	require.NoError(t, e)
+	assert.NoError(t, checkTZif(data))
+}
+
+func TestRegisterZoneRulesProvider(t *testing.T) {
+	withZoneRulesProviders(t)
+	tokyo, e := fs.ReadFile(testZoneinfoFS(t), "Asia/Tokyo")
+	require.NoError(t, e)
+	var p = ZoneRulesProviderOfBytes(map[string][]byte{"Custom/Tokyo": tokyo, "Custom/Bad": []byte("TZif")})
+	ids, e := p.ZoneIds()
+	require.NoError(t, e)
+	assert.Equal(t, []string{"Custom/Bad", "Custom/Tokyo"}, ids)
+
+	_, e = ZoneIdOf("Custom/Tokyo")
+	assert.Error(t, e)
+	RegisterZoneRulesProvider(p)
+	zone, e := ZoneIdOf("Custom/Tokyo")
+	require.NoError(t, e)
+	assert.Equal(t, "Custom/Tokyo", zone.String())
+	assert.Equal(t, MustZoneOffsetOfHours(9), zone.GetOffset(MustLocalDateTimeParse("2024-07-01T00:00")))
+
+	_, e = ZoneIdOf("Custom/Bad")
+	assert.ErrorContains(t, e, "invalid zone id")
+	// zones not provided are loaded by Go, and the short IDs still work
+	assert.Equal(t, "Europe/Paris", MustZoneIdOf("Europe/Paris").String())
+	assert.Equal(t, "Asia/Shanghai", MustZoneIdOf("CTT").String())
+
+	assert.Panics(t, func() { RegisterZoneRulesProvider(nil) })
+}
+
+func TestAvailableZoneIds(t *testing.T) {
+	withZoneRulesProviders(t)
+	tokyo, e := fs.ReadFile(testZoneinfoFS(t), "Asia/Tokyo")
+	require.NoError(t, e)
+	RegisterZoneRulesProvider(ZoneRulesProviderOfBytes(map[string][]byte{"Custom/Tokyo": tokyo}))
+	ids, e := AvailableZoneIds()
+	require.NoError(t, e)
+	assert.True(t, slices.IsSorted(ids))
+	assert.Contains(t, ids, "Custom/Tokyo")
+	if hostZoneRulesProvider() != nil {
+		assert.Contains(t, ids, "Europe/Paris")
+		assert.Contains(t, ids, "PST")
+		assert.NotContains(t, ids, "posix/Europe/Paris")
+	}
+	for _, id := range ids {
+		_, e := ZoneIdOf(id)
+		assert.NoError(t, e, id)
+	}
+}
+
+func TestZoneRulesVersion(t *testing.T) {
+	withZoneRulesProviders(t)
+	var p = ZoneRulesProviderOfFS(fstest.MapFS{"tzdata.zi": {Data: []byte("# version 2025b\n# ddeps\n")}})
+	RegisterZoneRulesProvider(p)
+	assert.Equal(t, "2025b", ZoneRulesVersion())
+	assert.Equal(t, "2024a", ZoneRulesProviderOfFS(fstest.MapFS{"+VERSION": {Data: []byte("2024a\n")}}).(interface{ Version() string }).Version())
+	assert.Equal(t, "", ZoneRulesProviderOfFS(fstest.MapFS{}).(interface{ Version() string }).Version())
+}
+
+func TestZoneIdOf_Link(t *testing.T) {
+	for link, canonical := range map[string]string{
+		"Asia/Calcutta":   "Asia/Kolkata",
+		"US/Pacific":      "America/Los_Angeles",
+		"America/Godthab": "America/Nuuk",
+		"Europe/Kiev":     "Europe/Kyiv",
+	} {
+		zone, e := ZoneIdOf(link)
+		require.NoError(t, e, link)
+		assert.Equal(t, canonical, zone.String(), link)
+		assert.Equal(t, MustZoneIdOf(canonical), zone, link)
+		assert.True(t, zone == MustZoneIdOf(canonical), link)
+	}
+	for canonical := range maps.Values(zoneLinkMap) {
+		_, e := ZoneIdOf(canonical)
+		assert.NoError(t, e, canonical)
+	}
+}
@@ -0,0 +1,225 @@
+package goda
+
+import (
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+	"weak"
+)
+
+// zoneTableEndYear is the first year not covered by the transition table of a zone,
+// the transitions from it on are computed per year, as they usually come from the recurring rule of the zone.
+const zoneTableEndYear = 2038
+
+// zoneTableYearCacheSize is the number of years cached per zone after zoneTableEndYear, a power of two.
+const zoneTableYearCacheSize = 64
+
+// zoneTableBounds is the range of epoch seconds representable by time.Time without overflow.
+const zoneTableBounds = math.MaxInt64 / 2
+
+// zoneTableMargin is the distance in seconds beyond which a local date-time can't be affected by a transition,
+// which is more than the largest offset.
+const zoneTableMargin = 2 * 86400
+
+var zoneTableEnd = MustLocalDateOf(zoneTableEndYear, January, 1).UnixEpochDays() * 86400
+
+// zoneTableMap caches the zoneTable of the locations not loaded by ID, such as those of ZoneIdOfGoLocation,
+// the entry is removed once the location is collected.
+var zoneTableMap sync.Map // weak.Pointer[time.Location] -> *zoneTable
+
+// zoneTransition is a change of the offset of a zone at an instant, the offsets are in seconds.
+type zoneTransition struct {
+	epochSecond int64
+	before      int32
+	after       int32
+}
+
+// localRange returns the local epoch seconds affected by the transition, which are skipped for a gap,
+// or occur twice for an overlap.
+func (t zoneTransition) localRange() (start, end int64) {
+	start, end = t.epochSecond+int64(t.before), t.epochSecond+int64(t.after)
+	return min(start, end), max(start, end)
+}
+
+// zoneTransitions is a list of transitions searchable by instant and by local date-time.
+type zoneTransitions struct {
+	// initial is the offset before the first transition
+	initial     int32
+	transitions []zoneTransition
+	// localStarts are the starts of the local ranges of the transitions, in ascending order
+	localStarts []int64
+}
+
+// newZoneTransitions collects the transitions of the location from the epoch second (inclusive) to the epoch second
+// (exclusive). The periods returned by time.Time.ZoneBounds which differ only in the abbreviation or the DST flag
+// are merged. Returns false if the local ranges of the transitions aren't ordered, which no real zone does.
+func newZoneTransitions(loc *time.Location, from, to int64) (r zoneTransitions, more, ok bool) {
+	var t = time.Unix(from, 0).In(loc)
+	_, offset := t.Zone()
+	r.initial = int32(offset)
+	for {
+		var end = zoneBoundsEnd(t)
+		if end.IsZero() {
+			break
+		}
+		if end.Unix() >= to {
+			more = true
+			break
+		}
+		_, next := end.Zone()
+		if int32(next) != int32(offset) {
+			var tr = zoneTransition{epochSecond: end.Unix(), before: int32(offset), after: int32(next)}
+			var start, _ = tr.localRange()
+			if n := len(r.transitions); n > 0 {
+				if _, prevEnd := r.transitions[n-1].localRange(); start < prevEnd {
+					return r, more, false
+				}
+			}
+			r.transitions = append(r.transitions, tr)
+			r.localStarts = append(r.localStarts, start)
+			offset = next
+		}
+		t = end
+	}
+	return r, more, true
+}
+
+// zoneBoundsEnd returns the end of the period of t as time.Time.ZoneBounds does, or the zero time if there is none.
+// Around the end of a leap year in the rule-based future, ZoneBounds may return an end which isn't after t,
+// the first change of the offset within a day after t is searched then, or the day after t is returned.
+func zoneBoundsEnd(t time.Time) time.Time {
+	_, end := t.ZoneBounds()
+	if end.IsZero() || end.After(t) {
+		return end
+	}
+	var offsetAt = func(epochSecond int64) int {
+		_, offset := time.Unix(epochSecond, 0).In(t.Location()).Zone()
+		return offset
+	}
+	var low, high = t.Unix(), t.Unix() + 86400
+	if offsetAt(high) == offsetAt(low) {
+		return time.Unix(high, 0).In(t.Location())
+	}
+	for high-low > 1 {
+		if mid := low + (high-low)/2; offsetAt(mid) == offsetAt(low) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return time.Unix(high, 0).In(t.Location())
+}
+
+// offsetAt returns the offset in effect at the epoch second.
+func (z *zoneTransitions) offsetAt(epochSecond int64) int32 {
+	i := sort.Search(len(z.transitions), func(i int) bool { return z.transitions[i].epochSecond > epochSecond })
+	if i == 0 {
+		return z.initial
+	}
+	return z.transitions[i-1].after
+}
+
+// offsetsAt returns the offsets of the local epoch second and whether it's in a gap or an overlap, see getOffsets.
+func (z *zoneTransitions) offsetsAt(local int64) (previous, after int32, mode int) {
+	i := sort.Search(len(z.localStarts), func(i int) bool { return z.localStarts[i] > local }) - 1
+	if i < 0 {
+		return z.initial, z.initial, zoneOffsetModeNormal
+	}
+	var tr = z.transitions[i]
+	if _, end := tr.localRange(); local >= end {
+		return tr.after, tr.after, zoneOffsetModeNormal
+	}
+	if tr.after > tr.before {
+		return tr.before, tr.after, zoneOffsetModeGap
+	}
+	return tr.before, tr.after, zoneOffsetModeOverlap
+}
+
+// zoneTable is the transitions of a time.Location, it resolves offsets without calling into the time package.
+// The transitions before zoneTableEndYear are collected when the table is created,
+// the later ones are computed and cached per year if the offset still changes.
+type zoneTable struct {
+	zoneTransitions
+	// perYear is true if there are transitions from zoneTableEndYear on
+	perYear bool
+	// fallback is true if the transitions can't be searched, the time package is used instead
+	fallback bool
+	years    [zoneTableYearCacheSize]atomic.Pointer[zoneTableYear]
+}
+
+// zoneTableYear is the transitions of a year from zoneTableEndYear on,
+// including the transitions shortly before and after the year.
+type zoneTableYear struct {
+	year int64
+	zoneTransitions
+}
+
+// zoneTableOf returns the cached zoneTable of the location, creating it if absent.
+func zoneTableOf(loc *time.Location) *zoneTable {
+	// The locations loaded by ID keep their table, which avoids the cost of a weak pointer
+	if v, ok := locLoadMap.Load(loc.String()); ok && v.(*loadedLocation).loc == loc {
+		var loaded = v.(*loadedLocation)
+		if table := loaded.table.Load(); table != nil {
+			return table
+		}
+		loaded.table.CompareAndSwap(nil, newZoneTable(loc))
+		return loaded.table.Load()
+	}
+	var key = weak.Make(loc)
+	if v, ok := zoneTableMap.Load(key); ok {
+		return v.(*zoneTable)
+	}
+	v, loaded := zoneTableMap.LoadOrStore(key, newZoneTable(loc))
+	if !loaded {
+		runtime.AddCleanup(loc, func(key weak.Pointer[time.Location]) { zoneTableMap.Delete(key) }, key)
+	}
+	return v.(*zoneTable)
+}
+
+func newZoneTable(loc *time.Location) *zoneTable {
+	var table = &zoneTable{}
+	var ok bool
+	table.zoneTransitions, table.perYear, ok = newZoneTransitions(loc, -zoneTableBounds, zoneTableEnd)
+	table.fallback = !ok
+	return table
+}
+
+// year returns the transitions of the year from zoneTableEndYear on.
+func (z *zoneTable) year(loc *time.Location, year int64) *zoneTransitions {
+	var slot = &z.years[year&(zoneTableYearCacheSize-1)]
+	if y := slot.Load(); y != nil && y.year == year {
+		return &y.zoneTransitions
+	}
+	var start = MustLocalDateOf(Year(year), January, 1).UnixEpochDays() * 86400
+	var end = MustLocalDateOf(Year(year+1), January, 1).UnixEpochDays() * 86400
+	var y = &zoneTableYear{year: year}
+	y.zoneTransitions, _, _ = newZoneTransitions(loc, start-zoneTableMargin, end+zoneTableMargin)
+	slot.Store(y)
+	return &y.zoneTransitions
+}
+
+// yearOf returns the year of the epoch second, which is clamped to zoneTableBounds.
+func yearOf(epochSecond int64) int64 {
+	return int64(mustValue(LocalDateOfEpochDays(floorDiv(epochSecond, 86400))).Year())
+}
+
+// offsetAt returns the offset in effect at the epoch second.
+func (z *zoneTable) offsetAt(loc *time.Location, epochSecond int64) int32 {
+	epochSecond = max(min(epochSecond, zoneTableBounds), -zoneTableBounds)
+	if !z.perYear || epochSecond < zoneTableEnd {
+		return z.zoneTransitions.offsetAt(epochSecond)
+	}
+	return z.year(loc, yearOf(epochSecond)).offsetAt(epochSecond)
+}
+
+// offsetsAt returns the offsets of the local epoch second and whether it's in a gap or an overlap, see getOffsets.
+func (z *zoneTable) offsetsAt(loc *time.Location, local int64) (previous, after int32, mode int) {
+	local = max(min(local, zoneTableBounds-zoneTableMargin), -zoneTableBounds+zoneTableMargin)
+	if !z.perYear || local < zoneTableEnd-zoneTableMargin {
+		return z.zoneTransitions.offsetsAt(local)
+	}
+	return z.year(loc, yearOf(local)).offsetsAt(local)
+}
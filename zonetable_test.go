@@ -0,0 +1,139 @@
+package goda
+
+import (
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var zoneTableTestZones = []string{
+	"Europe/Paris", "Europe/London", "Europe/Dublin", "America/New_York", "America/Sao_Paulo", "America/Santiago",
+	"Asia/Shanghai", "Asia/Kolkata", "Asia/Tehran", "Australia/Lord_Howe", "Pacific/Apia", "Africa/Casablanca",
+	"Antarctica/Troll", "America/Nuuk", "Pacific/Kiritimati", "Asia/Gaza", "UTC",
+}
+
+func TestZoneTable_MatchesGoTime(t *testing.T) {
+	var start = MustLocalDateTimeParse("1970-01-01T00:00:00")
+	var end = MustLocalDateTimeParse("2100-01-01T00:00:00")
+	for _, id := range zoneTableTestZones {
+		var zone = MustZoneIdOf(id)
+		if zone.loc != nil {
+			require.False(t, zoneTableOf(zone.loc).fallback, id)
+		}
+		var probes []LocalDateTime
+		for ldt := start; ldt.IsBefore(end); ldt = ldt.Chain().PlusMinutes(30 * 97).MustGet() {
+			probes = append(probes, ldt, ldt.Chain().PlusMinutes(15).MustGet())
+		}
+		// probe the edges and the middle of the local ranges of the transitions
+		for _, tr := range zone.Rules().Transitions(start.ToInstant(ZoneOffsetUTC()), end.ToInstant(ZoneOffsetUTC())) {
+			var low, high = minOf(tr.DateTimeBefore(), tr.DateTimeAfter()), maxOf(tr.DateTimeBefore(), tr.DateTimeAfter())
+			probes = append(probes,
+				low.Chain().MinusSeconds(1).MustGet(), low, low.Chain().PlusMinutes(20).MustGet(),
+				high.Chain().MinusSeconds(1).MustGet(), high)
+		}
+		for _, probe := range probes {
+			p1, a1, m1 := zone.getOffsets(probe)
+			p2, a2, m2 := zone.getOffsetsByGoTime(probe)
+			if !assert.Equal(t, []any{p2, a2, m2}, []any{p1, a1, m1}, "%s at %s", id, probe) {
+				return
+			}
+			var s = probe.ToInstant(ZoneOffsetUTC()).EpochSecond()
+			assert.Equal(t, zone.offsetOfEpochSecondByGoTime(s), zone.offsetOfEpochSecond(s), "%s at %d", id, s)
+		}
+	}
+}
+
+func TestZoneTable_Transitions(t *testing.T) {
+	var zone = MustZoneIdOf("Europe/Paris")
+	for _, year := range []int{1990, 2037, 2038, 2039, 2100, 9999} {
+		var rules = zone.Rules()
+		var from = MustLocalDateTimeOf(Year(year), January, 1, 0, 0, 0, 0).ToInstant(ZoneOffsetUTC())
+		var to = MustLocalDateTimeOf(Year(year+1), January, 1, 0, 0, 0, 0).ToInstant(ZoneOffsetUTC())
+		for _, tr := range rules.Transitions(from, to) {
+			var before, after = tr.DateTimeBefore(), tr.DateTimeAfter()
+			var probe = minOf(before, after).Chain().PlusMinutes(30).MustGet()
+			prev, next, mode := zone.getOffsets(probe)
+			assert.Equal(t, tr.mode, mode, "%s", probe)
+			assert.Equal(t, tr.OffsetBefore(), prev, "%s", probe)
+			assert.Equal(t, tr.OffsetAfter(), next, "%s", probe)
+			var s = tr.Instant().EpochSecond()
+			assert.Equal(t, tr.OffsetBefore(), zone.offsetOfEpochSecond(s-1), "%s", tr)
+			assert.Equal(t, tr.OffsetAfter(), zone.offsetOfEpochSecond(s), "%s", tr)
+		}
+	}
+}
+
+func TestZoneTable_Extremes(t *testing.T) {
+	for _, id := range []string{"Europe/Paris", "UTC"} {
+		var zone = MustZoneIdOf(id)
+		for _, ldt := range []LocalDateTime{LocalDateMin().AtTime(MustLocalTimeOf(0, 0, 0, 0)), LocalDateMax().AtTime(MustLocalTimeOf(0, 0, 0, 0))} {
+			_, _, mode := zone.getOffsets(ldt)
+			assert.Equal(t, zoneOffsetModeNormal, mode, ldt.String())
+		}
+		assert.NotPanics(t, func() {
+			zone.offsetOfEpochSecond(-1 << 63)
+			zone.offsetOfEpochSecond(1<<63 - 1)
+		})
+	}
+}
+
+func TestZoneTable_GoLocation(t *testing.T) {
+	var zone = ZoneIdOfGoLocation(time.FixedZone("X", 3600))
+	var table = zoneTableOf(zone.loc)
+	assert.Empty(t, table.transitions)
+	assert.False(t, table.perYear)
+	assert.Equal(t, MustZoneOffsetOfHours(1), zone.GetOffset(MustLocalDateTimeParse("2024-07-01T00:00")))
+	assert.Same(t, table, zoneTableOf(zone.loc))
+
+	data, e := fs.ReadFile(testZoneinfoFS(t), "Europe/Berlin")
+	require.NoError(t, e)
+	loc, e := time.LoadLocationFromTZData("Europe/Berlin", data)
+	require.NoError(t, e)
+	assert.True(t, zoneTableOf(loc).perYear)
+	assert.Equal(t, MustZoneOffsetOfHours(2), ZoneIdOfGoLocation(loc).GetOffset(MustLocalDateTimeParse("2050-07-01T00:00")))
+}
+
+func benchmarkZoneTableLocalDateTimes() []LocalDateTime {
+	var list []LocalDateTime
+	var end = MustLocalDateTimeParse("2100-01-01T00:00:00")
+	for ldt := MustLocalDateTimeParse("1970-01-01T00:00:00"); ldt.IsBefore(end); ldt = ldt.Chain().PlusHours(97).MustGet() {
+		list = append(list, ldt)
+	}
+	return list
+}
+
+func BenchmarkZoneId_GetOffset(b *testing.B) {
+	var zone = MustZoneIdOf("America/New_York")
+	var list = benchmarkZoneTableLocalDateTimes()
+	b.Run("Table", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			zone.getOffsets(list[i%len(list)])
+		}
+	})
+	b.Run("GoTime", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			zone.getOffsetsByGoTime(list[i%len(list)])
+		}
+	})
+}
+
+func BenchmarkZoneId_OffsetOfEpochSecond(b *testing.B) {
+	var zone = MustZoneIdOf("America/New_York")
+	var list []int64
+	for _, ldt := range benchmarkZoneTableLocalDateTimes() {
+		list = append(list, ldt.ToInstant(ZoneOffsetUTC()).EpochSecond())
+	}
+	b.Run("Table", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			zone.offsetOfEpochSecond(list[i%len(list)])
+		}
+	})
+	b.Run("GoTime", func(b *testing.B) {
+		for i := 0; b.Loop(); i++ {
+			zone.offsetOfEpochSecondByGoTime(list[i%len(list)])
+		}
+	})
+}